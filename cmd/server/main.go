@@ -4,6 +4,7 @@ import (
 	"flag"
 	"log/slog"
 	"os"
+	_ "time/tzdata" // time zone database for clients' IANA zones; the runtime image has none
 
 	"github.com/chiquitav2/journalful/internal/app"
 	"github.com/chiquitav2/journalful/pkg/conf"
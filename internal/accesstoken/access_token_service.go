@@ -0,0 +1,182 @@
+package accesstoken
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/accesstoken/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultLifetime = 90 * 24 * time.Hour
+	maxLifetime     = 366 * 24 * time.Hour
+)
+
+type AccessTokenServiceInterface interface {
+	CreateAccessToken(ctx context.Context, request *accesstoken.CreateAccessTokenRequest) (*accesstoken.CreateAccessTokenResponse, error)
+	ListAccessTokens(ctx context.Context, request *accesstoken.ListAccessTokensRequest) (*accesstoken.ListAccessTokensResponse, error)
+	RevokeAccessToken(ctx context.Context, request *accesstoken.RevokeAccessTokenRequest) (*accesstoken.RevokeAccessTokenResponse, error)
+}
+
+type AccessTokenService struct {
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewAccessTokenService(conn *sql.DB) *AccessTokenService {
+	return &AccessTokenService{
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+// caller returns the authenticated principal, who needs a profile to own
+// tokens.
+func caller(ctx context.Context) (*auth.Principal, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+	if principal.ProfileID == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "create a profile first")
+	}
+	return principal, nil
+}
+
+func (s *AccessTokenService) CreateAccessToken(ctx context.Context, request *accesstoken.CreateAccessTokenRequest) (*accesstoken.CreateAccessTokenResponse, error) {
+	principal, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.IsAccessToken() {
+		return nil, status.Error(codes.PermissionDenied, "access tokens cannot create access tokens")
+	}
+
+	name := strings.TrimSpace(request.Name)
+	scopes, err := scopesToDB(request.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if slices.Contains(request.Scopes, accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_ADMIN) && !principal.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "only admins can create admin tokens")
+	}
+	expiresAt, err := expiry(request.ExpiresAt, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	token, prefix, hash, err := newToken()
+	if err != nil {
+		slog.Error("failed to create access token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+	result, err := s.queries.CreateAccessToken(ctx, db.CreateAccessTokenParams{
+		ProfileID:   principal.ProfileID,
+		Name:        name,
+		TokenHash:   hash,
+		TokenPrefix: prefix,
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		slog.Error("failed to create access token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.Error("failed to get last insert ID", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get last insert ID")
+	}
+	created, err := s.queries.GetAccessToken(ctx, id)
+	if err != nil {
+		slog.Error("failed to get access token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get access token")
+	}
+
+	return &accesstoken.CreateAccessTokenResponse{
+		AccessToken: dbToGrpcAccessToken(created),
+		Token:       token,
+	}, nil
+}
+
+// expiry validates the requested expiry, defaulting to defaultLifetime.
+func expiry(requested *timestamppb.Timestamp, now time.Time) (time.Time, error) {
+	if requested == nil {
+		return now.Add(defaultLifetime), nil
+	}
+	expiresAt := requested.AsTime()
+	if !expiresAt.After(now) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	if expiresAt.Sub(now) > maxLifetime {
+		return time.Time{}, status.Error(codes.InvalidArgument, "access tokens can be valid for at most a year")
+	}
+	return expiresAt, nil
+}
+
+func (s *AccessTokenService) ListAccessTokens(ctx context.Context, _ *accesstoken.ListAccessTokensRequest) (*accesstoken.ListAccessTokensResponse, error) {
+	principal, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.ListAccessTokensByProfileID(ctx, principal.ProfileID)
+	if err != nil {
+		slog.Error("failed to list access tokens", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list access tokens")
+	}
+	tokens := make([]*accesstoken.AccessToken, len(rows))
+	for i, row := range rows {
+		tokens[i] = dbToGrpcAccessToken(row)
+	}
+	return &accesstoken.ListAccessTokensResponse{AccessTokens: tokens}, nil
+}
+
+func (s *AccessTokenService) RevokeAccessToken(ctx context.Context, request *accesstoken.RevokeAccessTokenRequest) (*accesstoken.RevokeAccessTokenResponse, error) {
+	principal, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.queries.RevokeAccessToken(ctx, db.RevokeAccessTokenParams{ID: request.Id, ProfileID: principal.ProfileID})
+	if err != nil {
+		slog.Error("failed to revoke access token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to revoke access token")
+	}
+	if revoked == 0 {
+		// Unknown, someone else's, or already revoked: revoking is idempotent
+		// for the owner, and other users' tokens stay invisible.
+		token, err := s.queries.GetAccessToken(ctx, request.Id)
+		if err != nil || token.ProfileID != principal.ProfileID {
+			return nil, status.Error(codes.NotFound, "access token not found")
+		}
+	}
+	return &accesstoken.RevokeAccessTokenResponse{}, nil
+}
+
+func dbToGrpcAccessToken(token db.AccessToken) *accesstoken.AccessToken {
+	grpcToken := &accesstoken.AccessToken{
+		Id:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopesToGrpc(token.Scopes),
+		ExpiresAt:   timestamppb.New(token.ExpiresAt),
+	}
+	if token.LastUsedAt.Valid {
+		grpcToken.LastUsedAt = timestamppb.New(token.LastUsedAt.Time)
+	}
+	if token.RevokedAt.Valid {
+		grpcToken.RevokedAt = timestamppb.New(token.RevokedAt.Time)
+	}
+	if token.CreatedAt.Valid {
+		grpcToken.CreatedAt = timestamppb.New(token.CreatedAt.Time)
+	}
+	return grpcToken
+}
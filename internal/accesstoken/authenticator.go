@@ -0,0 +1,89 @@
+package accesstoken
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+)
+
+// lastUsedResolution limits how often a token's last use is written back,
+// so a busy script does not update its row on every request.
+const lastUsedResolution = time.Minute
+
+// AdminConfirmationTTL is how long a token owner stays an admin after the
+// identity provider last confirmed the role. Signing in without the role
+// takes it from their tokens at once.
+const AdminConfirmationTTL = 24 * time.Hour
+
+// Authenticator verifies personal access tokens against the database.
+type Authenticator struct {
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewAuthenticator(conn *sql.DB) *Authenticator {
+	return &Authenticator{
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*auth.Claims, error) {
+	if !IsAccessToken(token) {
+		return nil, fmt.Errorf("%w: not a personal access token", auth.ErrInvalidToken)
+	}
+	row, err := a.queries.GetAccessTokenByHash(ctx, hashToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: unknown personal access token", auth.ErrInvalidToken)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+	now := a.now()
+	if err := checkToken(row, now); err != nil {
+		return nil, err
+	}
+
+	err = a.queries.TouchAccessToken(ctx, db.TouchAccessTokenParams{
+		UsedAt:      sql.NullTime{Time: now, Valid: true},
+		ID:          row.ID,
+		StaleBefore: sql.NullTime{Time: now.Add(-lastUsedResolution), Valid: true},
+	})
+	if err != nil {
+		// Not worth failing the request over.
+		slog.Warn("failed to record access token use", "error", err)
+	}
+
+	return tokenClaims(row, now), nil
+}
+
+func checkToken(row db.GetAccessTokenByHashRow, now time.Time) error {
+	if row.RevokedAt.Valid {
+		return fmt.Errorf("%w: personal access token was revoked", auth.ErrInvalidToken)
+	}
+	if !now.Before(row.ExpiresAt) {
+		return fmt.Errorf("%w: personal access token expired", auth.ErrInvalidToken)
+	}
+	return nil
+}
+
+func tokenClaims(row db.GetAccessTokenByHashRow, now time.Time) *auth.Claims {
+	claims := &auth.Claims{
+		UserID:  row.UserID,
+		Name:    row.ProfileName,
+		Scopes:  grantedScopes(row.Scopes),
+		TokenID: row.ID,
+	}
+	// The role follows the owner, not the token: admin requests also need the
+	// admin scope, which the PolicyInterceptor checks.
+	if row.AdminConfirmedAt.Valid && now.Sub(row.AdminConfirmedAt.Time) < AdminConfirmationTTL {
+		claims.Roles = []string{auth.RoleAdmin}
+	}
+	return claims
+}
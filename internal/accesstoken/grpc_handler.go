@@ -0,0 +1,31 @@
+package accesstoken
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/accesstoken/v1"
+)
+
+type GrpcHandler struct {
+	accesstoken.UnimplementedAccessTokenServiceServer
+	service AccessTokenServiceInterface
+}
+
+func NewAccessTokenGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewAccessTokenService(conn),
+	}
+}
+
+func (h *GrpcHandler) CreateAccessToken(ctx context.Context, request *accesstoken.CreateAccessTokenRequest) (*accesstoken.CreateAccessTokenResponse, error) {
+	return h.service.CreateAccessToken(ctx, request)
+}
+
+func (h *GrpcHandler) ListAccessTokens(ctx context.Context, request *accesstoken.ListAccessTokensRequest) (*accesstoken.ListAccessTokensResponse, error) {
+	return h.service.ListAccessTokens(ctx, request)
+}
+
+func (h *GrpcHandler) RevokeAccessToken(ctx context.Context, request *accesstoken.RevokeAccessTokenRequest) (*accesstoken.RevokeAccessTokenResponse, error) {
+	return h.service.RevokeAccessToken(ctx, request)
+}
@@ -0,0 +1,96 @@
+package accesstoken
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/accesstoken/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewToken(t *testing.T) {
+	token, prefix, hash, err := newToken()
+	assert.NoError(t, err)
+	assert.True(t, IsAccessToken(token))
+	assert.Equal(t, token[:displayPrefixLen], prefix)
+	assert.Equal(t, hashToken(token), hash)
+	assert.Len(t, hash, 64)
+
+	other, _, _, err := newToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.False(t, IsAccessToken("eyJhbGciOiJSUzI1NiJ9.e30.sig"))
+}
+
+func TestScopes(t *testing.T) {
+	stored, err := scopesToDB([]accesstoken.AccessTokenScope{
+		accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_READ,
+		accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_LIBRARY_WRITE,
+		accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_READ,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "library:write read", stored)
+	assert.Equal(t, []accesstoken.AccessTokenScope{
+		accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_READ,
+		accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_LIBRARY_WRITE,
+	}, scopesToGrpc(stored))
+
+	_, err = scopesToDB(nil)
+	assert.Error(t, err)
+	_, err = scopesToDB([]accesstoken.AccessTokenScope{accesstoken.AccessTokenScope_ACCESS_TOKEN_SCOPE_UNSPECIFIED})
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{auth.ScopeRead}, grantedScopes("read"))
+	assert.Equal(t, []string{auth.ScopeLibraryWrite, auth.ScopeRead}, grantedScopes("library:write"))
+	assert.Equal(t, []string{auth.ScopeWrite, auth.ScopeLibraryWrite, auth.ScopeRead}, grantedScopes("write"))
+	assert.Equal(t, []string{auth.ScopeAdmin, auth.ScopeWrite, auth.ScopeLibraryWrite, auth.ScopeRead}, grantedScopes("admin"))
+}
+
+func TestExpiry(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	expiresAt, err := expiry(nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(defaultLifetime), expiresAt)
+
+	week := now.Add(7 * 24 * time.Hour)
+	expiresAt, err = expiry(timestamppb.New(week), now)
+	assert.NoError(t, err)
+	assert.Equal(t, week, expiresAt)
+
+	_, err = expiry(timestamppb.New(now.Add(-time.Minute)), now)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = expiry(timestamppb.New(now.Add(2*maxLifetime)), now)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCheckToken(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	row := db.GetAccessTokenByHashRow{ID: 7, UserID: "user-1", Scopes: "admin", ExpiresAt: now.Add(time.Hour)}
+	assert.NoError(t, checkToken(row, now))
+
+	claims := tokenClaims(row, now)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, int64(7), claims.TokenID)
+	assert.Empty(t, claims.Roles, "the scope does not make the owner an admin")
+
+	expired := row
+	expired.ExpiresAt = now
+	assert.ErrorIs(t, checkToken(expired, now), auth.ErrInvalidToken)
+
+	revoked := row
+	revoked.RevokedAt = sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+	assert.ErrorIs(t, checkToken(revoked, now), auth.ErrInvalidToken)
+
+	row.AdminConfirmedAt = sql.NullTime{Time: now.Add(-time.Hour), Valid: true}
+	assert.Equal(t, []string{auth.RoleAdmin}, tokenClaims(row, now).Roles)
+
+	row.AdminConfirmedAt.Time = now.Add(-AdminConfirmationTTL)
+	assert.Empty(t, tokenClaims(row, now).Roles, "the confirmation went stale")
+}
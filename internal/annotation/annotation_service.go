@@ -0,0 +1,419 @@
+package annotation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"github.com/chiquitav2/journalful/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 25
+	maxPageSize     = 100
+)
+
+type AnnotationServiceInterface interface {
+	CreateAnnotation(ctx context.Context, request *annotation.CreateAnnotationRequest) (*annotation.CreateAnnotationResponse, error)
+	GetAnnotation(ctx context.Context, request *annotation.GetAnnotationRequest) (*annotation.GetAnnotationResponse, error)
+	ListAnnotations(ctx context.Context, request *annotation.ListAnnotationsRequest) (*annotation.ListAnnotationsResponse, error)
+	UpdateAnnotation(ctx context.Context, request *annotation.UpdateAnnotationRequest) (*annotation.UpdateAnnotationResponse, error)
+	DeleteAnnotation(ctx context.Context, request *annotation.DeleteAnnotationRequest) (*annotation.DeleteAnnotationResponse, error)
+	SearchAnnotations(ctx context.Context, request *annotation.SearchAnnotationsRequest) (*annotation.SearchAnnotationsResponse, error)
+	ExportAnnotations(ctx context.Context, request *annotation.ExportAnnotationsRequest) (*annotation.ExportAnnotationsResponse, error)
+	ExportNotes(request *annotation.ExportNotesRequest, stream annotation.AnnotationService_ExportNotesServer) error
+}
+
+type AnnotationService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewAnnotationService(conn *sql.DB) *AnnotationService {
+	return &AnnotationService{
+		conn:    conn,
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+func (s *AnnotationService) CreateAnnotation(ctx context.Context, request *annotation.CreateAnnotationRequest) (*annotation.CreateAnnotationResponse, error) {
+	entry, err := s.queries.GetLibraryArticleDetails(ctx, request.LibraryArticleId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "library article not found")
+		}
+		slog.Error("failed to get library article", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get library article")
+	}
+	// Libraries have no members besides their owner yet, so only the owner
+	// can annotate.
+	if entry.OwnerID != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the library owner can annotate its articles")
+	}
+
+	params := db.CreateAnnotationParams{
+		LibraryArticleID: entry.ID,
+		AuthorID:         request.UserId,
+		Quote:            nullString(request.Quote),
+		Comment:          nullString(request.Comment),
+		Page:             nullInt32(request.Page),
+		Location:         nullString(request.Location),
+		Color:            int8(request.Color),
+		Visibility:       int8(normalizeVisibility(request.Visibility)),
+	}
+	if err := validateAnnotation(params.Quote, params.Comment); err != nil {
+		return nil, err
+	}
+	tags := normalizeTags(request.Tags)
+
+	var id int64
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		result, err := q.CreateAnnotation(ctx, params)
+		if err != nil {
+			return err
+		}
+		id, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return setTags(ctx, q, id, tags)
+	})
+	if err != nil {
+		slog.Error("failed to create annotation", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create annotation")
+	}
+
+	created, err := s.getAnnotation(ctx, id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &annotation.CreateAnnotationResponse{Annotation: created}, nil
+}
+
+// getAnnotation loads an annotation the viewer is allowed to see. Annotations
+// hidden from the viewer are reported as missing.
+func (s *AnnotationService) getAnnotation(ctx context.Context, id, viewerID int64) (*annotation.Annotation, error) {
+	row, err := s.queries.GetAnnotation(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "annotation not found")
+		}
+		slog.Error("failed to get annotation", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get annotation")
+	}
+	if !canView(row.Annotation, row.OwnerID, row.Ispublic.Bool, viewerID) {
+		return nil, status.Error(codes.NotFound, "annotation not found")
+	}
+
+	grpcAnnotation := dbToGrpcAnnotation(row.Annotation, row.LibraryID, row.ArticleID)
+	if err := s.attachTags(ctx, grpcAnnotation); err != nil {
+		return nil, err
+	}
+	return grpcAnnotation, nil
+}
+
+func (s *AnnotationService) GetAnnotation(ctx context.Context, request *annotation.GetAnnotationRequest) (*annotation.GetAnnotationResponse, error) {
+	grpcAnnotation, err := s.getAnnotation(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &annotation.GetAnnotationResponse{Annotation: grpcAnnotation}, nil
+}
+
+func (s *AnnotationService) ListAnnotations(ctx context.Context, request *annotation.ListAnnotationsRequest) (*annotation.ListAnnotationsResponse, error) {
+	rows, err := s.queries.ListAnnotationsForLibraryArticle(ctx, db.ListAnnotationsForLibraryArticleParams{
+		LibraryArticleID: request.LibraryArticleId,
+		ViewerID:         request.UserId,
+	})
+	if err != nil {
+		slog.Error("failed to list annotations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list annotations")
+	}
+
+	annotations := make([]*annotation.Annotation, len(rows))
+	for i, row := range rows {
+		annotations[i] = dbToGrpcAnnotation(row.Annotation, row.LibraryID, row.ArticleID)
+	}
+	if err := s.attachTags(ctx, annotations...); err != nil {
+		return nil, err
+	}
+	return &annotation.ListAnnotationsResponse{Annotations: annotations}, nil
+}
+
+func (s *AnnotationService) UpdateAnnotation(ctx context.Context, request *annotation.UpdateAnnotationRequest) (*annotation.UpdateAnnotationResponse, error) {
+	current, err := s.getAnnotation(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if current.AuthorId != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the author can change an annotation")
+	}
+
+	params := db.UpdateAnnotationParams{
+		ID:         current.Id,
+		Quote:      nullString(current.Quote),
+		Comment:    nullString(current.Comment),
+		Page:       nullInt32(current.Page),
+		Location:   nullString(current.Location),
+		Color:      int8(current.Color),
+		Visibility: int8(current.Visibility),
+	}
+	if request.Quote != nil {
+		params.Quote = nullString(request.Quote)
+	}
+	if request.Comment != nil {
+		params.Comment = nullString(request.Comment)
+	}
+	if request.Page != nil {
+		params.Page = nullInt32(request.Page)
+	}
+	if request.Location != nil {
+		params.Location = nullString(request.Location)
+	}
+	if request.Color != nil {
+		params.Color = int8(*request.Color)
+	}
+	if request.Visibility != nil {
+		params.Visibility = int8(normalizeVisibility(*request.Visibility))
+	}
+	if err := validateAnnotation(params.Quote, params.Comment); err != nil {
+		return nil, err
+	}
+	var tags []string
+	if request.Tags != nil {
+		tags = normalizeTags(request.Tags.Names)
+	}
+
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		if err := q.UpdateAnnotation(ctx, params); err != nil {
+			return err
+		}
+		if request.Tags == nil {
+			return nil
+		}
+		return setTags(ctx, q, current.Id, tags)
+	})
+	if err != nil {
+		slog.Error("failed to update annotation", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update annotation")
+	}
+
+	updated, err := s.getAnnotation(ctx, current.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &annotation.UpdateAnnotationResponse{Annotation: updated}, nil
+}
+
+func (s *AnnotationService) DeleteAnnotation(ctx context.Context, request *annotation.DeleteAnnotationRequest) (*annotation.DeleteAnnotationResponse, error) {
+	current, err := s.getAnnotation(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if current.AuthorId != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the author can delete an annotation")
+	}
+	if err := s.queries.DeleteAnnotation(ctx, current.Id); err != nil {
+		slog.Error("failed to delete annotation", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete annotation")
+	}
+	return &annotation.DeleteAnnotationResponse{Success: true}, nil
+}
+
+func (s *AnnotationService) SearchAnnotations(ctx context.Context, request *annotation.SearchAnnotationsRequest) (*annotation.SearchAnnotationsResponse, error) {
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	params := db.SearchAnnotationsParams{
+		AuthorID: request.UserId,
+		Limit:    pageSize + 1,
+	}
+	if request.LibraryId != nil {
+		params.LibraryID = sql.NullInt64{Int64: *request.LibraryId, Valid: true}
+	}
+	if request.Color != nil {
+		params.Color = sql.NullInt16{Int16: int16(*request.Color), Valid: true}
+	}
+	if request.Query != nil && strings.TrimSpace(*request.Query) != "" {
+		params.Query = sql.NullString{String: utils.LikePattern(*request.Query), Valid: true}
+	}
+	if request.Tag != nil && strings.TrimSpace(*request.Tag) != "" {
+		params.Tag = sql.NullString{String: strings.TrimSpace(*request.Tag), Valid: true}
+	}
+	if request.PageToken != "" {
+		beforeID, err := utils.DecodeCursor(request.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		params.BeforeID = sql.NullInt64{Int64: beforeID, Valid: true}
+	}
+
+	rows, err := s.queries.SearchAnnotations(ctx, params)
+	if err != nil {
+		slog.Error("failed to search annotations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search annotations")
+	}
+
+	var nextPageToken string
+	if int32(len(rows)) > pageSize {
+		rows = rows[:pageSize]
+		nextPageToken = utils.EncodeCursor(rows[len(rows)-1].Annotation.ID)
+	}
+
+	annotations := make([]*annotation.Annotation, len(rows))
+	for i, row := range rows {
+		annotations[i] = dbToGrpcAnnotation(row.Annotation, row.LibraryID, row.ArticleID)
+	}
+	if err := s.attachTags(ctx, annotations...); err != nil {
+		return nil, err
+	}
+
+	return &annotation.SearchAnnotationsResponse{
+		Annotations:   annotations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// attachTags fills in the tags of the given annotations with one query.
+func (s *AnnotationService) attachTags(ctx context.Context, annotations ...*annotation.Annotation) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*annotation.Annotation, len(annotations))
+	ids := make([]int64, len(annotations))
+	for i, a := range annotations {
+		byID[a.Id] = a
+		ids[i] = a.Id
+	}
+
+	rows, err := s.queries.ListAnnotationTags(ctx, ids)
+	if err != nil {
+		slog.Error("failed to list annotation tags", "error", err)
+		return status.Error(codes.Internal, "failed to list annotation tags")
+	}
+	for _, row := range rows {
+		if a, ok := byID[row.AnnotationID]; ok {
+			a.Tags = append(a.Tags, row.Name)
+		}
+	}
+	return nil
+}
+
+// setTags replaces the tags of an annotation, creating tags that don't exist
+// yet.
+func setTags(ctx context.Context, q *db.Queries, annotationID int64, tags []string) error {
+	if err := q.DeleteAnnotationTags(ctx, annotationID); err != nil {
+		return err
+	}
+	for _, name := range tags {
+		tagID, err := q.UpsertTag(ctx, name)
+		if err != nil {
+			return err
+		}
+		if err := q.AddAnnotationTag(ctx, db.AddAnnotationTagParams{AnnotationID: annotationID, TagID: tagID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canView reports whether viewerID may see an annotation. Authors always see
+// their own annotations; shared ones are visible to whoever can view the
+// library, which is its owner or anyone for a public library.
+func canView(a db.Annotation, libraryOwnerID int64, libraryIsPublic bool, viewerID int64) bool {
+	if a.AuthorID == viewerID {
+		return true
+	}
+	if annotation.AnnotationVisibility(a.Visibility) != annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_SHARED {
+		return false
+	}
+	return libraryOwnerID == viewerID || libraryIsPublic
+}
+
+func normalizeVisibility(visibility annotation.AnnotationVisibility) annotation.AnnotationVisibility {
+	if visibility == annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_SHARED {
+		return visibility
+	}
+	return annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_PRIVATE
+}
+
+// validateAnnotation checks the annotation as it will be stored, after an
+// update has been applied.
+func validateAnnotation(quote, comment sql.NullString) error {
+	if !quote.Valid && !comment.Valid {
+		return status.Error(codes.InvalidArgument, "an annotation needs a quote or a comment")
+	}
+	return nil
+}
+
+// normalizeTags trims tag names and drops empty and duplicate ones.
+func normalizeTags(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	var tags []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+// nullString treats a missing or blank string as NULL.
+func nullString(s *string) sql.NullString {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func nullInt32(i *int32) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *i, Valid: true}
+}
+
+func dbToGrpcAnnotation(row db.Annotation, libraryID, articleID int64) *annotation.Annotation {
+	grpcAnnotation := &annotation.Annotation{
+		Id:               row.ID,
+		LibraryArticleId: row.LibraryArticleID,
+		LibraryId:        libraryID,
+		ArticleId:        articleID,
+		AuthorId:         row.AuthorID,
+		Color:            annotation.AnnotationColor(row.Color),
+		Visibility:       annotation.AnnotationVisibility(row.Visibility),
+		CreatedAt:        timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt:        timestamppb.New(row.UpdatedAt.Time),
+	}
+	if row.Quote.Valid {
+		grpcAnnotation.Quote = &row.Quote.String
+	}
+	if row.Comment.Valid {
+		grpcAnnotation.Comment = &row.Comment.String
+	}
+	if row.Page.Valid {
+		grpcAnnotation.Page = &row.Page.Int32
+	}
+	if row.Location.Valid {
+		grpcAnnotation.Location = &row.Location.String
+	}
+	return grpcAnnotation
+}
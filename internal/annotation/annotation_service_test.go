@@ -0,0 +1,49 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanView(t *testing.T) {
+	private := db.Annotation{AuthorID: 1, Visibility: int8(annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_PRIVATE)}
+	shared := db.Annotation{AuthorID: 1, Visibility: int8(annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_SHARED)}
+
+	assert.True(t, canView(private, 2, true, 1), "authors see their own annotations")
+	assert.False(t, canView(private, 2, true, 2))
+	assert.True(t, canView(shared, 2, false, 2))
+	assert.False(t, canView(shared, 2, false, 3))
+	assert.True(t, canView(shared, 2, true, 3), "shared annotations in public libraries are visible to anyone")
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags := normalizeTags([]string{" methods ", "", "methods", "to cite"})
+	assert.Equal(t, []string{"methods", "to cite"}, tags)
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	records := []exportRecord{
+		{Library: "Thesis", ArticleTitle: "Attention", DOI: "10.1/a", Quote: "all you need", Page: 3, Color: "yellow", Tags: []string{"core idea"}, libraryID: 1, libraryArticleID: 10},
+		{Library: "Thesis", ArticleTitle: "Attention", DOI: "10.1/a", Comment: "Check the ablation.", libraryID: 1, libraryArticleID: 10},
+	}
+
+	expected := "# Annotations\n" +
+		"\n## Thesis\n" +
+		"\n### Attention\n\nDOI: 10.1/a\n" +
+		"\n> all you need\n\n*p. 3 · yellow*\n\n#core-idea\n\n" +
+		"\nCheck the ablation.\n\n"
+	assert.Equal(t, expected, string(renderMarkdown(records)))
+}
+
+func TestRenderCSV(t *testing.T) {
+	content, err := renderCSV([]exportRecord{{
+		Library: "Thesis", ArticleTitle: "A, B", DOI: "10.1/a", Page: 2, Tags: []string{"x", "y"},
+		CreatedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}})
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "Thesis,\"A, B\",10.1/a,2,,,,,x;y,false,2025-03-01T12:00:00Z\n")
+}
@@ -0,0 +1,190 @@
+package annotation
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportRecord is one annotation together with the article it belongs to.
+type exportRecord struct {
+	Library      string    `json:"library"`
+	ArticleTitle string    `json:"article_title"`
+	DOI          string    `json:"doi"`
+	Quote        string    `json:"quote,omitempty"`
+	Comment      string    `json:"comment,omitempty"`
+	Page         int32     `json:"page,omitempty"`
+	Location     string    `json:"location,omitempty"`
+	Color        string    `json:"color,omitempty"`
+	Tags         []string  `json:"tags,omitempty"`
+	Shared       bool      `json:"shared"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	libraryID        int64
+	libraryArticleID int64
+}
+
+// ExportAnnotations renders all annotations a user wrote, optionally limited
+// to one library, as a single downloadable file.
+func (s *AnnotationService) ExportAnnotations(ctx context.Context, request *annotation.ExportAnnotationsRequest) (*annotation.ExportAnnotationsResponse, error) {
+	params := db.ListAnnotationsForExportParams{AuthorID: request.UserId}
+	if request.LibraryId != nil {
+		params.LibraryID = sql.NullInt64{Int64: *request.LibraryId, Valid: true}
+	}
+	rows, err := s.queries.ListAnnotationsForExport(ctx, params)
+	if err != nil {
+		slog.Error("failed to list annotations for export", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list annotations")
+	}
+
+	grpcAnnotations := make([]*annotation.Annotation, len(rows))
+	for i, row := range rows {
+		grpcAnnotations[i] = dbToGrpcAnnotation(row.Annotation, row.LibraryID, row.ArticleID)
+	}
+	if err := s.attachTags(ctx, grpcAnnotations...); err != nil {
+		return nil, err
+	}
+
+	records := make([]exportRecord, len(rows))
+	for i, row := range rows {
+		records[i] = exportRecord{
+			Library:          row.LibraryName.String,
+			ArticleTitle:     row.ArticleTitle,
+			DOI:              row.Doi,
+			Quote:            row.Annotation.Quote.String,
+			Comment:          row.Annotation.Comment.String,
+			Page:             row.Annotation.Page.Int32,
+			Location:         row.Annotation.Location.String,
+			Color:            colorName(annotation.AnnotationColor(row.Annotation.Color)),
+			Tags:             grpcAnnotations[i].Tags,
+			Shared:           annotation.AnnotationVisibility(row.Annotation.Visibility) == annotation.AnnotationVisibility_ANNOTATION_VISIBILITY_SHARED,
+			CreatedAt:        row.Annotation.CreatedAt.Time,
+			libraryID:        row.LibraryID,
+			libraryArticleID: row.Annotation.LibraryArticleID,
+		}
+	}
+
+	var content []byte
+	var contentType, extension string
+	switch request.Format {
+	case annotation.ExportFormat_EXPORT_FORMAT_CSV:
+		content, err = renderCSV(records)
+		contentType, extension = "text/csv", "csv"
+	case annotation.ExportFormat_EXPORT_FORMAT_JSON:
+		content, err = json.MarshalIndent(records, "", "  ")
+		contentType, extension = "application/json", "json"
+	default:
+		content = renderMarkdown(records)
+		contentType, extension = "text/markdown", "md"
+	}
+	if err != nil {
+		slog.Error("failed to render annotations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to render annotations")
+	}
+
+	return &annotation.ExportAnnotationsResponse{
+		Content:     content,
+		ContentType: contentType,
+		Filename:    fmt.Sprintf("annotations-%s.%s", s.now().Format("2006-01-02"), extension),
+	}, nil
+}
+
+// renderMarkdown groups records by library and article. Records must be
+// ordered so that each library and article is contiguous.
+func renderMarkdown(records []exportRecord) []byte {
+	var b bytes.Buffer
+	b.WriteString("# Annotations\n")
+	var libraryID, libraryArticleID int64
+	for i, r := range records {
+		if i == 0 || r.libraryID != libraryID {
+			libraryID = r.libraryID
+			fmt.Fprintf(&b, "\n## %s\n", r.Library)
+		}
+		if i == 0 || r.libraryArticleID != libraryArticleID {
+			libraryArticleID = r.libraryArticleID
+			fmt.Fprintf(&b, "\n### %s\n\nDOI: %s\n", r.ArticleTitle, r.DOI)
+		}
+		b.WriteString("\n")
+		writeMarkdownAnnotation(&b, r)
+	}
+	return b.Bytes()
+}
+
+// writeMarkdownAnnotation writes one annotation as a block quote followed by
+// its position, comment and tags.
+func writeMarkdownAnnotation(b *bytes.Buffer, r exportRecord) {
+	if r.Quote != "" {
+		for _, line := range strings.Split(strings.TrimSpace(r.Quote), "\n") {
+			fmt.Fprintf(b, "> %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+
+	var meta []string
+	if r.Page > 0 {
+		meta = append(meta, fmt.Sprintf("p. %d", r.Page))
+	}
+	if r.Location != "" {
+		meta = append(meta, r.Location)
+	}
+	if r.Color != "" {
+		meta = append(meta, r.Color)
+	}
+	if len(meta) > 0 {
+		fmt.Fprintf(b, "*%s*\n\n", strings.Join(meta, " · "))
+	}
+
+	if r.Comment != "" {
+		fmt.Fprintf(b, "%s\n\n", strings.TrimSpace(r.Comment))
+	}
+	if len(r.Tags) > 0 {
+		tags := make([]string, len(r.Tags))
+		for i, tag := range r.Tags {
+			tags[i] = "#" + strings.ReplaceAll(tag, " ", "-")
+		}
+		fmt.Fprintf(b, "%s\n\n", strings.Join(tags, " "))
+	}
+}
+
+func renderCSV(records []exportRecord) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write([]string{"library", "article_title", "doi", "page", "location", "color", "quote", "comment", "tags", "shared", "created_at"}); err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		page := ""
+		if r.Page > 0 {
+			page = strconv.Itoa(int(r.Page))
+		}
+		err := w.Write([]string{
+			r.Library, r.ArticleTitle, r.DOI, page, r.Location, r.Color, r.Quote, r.Comment,
+			strings.Join(r.Tags, ";"), strconv.FormatBool(r.Shared), r.CreatedAt.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return b.Bytes(), w.Error()
+}
+
+// colorName returns the lower-case colour name, or "" when unspecified.
+func colorName(color annotation.AnnotationColor) string {
+	if color == annotation.AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(color.String(), "ANNOTATION_COLOR_"))
+}
@@ -0,0 +1,51 @@
+package annotation
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+)
+
+type GrpcHandler struct {
+	annotation.UnimplementedAnnotationServiceServer
+	service AnnotationServiceInterface
+}
+
+func NewAnnotationGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewAnnotationService(conn),
+	}
+}
+
+func (h *GrpcHandler) CreateAnnotation(ctx context.Context, request *annotation.CreateAnnotationRequest) (*annotation.CreateAnnotationResponse, error) {
+	return h.service.CreateAnnotation(ctx, request)
+}
+
+func (h *GrpcHandler) GetAnnotation(ctx context.Context, request *annotation.GetAnnotationRequest) (*annotation.GetAnnotationResponse, error) {
+	return h.service.GetAnnotation(ctx, request)
+}
+
+func (h *GrpcHandler) ListAnnotations(ctx context.Context, request *annotation.ListAnnotationsRequest) (*annotation.ListAnnotationsResponse, error) {
+	return h.service.ListAnnotations(ctx, request)
+}
+
+func (h *GrpcHandler) UpdateAnnotation(ctx context.Context, request *annotation.UpdateAnnotationRequest) (*annotation.UpdateAnnotationResponse, error) {
+	return h.service.UpdateAnnotation(ctx, request)
+}
+
+func (h *GrpcHandler) DeleteAnnotation(ctx context.Context, request *annotation.DeleteAnnotationRequest) (*annotation.DeleteAnnotationResponse, error) {
+	return h.service.DeleteAnnotation(ctx, request)
+}
+
+func (h *GrpcHandler) SearchAnnotations(ctx context.Context, request *annotation.SearchAnnotationsRequest) (*annotation.SearchAnnotationsResponse, error) {
+	return h.service.SearchAnnotations(ctx, request)
+}
+
+func (h *GrpcHandler) ExportAnnotations(ctx context.Context, request *annotation.ExportAnnotationsRequest) (*annotation.ExportAnnotationsResponse, error) {
+	return h.service.ExportAnnotations(ctx, request)
+}
+
+func (h *GrpcHandler) ExportNotes(request *annotation.ExportNotesRequest, stream annotation.AnnotationService_ExportNotesServer) error {
+	return h.service.ExportNotes(request, stream)
+}
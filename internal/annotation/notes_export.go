@@ -0,0 +1,309 @@
+package annotation
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the size of the zip pieces streamed to the client.
+const exportChunkSize = 64 * 1024
+
+// noteFile is everything rendered into one article's Markdown file.
+type noteFile struct {
+	Title         string
+	DOI           string
+	Authors       []string
+	Year          int32
+	Journal       string
+	Tags          []string
+	Status        library.ReadingStatus
+	Favorite      bool
+	DateAdded     time.Time
+	DateCompleted time.Time
+	Notes         string
+	Annotations   []exportRecord
+}
+
+// ExportNotes streams a zip archive of a library with one Markdown file per
+// saved article, in the layout Obsidian and similar tools expect: YAML front
+// matter with the article's metadata followed by notes and annotations.
+func (s *AnnotationService) ExportNotes(request *annotation.ExportNotesRequest, stream annotation.AnnotationService_ExportNotesServer) error {
+	ctx := stream.Context()
+
+	lib, err := s.queries.GetLibrary(ctx, request.LibraryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "library not found")
+		}
+		slog.Error("failed to get library", "error", err)
+		return status.Error(codes.Internal, "failed to get library")
+	}
+	if lib.OwnerID != request.UserId {
+		return status.Error(codes.PermissionDenied, "only the library owner can export its notes")
+	}
+
+	files, err := s.loadNoteFiles(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	libraryName := slugify(lib.Name.String)
+	if libraryName == "" {
+		libraryName = fmt.Sprintf("library-%d", lib.ID)
+	}
+
+	w := &chunkWriter{
+		send:     stream.Send,
+		filename: libraryName + "-notes.zip",
+	}
+	if err := writeNotesArchive(w, libraryName, files, s.now()); err != nil {
+		slog.Error("failed to write notes archive", "error", err)
+		return status.Error(codes.Internal, "failed to export notes")
+	}
+	if err := w.Flush(); err != nil {
+		slog.Error("failed to send notes archive", "error", err)
+		return status.Error(codes.Internal, "failed to export notes")
+	}
+	return nil
+}
+
+func (s *AnnotationService) loadNoteFiles(ctx context.Context, request *annotation.ExportNotesRequest) ([]noteFile, error) {
+	articles, err := s.queries.ListLibraryArticlesForNotesExport(ctx, request.LibraryId)
+	if err != nil {
+		slog.Error("failed to list library articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list library articles")
+	}
+	authors, err := s.queries.ListArticleAuthorsForLibrary(ctx, request.LibraryId)
+	if err != nil {
+		slog.Error("failed to list article authors", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list article authors")
+	}
+	tags, err := s.queries.ListArticleTagsForLibrary(ctx, request.LibraryId)
+	if err != nil {
+		slog.Error("failed to list article tags", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list article tags")
+	}
+	annotationRows, err := s.queries.ListAnnotationsForExport(ctx, db.ListAnnotationsForExportParams{
+		AuthorID:  request.UserId,
+		LibraryID: sql.NullInt64{Int64: request.LibraryId, Valid: true},
+	})
+	if err != nil {
+		slog.Error("failed to list annotations for export", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list annotations")
+	}
+	grpcAnnotations := make([]*annotation.Annotation, len(annotationRows))
+	for i, row := range annotationRows {
+		grpcAnnotations[i] = dbToGrpcAnnotation(row.Annotation, row.LibraryID, row.ArticleID)
+	}
+	if err := s.attachTags(ctx, grpcAnnotations...); err != nil {
+		return nil, err
+	}
+
+	authorsByArticle := make(map[int64][]string)
+	for _, row := range authors {
+		authorsByArticle[row.ArticleID] = append(authorsByArticle[row.ArticleID], row.Name)
+	}
+	tagsByArticle := make(map[int64][]string)
+	for _, row := range tags {
+		tagsByArticle[row.ArticleID] = append(tagsByArticle[row.ArticleID], row.Name)
+	}
+	annotationsByEntry := make(map[int64][]exportRecord)
+	for i, row := range annotationRows {
+		annotationsByEntry[row.Annotation.LibraryArticleID] = append(annotationsByEntry[row.Annotation.LibraryArticleID], exportRecord{
+			Quote:    row.Annotation.Quote.String,
+			Comment:  row.Annotation.Comment.String,
+			Page:     row.Annotation.Page.Int32,
+			Location: row.Annotation.Location.String,
+			Color:    colorName(annotation.AnnotationColor(row.Annotation.Color)),
+			Tags:     grpcAnnotations[i].Tags,
+		})
+	}
+
+	files := make([]noteFile, len(articles))
+	for i, a := range articles {
+		files[i] = noteFile{
+			Title:         a.Title,
+			DOI:           a.Doi,
+			Authors:       authorsByArticle[a.ArticleID],
+			Year:          a.PublicationYear.Int32,
+			Journal:       a.JournalName.String,
+			Tags:          tagsByArticle[a.ArticleID],
+			Status:        library.ReadingStatus(a.ReadingStatus.Int16),
+			Favorite:      a.Isfavorite.Bool,
+			DateAdded:     a.Dateadded.Time,
+			DateCompleted: a.Datecompleted.Time,
+			Notes:         a.Notes.String,
+			Annotations:   annotationsByEntry[a.ID],
+		}
+	}
+	return files, nil
+}
+
+// writeNotesArchive writes one Markdown file per article into a folder named
+// after the library. Articles whose titles slugify to the same name get a
+// numeric suffix.
+func writeNotesArchive(w *chunkWriter, folder string, files []noteFile, modified time.Time) error {
+	archive := zip.NewWriter(w)
+	used := make(map[string]int)
+	for _, file := range files {
+		name := slugify(file.Title)
+		if name == "" {
+			name = "untitled"
+		}
+		used[name]++
+		if n := used[name]; n > 1 {
+			name = fmt.Sprintf("%s-%d", name, n)
+		}
+
+		entry, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     folder + "/" + name + ".md",
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := entry.Write(renderNoteFile(file)); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func renderNoteFile(file noteFile) []byte {
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "title: %s\n", yamlString(file.Title))
+	fmt.Fprintf(&b, "doi: %s\n", yamlString(file.DOI))
+	writeYAMLList(&b, "authors", file.Authors)
+	if file.Year > 0 {
+		fmt.Fprintf(&b, "year: %d\n", file.Year)
+	}
+	if file.Journal != "" {
+		fmt.Fprintf(&b, "journal: %s\n", yamlString(file.Journal))
+	}
+	writeYAMLList(&b, "tags", file.Tags)
+	if file.Status != library.ReadingStatus_READING_STATUS_UNSPECIFIED {
+		fmt.Fprintf(&b, "status: %s\n", statusName(file.Status))
+	}
+	if file.Favorite {
+		b.WriteString("favorite: true\n")
+	}
+	if !file.DateAdded.IsZero() {
+		fmt.Fprintf(&b, "added: %s\n", file.DateAdded.Format(time.DateOnly))
+	}
+	if !file.DateCompleted.IsZero() {
+		fmt.Fprintf(&b, "completed: %s\n", file.DateCompleted.Format(time.DateOnly))
+	}
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s\n", file.Title)
+	if notes := strings.TrimSpace(file.Notes); notes != "" {
+		fmt.Fprintf(&b, "\n## Notes\n\n%s\n", notes)
+	}
+	if len(file.Annotations) > 0 {
+		b.WriteString("\n## Annotations\n")
+		for _, r := range file.Annotations {
+			b.WriteString("\n")
+			writeMarkdownAnnotation(&b, r)
+		}
+	}
+	return b.Bytes()
+}
+
+func writeYAMLList(b *bytes.Buffer, key string, values []string) {
+	if len(values) == 0 {
+		fmt.Fprintf(b, "%s: []\n", key)
+		return
+	}
+	fmt.Fprintf(b, "%s:\n", key)
+	for _, v := range values {
+		fmt.Fprintf(b, "  - %s\n", yamlString(v))
+	}
+}
+
+// yamlString quotes s as a YAML double-quoted scalar. Go's escapes are a
+// subset of the ones YAML accepts there.
+func yamlString(s string) string {
+	return strconv.Quote(s)
+}
+
+// statusName returns the reading status in kebab case, e.g. "to-read".
+func statusName(readingStatus library.ReadingStatus) string {
+	name := strings.TrimPrefix(readingStatus.String(), "READING_STATUS_")
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+}
+
+// slugify turns a title into a file name safe on every common file system.
+func slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if runes := []rune(slug); len(runes) > 80 {
+		slug = strings.TrimSuffix(string(runes[:80]), "-")
+	}
+	return slug
+}
+
+// chunkWriter buffers the archive and sends it in exportChunkSize pieces.
+type chunkWriter struct {
+	send     func(*annotation.ExportNotesChunk) error
+	filename string
+	buf      []byte
+	sent     bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.sendChunk(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends whatever is left in the buffer. An empty archive still sends
+// one chunk so the client receives the filename.
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	err := w.sendChunk(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *chunkWriter) sendChunk(data []byte) error {
+	chunk := &annotation.ExportNotesChunk{Data: append([]byte(nil), data...)}
+	if !w.sent {
+		chunk.Filename = w.filename
+		w.sent = true
+	}
+	return w.send(chunk)
+}
@@ -0,0 +1,82 @@
+package annotation
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderNoteFile(t *testing.T) {
+	file := noteFile{
+		Title:     `Attention: "all" you need`,
+		DOI:       "10.1/a",
+		Authors:   []string{"Vaswani, A."},
+		Year:      2017,
+		Status:    library.ReadingStatus_READING_STATUS_TO_READ,
+		DateAdded: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		Notes:     "Read section 3 again.",
+		Annotations: []exportRecord{
+			{Quote: "scaled dot-product", Page: 4},
+		},
+	}
+
+	expected := "---\n" +
+		"title: \"Attention: \\\"all\\\" you need\"\n" +
+		"doi: \"10.1/a\"\n" +
+		"authors:\n  - \"Vaswani, A.\"\n" +
+		"year: 2017\n" +
+		"tags: []\n" +
+		"status: to-read\n" +
+		"added: 2025-03-01\n" +
+		"---\n\n" +
+		"# Attention: \"all\" you need\n" +
+		"\n## Notes\n\nRead section 3 again.\n" +
+		"\n## Annotations\n" +
+		"\n> scaled dot-product\n\n*p. 4*\n\n"
+	assert.Equal(t, expected, string(renderNoteFile(file)))
+}
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "attention-is-all-you-need", slugify("Attention Is All You Need!"))
+	assert.Equal(t, "über-graphs-2", slugify("  Über: graphs (2) "))
+	assert.Equal(t, "", slugify("?!"))
+}
+
+func TestWriteNotesArchiveStreamsChunks(t *testing.T) {
+	var chunks []*annotation.ExportNotesChunk
+	w := &chunkWriter{
+		send: func(chunk *annotation.ExportNotesChunk) error {
+			chunks = append(chunks, chunk)
+			return nil
+		},
+		filename: "thesis-notes.zip",
+	}
+
+	files := []noteFile{{Title: "Same title"}, {Title: "Same title"}}
+	assert.NoError(t, writeNotesArchive(w, "thesis", files, time.Now()))
+	assert.NoError(t, w.Flush())
+
+	assert.Equal(t, "thesis-notes.zip", chunks[0].Filename)
+	var archive []byte
+	for _, chunk := range chunks {
+		archive = append(archive, chunk.Data...)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	assert.NoError(t, err)
+	assert.Len(t, reader.File, 2)
+	assert.Equal(t, "thesis/same-title.md", reader.File[0].Name)
+	assert.Equal(t, "thesis/same-title-2.md", reader.File[1].Name)
+
+	f, err := reader.File[0].Open()
+	assert.NoError(t, err)
+	content, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "# Same title\n")
+}
@@ -0,0 +1,75 @@
+package adminapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/chiquitav2/journalful/internal/api"
+	"github.com/chiquitav2/journalful/internal/metrics"
+	"github.com/chiquitav2/journalful/pkg/conf"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// Server serves operational endpoints, currently the Prometheus metrics, on
+// a port separate from the API.
+type Server struct {
+	api.ApiModule
+	server *http.Server
+}
+
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Register sets up the admin routes.
+func (s *Server) Register() error {
+	s.server = &http.Server{
+		Handler:           newHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return nil
+}
+
+// newHandler serves the metrics registry in the Prometheus text format at
+// /metrics.
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorLog:      slog.NewLogLogger(slog.Default().Handler(), slog.LevelError),
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
+	return mux
+}
+
+// Start serves the admin endpoints until Stop is called.
+func (s *Server) Start(cfg *conf.Config) error {
+	addr := net.JoinHostPort(cfg.Admin.Host, cfg.Admin.Port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	slog.Info("admin server starting", "address", lis.Addr().String())
+	if err := s.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve admin endpoints: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) Stop() {
+	slog.Info("stopping admin server")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		slog.Error("failed to shut down admin server", "error", err)
+	}
+	slog.Info("admin server stopped")
+}
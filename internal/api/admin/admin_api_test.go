@@ -0,0 +1,59 @@
+package adminapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/internal/metrics"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAdminServesMetrics(t *testing.T) {
+	metrics.ObserveRPC("/api.library.v1.LibraryService/GetLibrary", false, codes.NotFound, 20*time.Millisecond)
+	metrics.ObserveRPC("/api.library.v1.LibraryService/WatchLibrary", true, codes.OK, time.Minute)
+	metrics.ObserveMetadataRequest("crossref", metrics.ResultError, time.Second)
+
+	rec := httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	body := rec.Body.String()
+	assert.Contains(t, body, `journalful_grpc_requests_total{code="NotFound",method="GetLibrary",service="api.library.v1.LibraryService",type="unary"} 1`)
+	assert.Contains(t, body, `journalful_grpc_request_duration_seconds_count{method="WatchLibrary",service="api.library.v1.LibraryService",type="stream"} 1`)
+	assert.Contains(t, body, `journalful_metadata_requests_total{provider="crossref",result="error"} 1`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestAdminServesDatabaseMetrics(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("CountCatalog", db.CountCatalogRow{Articles: 12, Libraries: 3, Profiles: 4})
+	conn.SetMaxOpenConns(8)
+	assert.NoError(t, metrics.RegisterDB(conn))
+
+	rec := httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, `go_sql_max_open_connections{db_name="journalful"} 8`)
+	assert.Contains(t, body, `go_sql_open_connections{db_name="journalful"}`)
+	assert.Contains(t, body, "journalful_articles 12\n")
+	assert.Contains(t, body, "journalful_libraries 3\n")
+	assert.Contains(t, body, "journalful_profiles 4\n")
+}
+
+func TestAdminOnlyServesMetrics(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/metrics", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	newHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
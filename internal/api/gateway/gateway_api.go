@@ -0,0 +1,135 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/chiquitav2/journalful/internal/api"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/openapi"
+	"github.com/chiquitav2/journalful/pkg/profile/v1"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// Server serves the HTTP/JSON transcoding of the gRPC API. Calls are
+// forwarded to the gRPC server rather than to the services directly, so
+// authentication and the interceptors apply exactly as for gRPC clients.
+type Server struct {
+	api.ApiModule
+	config *conf.Config
+	conn   *grpc.ClientConn
+	server *http.Server
+}
+
+func NewServer(cfg *conf.Config) *Server {
+	return &Server{
+		config: cfg,
+	}
+}
+
+// Register connects to the gRPC server and sets up the REST routes.
+func (s *Server) Register() error {
+	// The gRPC server always serves TLS with this certificate; trusting it
+	// directly keeps self-signed development certificates working.
+	creds, err := credentials.NewClientTLSFromFile(s.config.Server.CertFile, "")
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	endpoint := net.JoinHostPort(s.config.Server.Host, s.config.Server.Port)
+	conn, err := grpc.NewClient(endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create gRPC client for %s: %w", endpoint, err)
+	}
+	s.conn = conn
+
+	handler, err := newHandler(context.Background(), conn)
+	if err != nil {
+		return err
+	}
+	s.server = &http.Server{
+		Handler:           otelhttp.NewHandler(handler, "gateway"),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return nil
+}
+
+// newHandler routes /v1/ to the transcoded services and serves the OpenAPI
+// document at /openapi.json.
+func newHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	gateway := runtime.NewServeMux()
+	registrations := []struct {
+		name     string
+		register func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error
+	}{
+		{"ArticlesService", article.RegisterArticlesServiceHandler},
+		{"AuthorService", profile.RegisterAuthorServiceHandler},
+		{"ProfileService", profile.RegisterProfileServiceHandler},
+		{"LibraryService", library.RegisterLibraryServiceHandler},
+	}
+	for _, r := range registrations {
+		if err := r.register(ctx, gateway, conn); err != nil {
+			return nil, fmt.Errorf("failed to register %s gateway: %w", r.name, err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gateway)
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(openapi.Document); err != nil {
+			slog.Warn("failed to write OpenAPI document", "error", err)
+		}
+	})
+	return mux, nil
+}
+
+// Start serves the gateway until Stop is called.
+func (s *Server) Start(cfg *conf.Config) error {
+	addr := fmt.Sprintf(":%s", cfg.Gateway.Port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	slog.Info("HTTP gateway starting", "address", lis.Addr().String())
+	if cfg.Server.UseTLS {
+		err = s.server.ServeTLS(lis, cfg.Server.CertFile, cfg.Server.KeyFile)
+	} else {
+		err = s.server.Serve(lis)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve HTTP gateway: %w", err)
+	}
+	return nil
+}
+
+// Stop waits for in-flight requests before closing the gRPC connection.
+func (s *Server) Stop() {
+	slog.Info("stopping HTTP gateway")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		slog.Error("failed to shut down HTTP gateway", "error", err)
+	}
+	if err := s.conn.Close(); err != nil {
+		slog.Error("failed to close gRPC connection", "error", err)
+	}
+	slog.Info("HTTP gateway stopped")
+}
@@ -0,0 +1,47 @@
+package gatewayapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chiquitav2/journalful/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newTestHandler(t *testing.T) http.Handler {
+	conn, err := grpc.NewClient("localhost:0", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	handler, err := newHandler(context.Background(), conn)
+	assert.NoError(t, err)
+	return handler
+}
+
+func TestGatewayServesOpenAPIDocument(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestHandler(t).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, openapi.Document, rec.Body.Bytes())
+	assert.Contains(t, string(openapi.Document), `"/v1/libraries/{libraryId}/articles"`)
+}
+
+func TestGatewayTranscodesRoutes(t *testing.T) {
+	handler := newTestHandler(t)
+
+	// Path parameters are parsed before the call is forwarded, so a bad id
+	// is rejected without a gRPC server.
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/articles/not-a-number", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/no-such-route", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
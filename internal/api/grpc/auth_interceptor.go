@@ -2,12 +2,23 @@ package grpcapi
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/zitadel/zitadel-go/v3/pkg/authorization"
-	"github.com/zitadel/zitadel-go/v3/pkg/authorization/oauth"
+	"github.com/chiquitav2/journalful/internal/accesstoken"
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
 )
 
@@ -16,41 +27,132 @@ const (
 	bearerPrefix        = "Bearer "
 )
 
+// adminConfirmationResolution limits how often the identity provider's
+// confirmation of the admin role is written back.
+const adminConfirmationResolution = time.Hour
+
+// ProfileResolver returns the profile id of the user the claims are for, or
+// 0 if they have not created a profile yet.
+type ProfileResolver func(ctx context.Context, claims *auth.Claims) (int64, error)
+
+// NewDBProfileResolver resolves profiles from the database. It also records
+// whether the identity provider still grants the admin role, which the
+// user's personal access tokens go by.
+func NewDBProfileResolver(conn *sql.DB) ProfileResolver {
+	queries := db.NewTraced(conn)
+	return func(ctx context.Context, claims *auth.Claims) (int64, error) {
+		p, err := queries.GetProfileByUserID(ctx, claims.UserID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if claims.TokenID != 0 {
+			return p.ID, nil
+		}
+
+		now := time.Now()
+		isAdmin := slices.Contains(claims.Roles, auth.RoleAdmin)
+		switch {
+		case isAdmin && (!p.AdminConfirmedAt.Valid || now.Sub(p.AdminConfirmedAt.Time) >= adminConfirmationResolution):
+			err = queries.ConfirmProfileAdmin(ctx, db.ConfirmProfileAdminParams{
+				ConfirmedAt: sql.NullTime{Time: now, Valid: true},
+				ID:          p.ID,
+			})
+		case !isAdmin && p.AdminConfirmedAt.Valid:
+			err = queries.ClearProfileAdmin(ctx, p.ID)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to record admin role: %w", err)
+		}
+		return p.ID, nil
+	}
+}
+
+// defaultPublicMethods can always be called without a token, so load
+// balancers can probe the server and tools like grpcurl can list services.
+var defaultPublicMethods = []string{
+	healthpb.Health_Check_FullMethodName,
+	healthpb.Health_List_FullMethodName,
+	healthpb.Health_Watch_FullMethodName,
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName,
+	reflectionpbalpha.ServerReflection_ServerReflectionInfo_FullMethodName,
+}
+
+// AuthInterceptor authenticates identity provider tokens with authenticator
+// and personal access tokens with accessTokens. Calls to public methods
+// without a token get an anonymous principal.
 type AuthInterceptor struct {
-	authorizer authorization.Authorizer[*oauth.IntrospectionContext]
+	authenticator auth.Authenticator
+	accessTokens  auth.Authenticator
+	profiles      ProfileResolver
+	publicMethods map[string]bool
 }
 
-func NewAuthInterceptor(authorizer authorization.Authorizer[*oauth.IntrospectionContext]) *AuthInterceptor {
-	return &AuthInterceptor{authorizer: authorizer}
+func NewAuthInterceptor(authenticator, accessTokens auth.Authenticator, profiles ProfileResolver, publicMethods []string) *AuthInterceptor {
+	public := make(map[string]bool)
+	for _, method := range append(slices.Clone(defaultPublicMethods), publicMethods...) {
+		public[method] = true
+	}
+	return &AuthInterceptor{authenticator: authenticator, accessTokens: accessTokens, profiles: profiles, publicMethods: public}
 }
 
 func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		userID, err := i.authorize(ctx)
+		principal, err := i.authorize(ctx, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
 
-		ctx = context.WithValue(ctx, "userID", userID)
-		return handler(ctx, req)
+		return handler(auth.WithPrincipal(ctx, principal), req)
 	}
 }
 
-func (i *AuthInterceptor) authorize(ctx context.Context) (string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		principal, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: auth.WithPrincipal(ctx, principal)})
 	}
+}
 
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (*auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
 	values := md.Get(authorizationHeader)
 	if len(values) == 0 {
-		return "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+		if i.publicMethods[method] {
+			return auth.Anonymous(), nil
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	}
+	token, ok := strings.CutPrefix(values[0], bearerPrefix)
+	if !ok || token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization header must be a bearer token")
+	}
+
+	authenticator := i.authenticator
+	if accesstoken.IsAccessToken(token) {
+		authenticator = i.accessTokens
+	}
+	claims, err := authenticator.Authenticate(ctx, token)
+	if errors.Is(err, auth.ErrInvalidToken) {
+		return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+	}
+	if err != nil {
+		slog.Error("failed to authenticate request", "error", err)
+		return nil, status.Error(codes.Unavailable, "authentication is unavailable")
 	}
 
-	claims, err := i.authorizer.CheckAuthorization(ctx, values[0])
+	profileID, err := i.profiles(ctx, claims)
 	if err != nil {
-		return "", status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+		slog.Error("failed to resolve profile", "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve profile")
 	}
 
-	return claims.UserID(), nil
+	return auth.NewPrincipal(claims, profileID), nil
 }
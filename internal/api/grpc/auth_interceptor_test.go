@@ -0,0 +1,108 @@
+package grpcapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type staticAuthenticator map[string]*auth.Claims
+
+func (a staticAuthenticator) Authenticate(_ context.Context, token string) (*auth.Claims, error) {
+	claims, ok := a[token]
+	if !ok {
+		return nil, auth.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func newTestAuthInterceptor(publicMethods ...string) grpc.UnaryServerInterceptor {
+	authenticator := staticAuthenticator{"good": {UserID: "user-1"}}
+	profiles := func(context.Context, *auth.Claims) (int64, error) { return 5, nil }
+	return NewAuthInterceptor(authenticator, staticAuthenticator{}, profiles, publicMethods).Unary()
+}
+
+func callWithToken(interceptor grpc.UnaryServerInterceptor, method, token string) (*auth.Principal, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, bearerPrefix+token))
+	}
+	var principal *auth.Principal
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal, _ = auth.PrincipalFromContext(ctx)
+		return nil, nil
+	})
+	return principal, err
+}
+
+func TestAuthInterceptorRequiresToken(t *testing.T) {
+	interceptor := newTestAuthInterceptor()
+
+	_, err := callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "bad")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	principal, err := callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "good")
+	assert.NoError(t, err)
+	assert.Equal(t, &auth.Principal{UserID: "user-1", ProfileID: 5}, principal)
+}
+
+func TestAuthInterceptorPublicMethods(t *testing.T) {
+	interceptor := newTestAuthInterceptor(library.LibraryService_GetLibrary_FullMethodName)
+
+	principal, err := callWithToken(interceptor, healthpb.Health_Check_FullMethodName, "")
+	assert.NoError(t, err)
+	assert.True(t, principal.IsAnonymous())
+
+	principal, err = callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "")
+	assert.NoError(t, err)
+	assert.True(t, principal.IsAnonymous())
+
+	principal, err = callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "good")
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", principal.UserID, "tokens sent to public methods are still used")
+
+	_, err = callWithToken(interceptor, library.LibraryService_GetLibrary_FullMethodName, "bad")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "and still verified")
+
+	_, err = callWithToken(interceptor, library.LibraryService_DeleteLibrary_FullMethodName, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestDBProfileResolverRecordsAdminRole(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	resolve := NewDBProfileResolver(conn)
+	ctx := context.Background()
+	fake.Return("GetProfileByUserID", db.Profile{ID: 5, UserID: "a"})
+
+	profileID, err := resolve(ctx, &auth.Claims{UserID: "a", Roles: []string{auth.RoleAdmin}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), profileID)
+	assert.Len(t, fake.Calls("ConfirmProfileAdmin"), 1)
+
+	_, err = resolve(ctx, &auth.Claims{UserID: "a", TokenID: 1, Roles: []string{auth.RoleAdmin}})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("ConfirmProfileAdmin"), 1, "access tokens do not confirm the role")
+
+	fake.Return("GetProfileByUserID", db.Profile{ID: 5, UserID: "a", AdminConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}})
+	_, err = resolve(ctx, &auth.Claims{UserID: "a", Roles: []string{auth.RoleAdmin}})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("ConfirmProfileAdmin"), 1, "recent confirmations are not rewritten")
+
+	_, err = resolve(ctx, &auth.Claims{UserID: "a"})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("ClearProfileAdmin"), 1, "signing in without the role clears it")
+}
@@ -7,11 +7,26 @@ import (
 	"log/slog"
 	"net"
 
+	accessTokenImp "github.com/chiquitav2/journalful/internal/accesstoken"
+	annotationImp "github.com/chiquitav2/journalful/internal/annotation"
+	attachmentImp "github.com/chiquitav2/journalful/internal/attachment"
 	"github.com/chiquitav2/journalful/internal/auth"
+	goalsImp "github.com/chiquitav2/journalful/internal/goals"
 	libraryImp "github.com/chiquitav2/journalful/internal/library"
 	profileImp "github.com/chiquitav2/journalful/internal/profile"
+	recommendationImp "github.com/chiquitav2/journalful/internal/recommendation"
+	reviewImp "github.com/chiquitav2/journalful/internal/review"
+	statsImp "github.com/chiquitav2/journalful/internal/stats"
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/chiquitav2/journalful/pkg/accesstoken/v1"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
 	"github.com/chiquitav2/journalful/pkg/library/v1"
 	"github.com/chiquitav2/journalful/pkg/profile/v1"
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+	"github.com/chiquitav2/journalful/pkg/review/v1"
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -29,64 +44,88 @@ import (
 // The name is changed from GrpcService to Server for brevity, as it's in a grpcapi package.
 type Server struct {
 	api.ApiModule
-	dbConn *sql.DB
-	server *grpc.Server
-	health *health.Server
-	config *conf.Config
+	dbConn    *sql.DB
+	blobStore storage.BlobStore
+	server    *grpc.Server
+	health    *health.Server
+	config    *conf.Config
 }
 
 // NewServer creates a new gRPC server.
 // Renamed from NewGrpcService.
-func NewServer(conn *sql.DB, cfg *conf.Config) *Server {
+func NewServer(conn *sql.DB, blobStore storage.BlobStore, cfg *conf.Config) *Server {
 	return &Server{
-		dbConn: conn,
-		health: health.NewServer(), // Initialize health server here.
-		config: cfg,
+		dbConn:    conn,
+		blobStore: blobStore,
+		health:    health.NewServer(), // Initialize health server here.
+		config:    cfg,
 	}
 }
 
 // Register registers all the gRPC services and reflection.
 func (s *Server) Register() error {
-	authorizer, err := auth.NewZitadelAuthorizer(context.Background(), s.config.Zitadel.Domain, s.config.Zitadel.KeyPath)
+	authenticator, err := auth.New(context.Background(), s.config)
 	if err != nil {
-		return fmt.Errorf("failed to create zitadel authorizer: %w", err)
+		return fmt.Errorf("failed to create authenticator: %w", err)
 	}
 
-	authInterceptor := NewAuthInterceptor(*authorizer)
+	authInterceptor := NewAuthInterceptor(authenticator, accessTokenImp.NewAuthenticator(s.dbConn), NewDBProfileResolver(s.dbConn), s.config.Auth.PublicMethods)
+	policyInterceptor := NewPolicyInterceptor(DefaultPolicies)
+	validationInterceptor, err := NewValidationInterceptor()
+	if err != nil {
+		return err
+	}
 
 	creds, err := credentials.NewServerTLSFromFile(s.config.Server.CertFile, s.config.Server.KeyFile)
 	if err != nil {
 		return fmt.Errorf("failed to load TLS keys: %w", err)
 	}
 
-	s.server = grpc.NewServer(
+	s.server = grpc.NewServer(append(
+		interceptorOptions(authInterceptor, policyInterceptor, validationInterceptor),
 		grpc.Creds(creds),
-		grpc.ChainUnaryInterceptor(
-			RecoveryInterceptor,
-			LoggingInterceptor,
-			ErrorInterceptor,
-			authInterceptor.Unary(),
-		),
-	)
+	)...)
+	s.registerServices(s.server)
+
+	return nil
+}
+
+// registerServices registers the API services, health checks and
+// reflection on server.
+func (s *Server) registerServices(server *grpc.Server) {
 	// Enable gRPC reflection for debugging.
-	reflection.Register(s.server)
+	reflection.Register(server)
 
 	// Register services.
-	article.RegisterArticlesServiceServer(s.server, articleImp.NewArticleGrpcHandler(s.dbConn))
-	profile.RegisterAuthorServiceServer(s.server, profileImp.NewProfileGrpcHandler(s.dbConn))
-	profile.RegisterProfileServiceServer(s.server, profileImp.NewProfileGrpcHandler(s.dbConn))
-	library.RegisterLibraryServiceServer(s.server, libraryImp.NewLibraryGrpcHandler(s.dbConn))
+	// Merging articles moves library entries, which library watchers hear about.
+	libraryHandler := libraryImp.NewLibraryGrpcHandler(s.dbConn)
+	article.RegisterArticlesServiceServer(server, articleImp.NewArticleGrpcHandler(s.dbConn, libraryHandler))
+	profile.RegisterAuthorServiceServer(server, profileImp.NewProfileGrpcHandler(s.dbConn))
+	profile.RegisterProfileServiceServer(server, profileImp.NewProfileGrpcHandler(s.dbConn))
+	library.RegisterLibraryServiceServer(server, libraryHandler)
+	stats.RegisterStatsServiceServer(server, statsImp.NewStatsGrpcHandler(s.dbConn))
+	goals.RegisterGoalServiceServer(server, goalsImp.NewGoalGrpcHandler(s.dbConn))
+	review.RegisterReviewServiceServer(server, reviewImp.NewReviewGrpcHandler(s.dbConn))
+	annotation.RegisterAnnotationServiceServer(server, annotationImp.NewAnnotationGrpcHandler(s.dbConn))
+	attachment.RegisterAttachmentServiceServer(server, attachmentImp.NewAttachmentGrpcHandler(s.dbConn, s.blobStore, s.config.Storage))
+	recommendation.RegisterRecommendationServiceServer(server, recommendationImp.NewRecommendationGrpcHandler(s.dbConn))
+	accesstoken.RegisterAccessTokenServiceServer(server, accessTokenImp.NewAccessTokenGrpcHandler(s.dbConn))
 
 	// Register health check service.
-	healthpb.RegisterHealthServer(s.server, s.health)
+	healthpb.RegisterHealthServer(server, s.health)
 	// Set initial status for services.
 	s.health.SetServingStatus("articles.ArticlesService", healthpb.HealthCheckResponse_SERVING)
 	s.health.SetServingStatus("profile.AuthorService", healthpb.HealthCheckResponse_SERVING)
 	s.health.SetServingStatus("profile.ProfileService", healthpb.HealthCheckResponse_SERVING)
 	s.health.SetServingStatus("library.LibraryService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("stats.StatsService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("goals.GoalService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("review.ReviewService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("annotation.AnnotationService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("attachment.AttachmentService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("recommendation.RecommendationService", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus("accesstoken.AccessTokenService", healthpb.HealthCheckResponse_SERVING)
 	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING) // Overall server status.
-
-	return nil
 }
 
 // Start starts the gRPC server.
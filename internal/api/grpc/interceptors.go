@@ -5,9 +5,35 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/chiquitav2/journalful/internal/apierror"
+	"github.com/chiquitav2/journalful/internal/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// MetricsInterceptor counts calls by status code and records how long they
+// took.
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	h, err := handler(ctx, req)
+
+	metrics.ObserveRPC(info.FullMethod, false, status.Code(err), time.Since(start))
+	return h, err
+}
+
+func StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+
+	metrics.ObserveRPC(info.FullMethod, true, status.Code(err), time.Since(start))
+	return err
+}
+
 func LoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	start := time.Now()
 
@@ -23,23 +49,109 @@ func LoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnarySe
 	return h, err
 }
 
+func StreamLoggingInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+
+	slog.Info(
+		"stream",
+		"method", info.FullMethod,
+		"duration", time.Since(start),
+		"error", err,
+	)
+
+	return err
+}
+
+// ErrorInterceptor logs failed calls and maps their errors to statuses
+// with apierror, so every RPC reports errors the same way.
 func ErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	h, err := handler(ctx, req)
 
 	if err != nil {
 		slog.Error("error", "method", info.FullMethod, "error", err)
-		return nil, err
+		return nil, apierror.ToStatus(err)
 	}
 
 	return h, nil
 }
 
-func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+func StreamErrorInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+
+	if err != nil {
+		slog.Error("error", "method", info.FullMethod, "error", err)
+		return apierror.ToStatus(err)
+	}
+
+	return nil
+}
+
+// errPanic is reported for handlers that panicked; the panic is only logged.
+var errPanic = apierror.New(codes.Internal, "internal error")
+
+func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (h interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			slog.Error("panic", "method", info.FullMethod, "panic", r)
+			h, err = nil, apierror.ToStatus(errPanic)
 		}
 	}()
 
 	return handler(ctx, req)
 }
+
+func StreamRecoveryInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("panic", "method", info.FullMethod, "panic", r)
+			err = apierror.ToStatus(errPanic)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// contextStream replaces the context of a server stream, so stream
+// interceptors can pass values to the handler like unary ones do.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// interceptorOptions chains the interceptors every RPC goes through, unary
+// and streaming alike. Metrics come first so they also count calls whose
+// handler panicked. Authentication comes after recovery and logging so
+// rejected calls are logged too, and requests are only validated once the
+// caller is known to be allowed to make them.
+//
+// A stats handler also starts a span for every call but health checks,
+// continuing the trace whose context the caller sent in the metadata.
+func interceptorOptions(authInterceptor *AuthInterceptor, policyInterceptor *PolicyInterceptor, validationInterceptor *ValidationInterceptor) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))),
+		grpc.ChainUnaryInterceptor(
+			MetricsInterceptor,
+			RecoveryInterceptor,
+			LoggingInterceptor,
+			ErrorInterceptor,
+			authInterceptor.Unary(),
+			policyInterceptor.Unary(),
+			validationInterceptor.Unary(),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamMetricsInterceptor,
+			StreamRecoveryInterceptor,
+			StreamLoggingInterceptor,
+			StreamErrorInterceptor,
+			authInterceptor.Stream(),
+			policyInterceptor.Stream(),
+			validationInterceptor.Stream(),
+		),
+	}
+}
@@ -0,0 +1,211 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/metrics"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// panicLibraryID makes watchServer panic, to exercise recovery.
+const panicLibraryID = 666
+
+// watchServer streams a single event telling who the caller is.
+type watchServer struct {
+	library.UnimplementedLibraryServiceServer
+}
+
+func (watchServer) WatchLibrary(request *library.WatchLibraryRequest, stream library.LibraryService_WatchLibraryServer) error {
+	if request.LibraryId == panicLibraryID {
+		panic("boom")
+	}
+	event := &library.LibraryEvent{LibraryId: request.LibraryId}
+	if principal, ok := auth.PrincipalFromContext(stream.Context()); ok && !principal.IsAnonymous() {
+		event.ArticleId = principal.ProfileID
+	}
+	return stream.Send(event)
+}
+
+// newBufconnClient serves watchServer behind the production interceptor
+// chain on an in-memory listener.
+func newBufconnClient(t *testing.T, publicMethods ...string) library.LibraryServiceClient {
+	authenticator := staticAuthenticator{"good": {UserID: "user-1"}}
+	profiles := func(context.Context, *auth.Claims) (int64, error) { return 5, nil }
+	validationInterceptor, err := NewValidationInterceptor()
+	assert.NoError(t, err)
+	server := grpc.NewServer(interceptorOptions(
+		NewAuthInterceptor(authenticator, staticAuthenticator{}, profiles, publicMethods),
+		NewPolicyInterceptor(DefaultPolicies),
+		validationInterceptor,
+	)...)
+	library.RegisterLibraryServiceServer(server, watchServer{})
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return library.NewLibraryServiceClient(conn)
+}
+
+func watch(client library.LibraryServiceClient, libraryID int64, token string) (*library.LibraryEvent, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearerPrefix+token)
+	}
+	stream, err := client.WatchLibrary(ctx, &library.WatchLibraryRequest{LibraryId: libraryID})
+	if err != nil {
+		return nil, err
+	}
+	return stream.Recv()
+}
+
+func TestStreamInterceptorsAuthenticate(t *testing.T) {
+	client := newBufconnClient(t)
+
+	_, err := watch(client, 1, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = watch(client, 1, "bad")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	event, err := watch(client, 1, "good")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), event.ArticleId, "the handler sees the principal")
+}
+
+func TestStreamInterceptorsAllowPublicMethods(t *testing.T) {
+	client := newBufconnClient(t, library.LibraryService_WatchLibrary_FullMethodName)
+
+	event, err := watch(client, 1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), event.ArticleId)
+}
+
+func TestStreamInterceptorsRecoverPanics(t *testing.T) {
+	client := newBufconnClient(t)
+
+	_, err := watch(client, panicLibraryID, "good")
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = watch(client, 1, "good")
+	assert.NoError(t, err, "the server keeps serving")
+}
+
+func TestRecoveryInterceptorReturnsInternal(t *testing.T) {
+	_, err := RecoveryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Panic"}, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+// rpcRequests reads the call counter the metrics interceptors keep for a
+// method and status code.
+func rpcRequests(t *testing.T, fullMethod string, code codes.Code) float64 {
+	t.Helper()
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	families, err := metrics.Registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "journalful_grpc_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["service"] == service && labels["method"] == method && labels["code"] == code.String() {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestMetricsInterceptorCountsStatusCodes(t *testing.T) {
+	const method = "/test.v1.MetricsService/Get"
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	notFound, ok := rpcRequests(t, method, codes.NotFound), rpcRequests(t, method, codes.OK)
+
+	_, err := MetricsInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = MetricsInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return "found", nil
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, notFound+1, rpcRequests(t, method, codes.NotFound))
+	assert.Equal(t, ok+1, rpcRequests(t, method, codes.OK))
+}
+
+func TestStreamMetricsCountFailuresOfInnerInterceptors(t *testing.T) {
+	client := newBufconnClient(t)
+	method := library.LibraryService_WatchLibrary_FullMethodName
+	unauthenticated, internal := rpcRequests(t, method, codes.Unauthenticated), rpcRequests(t, method, codes.Internal)
+
+	_, err := watch(client, 1, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = watch(client, panicLibraryID, "good")
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The server records a stream after the client has seen its status.
+	assert.Eventually(t, func() bool {
+		return rpcRequests(t, method, codes.Unauthenticated) == unauthenticated+1 &&
+			rpcRequests(t, method, codes.Internal) == internal+1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestServerContinuesCallerTrace(t *testing.T) {
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	client := newBufconnClient(t)
+
+	// As forwarded by the web frontend from its incoming request.
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		authorizationHeader, bearerPrefix+"good",
+		"traceparent", "00-"+traceID+"-00f067aa0ba902b7-01",
+	)
+	stream, err := client.WatchLibrary(ctx, &library.WatchLibraryRequest{LibraryId: 1})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	assert.Eventually(t, func() bool { return len(recorder.Ended()) > 0 }, time.Second, 10*time.Millisecond)
+	span := recorder.Ended()[0]
+	assert.Equal(t, "api.library.v1.LibraryService/WatchLibrary", span.Name())
+	assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+	assert.True(t, span.Parent().IsRemote())
+}
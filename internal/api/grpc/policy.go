@@ -0,0 +1,270 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/pkg/accesstoken/v1"
+	"github.com/chiquitav2/journalful/pkg/annotation/v1"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/profile/v1"
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+	"github.com/chiquitav2/journalful/pkg/review/v1"
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultPolicies lists the RPCs that need more than an authenticated
+// caller. RPCs not listed are open to every authenticated user. Whatever
+// the RPC, the PolicyInterceptor only lets admins name another user's
+// profile in user_id or owner_id, or as the id of a profile to update or
+// delete; other records addressed by their own id are only checked where
+// the service looks at their owner.
+var DefaultPolicies = map[string]auth.Policy{
+	article.ArticlesService_DeleteArticle_FullMethodName: auth.AdminOnly,
+	article.ArticlesService_MergeArticles_FullMethodName: auth.AdminOnly,
+	profile.AuthorService_DeleteAuthor_FullMethodName:    auth.AdminOnly,
+	profile.ProfileService_ListProfiles_FullMethodName:   auth.AdminOnly,
+}
+
+// accessTokenScopes is the scope a personal access token needs to call each
+// method. Tokens cannot call methods missing here; a test checks that every
+// registered method is listed.
+var accessTokenScopes = map[string]string{
+	accesstoken.AccessTokenService_CreateAccessToken_FullMethodName: auth.ScopeWrite,
+	accesstoken.AccessTokenService_ListAccessTokens_FullMethodName:  auth.ScopeRead,
+	accesstoken.AccessTokenService_RevokeAccessToken_FullMethodName: auth.ScopeWrite,
+
+	annotation.AnnotationService_CreateAnnotation_FullMethodName:  auth.ScopeWrite,
+	annotation.AnnotationService_GetAnnotation_FullMethodName:     auth.ScopeRead,
+	annotation.AnnotationService_ListAnnotations_FullMethodName:   auth.ScopeRead,
+	annotation.AnnotationService_UpdateAnnotation_FullMethodName:  auth.ScopeWrite,
+	annotation.AnnotationService_DeleteAnnotation_FullMethodName:  auth.ScopeWrite,
+	annotation.AnnotationService_SearchAnnotations_FullMethodName: auth.ScopeRead,
+	annotation.AnnotationService_ExportAnnotations_FullMethodName: auth.ScopeRead,
+	annotation.AnnotationService_ExportNotes_FullMethodName:       auth.ScopeRead,
+
+	article.ArticlesService_GetArticle_FullMethodName:       auth.ScopeRead,
+	article.ArticlesService_GetArticleByDOI_FullMethodName:  auth.ScopeRead,
+	article.ArticlesService_ListArticles_FullMethodName:     auth.ScopeRead,
+	article.ArticlesService_CreateArticle_FullMethodName:    auth.ScopeWrite,
+	article.ArticlesService_UpdateArticle_FullMethodName:    auth.ScopeWrite,
+	article.ArticlesService_DeleteArticle_FullMethodName:    auth.ScopeAdmin,
+	article.ArticlesService_SearchArticles_FullMethodName:   auth.ScopeRead,
+	article.ArticlesService_ListReferences_FullMethodName:   auth.ScopeRead,
+	article.ArticlesService_ListCitedBy_FullMethodName:      auth.ScopeRead,
+	article.ArticlesService_GetCitationGraph_FullMethodName: auth.ScopeRead,
+	article.ArticlesService_FindDuplicates_FullMethodName:   auth.ScopeRead,
+	article.ArticlesService_MergeArticles_FullMethodName:    auth.ScopeAdmin,
+
+	attachment.AttachmentService_UploadAttachment_FullMethodName:   auth.ScopeWrite,
+	attachment.AttachmentService_DownloadAttachment_FullMethodName: auth.ScopeRead,
+	attachment.AttachmentService_GetAttachment_FullMethodName:      auth.ScopeRead,
+	attachment.AttachmentService_ListAttachments_FullMethodName:    auth.ScopeRead,
+	attachment.AttachmentService_DeleteAttachment_FullMethodName:   auth.ScopeWrite,
+	attachment.AttachmentService_GetStorageUsage_FullMethodName:    auth.ScopeRead,
+	attachment.AttachmentService_RetryExtraction_FullMethodName:    auth.ScopeWrite,
+
+	goals.GoalService_CreateGoal_FullMethodName:      auth.ScopeWrite,
+	goals.GoalService_GetGoal_FullMethodName:         auth.ScopeRead,
+	goals.GoalService_ListGoals_FullMethodName:       auth.ScopeRead,
+	goals.GoalService_UpdateGoal_FullMethodName:      auth.ScopeWrite,
+	goals.GoalService_DeleteGoal_FullMethodName:      auth.ScopeWrite,
+	goals.GoalService_GetGoalProgress_FullMethodName: auth.ScopeRead,
+
+	library.LibraryService_SaveArticleToLibrary_FullMethodName:             auth.ScopeLibraryWrite,
+	library.LibraryService_AddByIdentifier_FullMethodName:                  auth.ScopeLibraryWrite,
+	library.LibraryService_GetUserLibrary_FullMethodName:                   auth.ScopeRead,
+	library.LibraryService_ListLibrarySummaries_FullMethodName:             auth.ScopeRead,
+	library.LibraryService_ListLibraryArticles_FullMethodName:              auth.ScopeRead,
+	library.LibraryService_GetLibrary_FullMethodName:                       auth.ScopeRead,
+	library.LibraryService_CreateLibrary_FullMethodName:                    auth.ScopeLibraryWrite,
+	library.LibraryService_UpdateLibrary_FullMethodName:                    auth.ScopeLibraryWrite,
+	library.LibraryService_DeleteLibrary_FullMethodName:                    auth.ScopeLibraryWrite,
+	library.LibraryService_UpdateLibraryArticle_FullMethodName:             auth.ScopeLibraryWrite,
+	library.LibraryService_RemoveArticleFromLibrary_FullMethodName:         auth.ScopeLibraryWrite,
+	library.LibraryService_RecordArticleOpened_FullMethodName:              auth.ScopeLibraryWrite,
+	library.LibraryService_ListReadingActivity_FullMethodName:              auth.ScopeRead,
+	library.LibraryService_ListFlaggedArticlesInMyLibraries_FullMethodName: auth.ScopeRead,
+	library.LibraryService_WatchLibrary_FullMethodName:                     auth.ScopeRead,
+
+	profile.AuthorService_GetAuthor_FullMethodName:            auth.ScopeRead,
+	profile.AuthorService_GetAuthorByProfileID_FullMethodName: auth.ScopeRead,
+	profile.AuthorService_ListAuthors_FullMethodName:          auth.ScopeRead,
+	profile.AuthorService_CreateAuthor_FullMethodName:         auth.ScopeWrite,
+	profile.AuthorService_UpdateAuthor_FullMethodName:         auth.ScopeWrite,
+	profile.AuthorService_DeleteAuthor_FullMethodName:         auth.ScopeAdmin,
+
+	profile.ProfileService_GetProfile_FullMethodName:    auth.ScopeRead,
+	profile.ProfileService_ListProfiles_FullMethodName:  auth.ScopeAdmin,
+	profile.ProfileService_CreateProfile_FullMethodName: auth.ScopeWrite,
+	profile.ProfileService_UpdateProfile_FullMethodName: auth.ScopeWrite,
+	profile.ProfileService_DeleteProfile_FullMethodName: auth.ScopeWrite,
+
+	recommendation.RecommendationService_GetSimilarArticles_FullMethodName:        auth.ScopeRead,
+	recommendation.RecommendationService_GetReadingRecommendations_FullMethodName: auth.ScopeRead,
+
+	review.ReviewService_ScheduleReview_FullMethodName: auth.ScopeWrite,
+	review.ReviewService_CancelReview_FullMethodName:   auth.ScopeWrite,
+	review.ReviewService_GetReviewQueue_FullMethodName: auth.ScopeRead,
+	review.ReviewService_RecordReview_FullMethodName:   auth.ScopeWrite,
+
+	stats.StatsService_GetReadingStats_FullMethodName: auth.ScopeRead,
+
+	healthpb.Health_Check_FullMethodName:                                   auth.ScopeRead,
+	healthpb.Health_List_FullMethodName:                                    auth.ScopeRead,
+	healthpb.Health_Watch_FullMethodName:                                   auth.ScopeRead,
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName:      auth.ScopeRead,
+	reflectionpbalpha.ServerReflection_ServerReflectionInfo_FullMethodName: auth.ScopeRead,
+}
+
+// PolicyInterceptor enforces per-RPC policies on the principal set by the
+// AuthInterceptor, so it must be chained after it. Requests made with a
+// personal access token are also limited to the token's scopes, and requests
+// may only act for the caller's own profile.
+type PolicyInterceptor struct {
+	policies map[string]auth.Policy
+}
+
+func NewPolicyInterceptor(policies map[string]auth.Policy) *PolicyInterceptor {
+	return &PolicyInterceptor{policies: policies}
+}
+
+func (i *PolicyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		if err := checkProfileIDs(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *PolicyInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &profileCheckingStream{ServerStream: ss})
+	}
+}
+
+// profileCheckingStream checks every message the client sends as the
+// handler receives it.
+type profileCheckingStream struct {
+	grpc.ServerStream
+}
+
+func (s *profileCheckingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkProfileIDs(s.Context(), m)
+}
+
+func (i *PolicyInterceptor) check(ctx context.Context, method string) error {
+	policy, ok := i.policies[method]
+	principal, authenticated := auth.PrincipalFromContext(ctx)
+	if principal.IsAccessToken() {
+		scope, listed := accessTokenScopes[method]
+		if !listed {
+			slog.Warn("method is not available to access tokens", "method", method, "user_id", principal.UserID)
+			return status.Error(codes.PermissionDenied, "method cannot be called with a personal access token")
+		}
+		policy.Scopes = append(slices.Clip(policy.Scopes), scope)
+		// Tokens act with their owner's live roles, so the admin role is
+		// only used with an admin token.
+		if slices.Contains(policy.AnyRole, auth.RoleAdmin) {
+			policy.Scopes = append(policy.Scopes, auth.ScopeAdmin)
+		}
+	} else if !ok {
+		return nil
+	}
+	if !authenticated || principal.IsAnonymous() {
+		return status.Error(codes.Unauthenticated, "authentication is required")
+	}
+	if !policy.Allows(principal) {
+		slog.Warn("permission denied", "method", method, "user_id", principal.UserID)
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// profileIDFields name the request fields that say which profile a request
+// acts for. Services take them at their word.
+var profileIDFields = []protoreflect.Name{"user_id", "owner_id"}
+
+// profileMessages are the requests whose id is the profile they act on.
+var profileMessages = []protoreflect.FullName{
+	(*profile.UpdateProfileRequest)(nil).ProtoReflect().Descriptor().FullName(),
+	(*profile.DeleteProfileRequest)(nil).ProtoReflect().Descriptor().FullName(),
+}
+
+func isProfileIDField(m protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.Int64Kind || fd.Cardinality() == protoreflect.Repeated {
+		return false
+	}
+	return slices.Contains(profileIDFields, fd.Name()) ||
+		fd.Name() == "id" && slices.Contains(profileMessages, m.Descriptor().FullName())
+}
+
+// checkProfileIDs refuses requests that name a profile other than the
+// caller's in any profileIDFields, or in the id of profileMessages, including
+// in nested messages. Admins may act for anyone.
+func checkProfileIDs(ctx context.Context, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if principal.IsAdmin() {
+		return nil
+	}
+	var profileID int64
+	var userID string
+	if principal != nil {
+		profileID, userID = principal.ProfileID, principal.UserID
+	}
+	if field := otherProfileField(msg.ProtoReflect(), profileID); field != "" {
+		slog.Warn("request names another profile", "field", field, "user_id", userID)
+		return status.Errorf(codes.PermissionDenied, "%s must be the caller's profile id", field)
+	}
+	return nil
+}
+
+// otherProfileField returns the name of the first profile id field in m
+// that is set to something other than profileID, or "".
+func otherProfileField(m protoreflect.Message, profileID int64) protoreflect.Name {
+	var found protoreflect.Name
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case isProfileIDField(m, fd):
+			if v.Int() != profileID {
+				found = fd.Name()
+			}
+		case fd.Kind() != protoreflect.MessageKind || fd.IsMap():
+		case fd.IsList():
+			for n := 0; n < v.List().Len() && found == ""; n++ {
+				found = otherProfileField(v.List().Get(n).Message(), profileID)
+			}
+		default:
+			found = otherProfileField(v.Message(), profileID)
+		}
+		return found == ""
+	})
+	return found
+}
@@ -0,0 +1,121 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/profile/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPolicyInterceptor(t *testing.T) {
+	interceptor := NewPolicyInterceptor(DefaultPolicies).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "a", Roles: []string{auth.RoleAdmin}})
+	reader := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "r"})
+
+	assert.NoError(t, call(admin, article.ArticlesService_DeleteArticle_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(reader, article.ArticlesService_DeleteArticle_FullMethodName)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background(), article.ArticlesService_DeleteArticle_FullMethodName)))
+	anonymous := auth.WithPrincipal(context.Background(), auth.Anonymous())
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(anonymous, article.ArticlesService_DeleteArticle_FullMethodName)))
+	assert.NoError(t, call(reader, article.ArticlesService_GetArticle_FullMethodName), "unlisted RPCs are open")
+}
+
+func TestPolicyInterceptorLimitsAccessTokens(t *testing.T) {
+	interceptor := NewPolicyInterceptor(DefaultPolicies).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(principal *auth.Principal, method string) codes.Code {
+		ctx := auth.WithPrincipal(context.Background(), principal)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+
+	readOnly := &auth.Principal{UserID: "u", TokenID: 1, Scopes: []string{auth.ScopeRead}}
+	libraryWriter := &auth.Principal{UserID: "u", TokenID: 2, Scopes: []string{auth.ScopeLibraryWrite, auth.ScopeRead}}
+
+	assert.Equal(t, codes.OK, call(readOnly, library.LibraryService_ListLibraryArticles_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, call(readOnly, library.LibraryService_SaveArticleToLibrary_FullMethodName))
+	assert.Equal(t, codes.OK, call(libraryWriter, library.LibraryService_SaveArticleToLibrary_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, call(libraryWriter, article.ArticlesService_UpdateArticle_FullMethodName))
+
+	admin := &auth.Principal{UserID: "a", TokenID: 3, Roles: []string{auth.RoleAdmin}, Scopes: []string{auth.ScopeRead}}
+	assert.Equal(t, codes.PermissionDenied, call(admin, article.ArticlesService_DeleteArticle_FullMethodName), "the role alone is not enough")
+	assert.Equal(t, codes.OK, call(admin, article.ArticlesService_GetArticle_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, call(admin, profile.ProfileService_ListProfiles_FullMethodName), "admin reads need the admin scope")
+
+	writer := &auth.Principal{UserID: "w", TokenID: 5, Scopes: []string{auth.ScopeWrite, auth.ScopeLibraryWrite, auth.ScopeRead}}
+	assert.Equal(t, codes.OK, call(writer, article.ArticlesService_CreateArticle_FullMethodName), "scripts can import articles")
+	assert.Equal(t, codes.OK, call(writer, goals.GoalService_CreateGoal_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, call(writer, article.ArticlesService_MergeArticles_FullMethodName))
+	assert.Equal(t, codes.PermissionDenied, call(writer, "/unknown.Service/Method"), "unlisted methods are refused")
+
+	demoted := &auth.Principal{UserID: "d", TokenID: 4, Scopes: []string{auth.ScopeAdmin, auth.ScopeLibraryWrite, auth.ScopeRead}}
+	assert.Equal(t, codes.PermissionDenied, call(demoted, article.ArticlesService_DeleteArticle_FullMethodName), "the scope alone is not enough")
+	demoted.Roles = []string{auth.RoleAdmin}
+	assert.Equal(t, codes.OK, call(demoted, article.ArticlesService_DeleteArticle_FullMethodName))
+}
+
+func TestPolicyInterceptorBindsProfileIDs(t *testing.T) {
+	interceptor := NewPolicyInterceptor(DefaultPolicies).Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(principal *auth.Principal, req proto.Message) codes.Code {
+		ctx := auth.WithPrincipal(context.Background(), principal)
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: library.LibraryService_CreateLibrary_FullMethodName}, handler)
+		return status.Code(err)
+	}
+
+	user := &auth.Principal{UserID: "u", ProfileID: 5}
+	assert.Equal(t, codes.OK, call(user, &library.CreateLibraryRequest{OwnerId: 5}))
+	assert.Equal(t, codes.PermissionDenied, call(user, &library.CreateLibraryRequest{OwnerId: 6}))
+	assert.Equal(t, codes.OK, call(user, &library.GetUserLibraryRequest{}), "unset ids are left to the service")
+	assert.Equal(t, codes.PermissionDenied, call(&auth.Principal{UserID: "new"}, &library.GetUserLibraryRequest{UserId: 5}), "callers without a profile own none")
+
+	nested := &attachment.UploadAttachmentRequest{Payload: &attachment.UploadAttachmentRequest_Metadata{
+		Metadata: &attachment.AttachmentMetadata{UserId: 6},
+	}}
+	assert.Equal(t, codes.PermissionDenied, call(user, nested))
+	assert.Equal(t, codes.OK, call(user, &profile.UpdateProfileRequest{Id: 5, Name: "Me"}))
+	assert.Equal(t, codes.PermissionDenied, call(user, &profile.UpdateProfileRequest{Id: 6, Name: "Them"}))
+	assert.Equal(t, codes.PermissionDenied, call(user, &profile.DeleteProfileRequest{Id: 6}))
+	assert.Equal(t, codes.OK, call(user, &goals.GetGoalRequest{Id: 6}), "other ids are left to the service")
+
+	admin := &auth.Principal{UserID: "a", ProfileID: 1, Roles: []string{auth.RoleAdmin}}
+	assert.Equal(t, codes.OK, call(admin, &library.CreateLibraryRequest{OwnerId: 6}), "admins act for anyone")
+	assert.Equal(t, codes.OK, call(admin, &profile.DeleteProfileRequest{Id: 6}))
+
+	reader := &auth.Principal{UserID: "a", ProfileID: 1, Roles: []string{auth.RoleAdmin}, TokenID: 2, Scopes: []string{auth.ScopeRead}}
+	assert.Equal(t, codes.PermissionDenied, call(reader, &library.GetUserLibraryRequest{UserId: 6}), "unless their token lacks the admin scope")
+}
+
+func TestAccessTokenScopesListRegisteredMethods(t *testing.T) {
+	server := grpc.NewServer()
+	NewServer(nil, nil, &conf.Config{}).registerServices(server)
+
+	registered := make(map[string]bool)
+	for service, info := range server.GetServiceInfo() {
+		for _, method := range info.Methods {
+			name := "/" + service + "/" + method.Name
+			registered[name] = true
+			assert.Contains(t, accessTokenScopes, name, "every method needs an access token scope")
+		}
+	}
+	for method := range accessTokenScopes {
+		assert.True(t, registered[method], "%s is not registered", method)
+	}
+}
@@ -0,0 +1,78 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/chiquitav2/journalful/internal/apierror"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor checks requests against the buf.validate rules in
+// their protos before they reach the handlers. Violations are returned as an
+// apierror.ValidationError, which the ErrorInterceptor turns into
+// InvalidArgument with a BadRequest detail per field.
+type ValidationInterceptor struct {
+	validator protovalidate.Validator
+}
+
+func NewValidationInterceptor() (*ValidationInterceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validator: %w", err)
+	}
+	return &ValidationInterceptor{validator: validator}, nil
+}
+
+func (i *ValidationInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream validates every message the client sends as the handler receives
+// it.
+func (i *ValidationInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, interceptor: i})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	interceptor *ValidationInterceptor
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.interceptor.validate(m)
+}
+
+func (i *ValidationInterceptor) validate(req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := i.validator.Validate(msg)
+	var validationErr *protovalidate.ValidationError
+	if errors.As(err, &validationErr) {
+		violations := make([]apierror.FieldViolation, len(validationErr.Violations))
+		for n, v := range validationErr.Violations {
+			violations[n] = apierror.FieldViolation{
+				Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+				Description: v.Proto.GetMessage(),
+			}
+		}
+		return &apierror.ValidationError{Violations: violations}
+	}
+	// Compilation and runtime errors mean the rules themselves are broken.
+	return err
+}
@@ -0,0 +1,59 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/apierror"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidationInterceptor(t *testing.T) {
+	validation, err := NewValidationInterceptor()
+	assert.NoError(t, err)
+	interceptor := validation.Unary()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(req proto.Message) error {
+		_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, handler)
+		return apierror.ToStatus(err)
+	}
+	violations := func(err error) map[string]string {
+		fields := map[string]string{}
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, v := range badRequest.FieldViolations {
+					fields[v.Field] = v.Description
+				}
+			}
+		}
+		return fields
+	}
+
+	assert.NoError(t, call(&article.CreateArticleRequest{Doi: "https://doi.org/10.1000/xyz123"}))
+	assert.NoError(t, call(&article.CreateArticleRequest{Doi: "10.48550/arXiv.2101.00001"}))
+	assert.NoError(t, call(&library.ListLibraryArticlesRequest{LibraryId: 1}))
+
+	err = call(&article.CreateArticleRequest{Doi: "not a doi", PublicationYear: proto.Int32(20)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	fields := violations(err)
+	assert.Contains(t, fields, "doi")
+	assert.Contains(t, fields, "publication_year")
+
+	err = call(&library.ListLibraryArticlesRequest{LibraryId: 0, PageSize: 1000, SortBy: 99})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	fields = violations(err)
+	assert.Contains(t, fields, "library_id")
+	assert.Contains(t, fields, "page_size")
+	assert.Contains(t, fields, "sort_by")
+
+	err = call(&article.MergeArticlesRequest{SurvivorId: 1, DuplicateId: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, violations(err), 1, "message rules are reported without a field")
+}
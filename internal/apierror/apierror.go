@@ -0,0 +1,155 @@
+// Package apierror turns the errors services return into gRPC statuses with
+// consistent codes and machine-readable details, so clients never see
+// Unknown or raw database messages.
+package apierror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain identifies this service in ErrorInfo details.
+const Domain = "journalful"
+
+// MySQL errors we map to specific codes.
+const (
+	mysqlDuplicateEntry    = 1062
+	mysqlRowIsReferenced   = 1451 // Deleting or updating a row other rows point to
+	mysqlNoReferencedRow   = 1452 // Pointing to a row that does not exist
+	mysqlRowIsReferencedV1 = 1217
+	mysqlNoReferencedRowV1 = 1216
+)
+
+// Error is a domain error with the code clients should see. Services define
+// them as package variables so callers can still match them with errors.Is.
+type Error struct {
+	Code    codes.Code
+	Message string
+}
+
+func New(code codes.Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// FieldViolation describes one invalid request field.
+type FieldViolation struct {
+	Field       string // Path of the field, like "library.name"
+	Description string
+}
+
+// ValidationError reports invalid request fields. It maps to
+// InvalidArgument with a BadRequest detail listing the violations.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// InvalidField returns a ValidationError for a single field.
+func InvalidField(field, description string) error {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = fmt.Sprintf("%s: %s", v.Field, v.Description)
+	}
+	return "invalid request: " + strings.Join(parts, "; ")
+}
+
+// IsDuplicateEntry reports whether err is a unique key violation.
+func IsDuplicateEntry(err error) bool {
+	return mysqlErrorNumber(err) == mysqlDuplicateEntry
+}
+
+// IsForeignKeyViolation reports whether err is a foreign key violation,
+// either from referencing a missing row or removing a referenced one.
+func IsForeignKeyViolation(err error) bool {
+	switch mysqlErrorNumber(err) {
+	case mysqlRowIsReferenced, mysqlNoReferencedRow, mysqlRowIsReferencedV1, mysqlNoReferencedRowV1:
+		return true
+	}
+	return false
+}
+
+func mysqlErrorNumber(err error) uint16 {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number
+	}
+	return 0
+}
+
+// ToStatus converts err to a gRPC status error. Status errors keep their
+// code and message; everything else is mapped by kind, and errors we don't
+// recognise become Internal without leaking their message. Every result
+// carries an ErrorInfo detail whose reason is the code's name.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if s, ok := status.FromError(err); ok {
+		if len(s.Details()) > 0 {
+			return err
+		}
+		return withDetails(s.Code(), s.Message(), nil)
+	}
+
+	var apiErr *Error
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range validationErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return withDetails(codes.InvalidArgument, validationErr.Error(), badRequest)
+	case errors.As(err, &apiErr):
+		return withDetails(apiErr.Code, apiErr.Message, nil)
+	case errors.Is(err, sql.ErrNoRows):
+		return withDetails(codes.NotFound, "not found", nil)
+	case IsDuplicateEntry(err):
+		return withDetails(codes.AlreadyExists, "already exists", nil)
+	case IsForeignKeyViolation(err):
+		return withDetails(codes.FailedPrecondition, "a related record is missing or still in use", nil)
+	case errors.Is(err, context.Canceled):
+		return withDetails(codes.Canceled, "request canceled", nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		return withDetails(codes.DeadlineExceeded, "deadline exceeded", nil)
+	default:
+		return withDetails(codes.Internal, "internal error", nil)
+	}
+}
+
+func withDetails(code codes.Code, message string, badRequest *errdetails.BadRequest) error {
+	if code == codes.OK {
+		return nil
+	}
+	s := status.New(code, message)
+	info := &errdetails.ErrorInfo{Reason: code.String(), Domain: Domain}
+	var err error
+	if badRequest != nil {
+		s, err = s.WithDetails(info, badRequest)
+	} else {
+		s, err = s.WithDetails(info)
+	}
+	if err != nil {
+		// Only fails if the details cannot be marshaled.
+		return status.Error(code, message)
+	}
+	return s.Err()
+}
@@ -0,0 +1,78 @@
+package apierror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("no ErrorInfo in %v", err)
+	return nil
+}
+
+func TestToStatusCodes(t *testing.T) {
+	errNotFound := New(codes.NotFound, "profile not found")
+	cases := map[string]struct {
+		err  error
+		code codes.Code
+		msg  string
+	}{
+		"no rows":          {fmt.Errorf("get library: %w", sql.ErrNoRows), codes.NotFound, "not found"},
+		"duplicate":        {&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'idx'"}, codes.AlreadyExists, "already exists"},
+		"missing parent":   {&mysql.MySQLError{Number: 1452}, codes.FailedPrecondition, "a related record is missing or still in use"},
+		"referenced":       {&mysql.MySQLError{Number: 1451}, codes.FailedPrecondition, "a related record is missing or still in use"},
+		"other mysql":      {&mysql.MySQLError{Number: 1205, Message: "Lock wait timeout"}, codes.Internal, "internal error"},
+		"domain error":     {fmt.Errorf("wrapped: %w", errNotFound), codes.NotFound, "profile not found"},
+		"status error":     {status.Error(codes.PermissionDenied, "not yours"), codes.PermissionDenied, "not yours"},
+		"canceled":         {context.Canceled, codes.Canceled, "request canceled"},
+		"unknown":          {errors.New("dial tcp: secret host"), codes.Internal, "internal error"},
+		"validation error": {InvalidField("name", "is required"), codes.InvalidArgument, "invalid request: name: is required"},
+	}
+	for name, c := range cases {
+		err := ToStatus(c.err)
+		s := status.Convert(err)
+		assert.Equal(t, c.code, s.Code(), name)
+		assert.Equal(t, c.msg, s.Message(), name)
+		info := errorInfo(t, err)
+		assert.Equal(t, c.code.String(), info.Reason, name)
+		assert.Equal(t, Domain, info.Domain, name)
+	}
+	assert.NoError(t, ToStatus(nil))
+}
+
+func TestToStatusBadRequest(t *testing.T) {
+	err := ToStatus(&ValidationError{Violations: []FieldViolation{
+		{Field: "name", Description: "is required"},
+		{Field: "page_size", Description: "must be at most 100"},
+	}})
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = br
+		}
+	}
+	if assert.NotNil(t, badRequest) {
+		assert.Len(t, badRequest.FieldViolations, 2)
+		assert.Equal(t, "page_size", badRequest.FieldViolations[1].Field)
+	}
+}
+
+func TestToStatusKeepsExistingDetails(t *testing.T) {
+	once := ToStatus(InvalidField("name", "is required"))
+	assert.Equal(t, once, ToStatus(once))
+}
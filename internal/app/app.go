@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	_ "embed"
 	"fmt"
@@ -8,15 +9,31 @@ import (
 	"time"
 
 	"github.com/chiquitav2/journalful/internal/api"
+	"github.com/chiquitav2/journalful/internal/api/admin"
+	"github.com/chiquitav2/journalful/internal/api/gateway"
 	"github.com/chiquitav2/journalful/internal/api/grpc"
+	"github.com/chiquitav2/journalful/internal/article"
+	"github.com/chiquitav2/journalful/internal/extraction"
+	"github.com/chiquitav2/journalful/internal/metrics"
+	"github.com/chiquitav2/journalful/internal/recommendation"
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/chiquitav2/journalful/internal/tracing"
 	"github.com/chiquitav2/journalful/pkg/conf"
 	_ "github.com/go-sql-driver/mysql"
 )
 
 type App struct {
-	grpcApi api.ApiModule
-	db      *sql.DB
-	config  *conf.Config
+	grpcApi     api.ApiModule
+	gatewayApi  api.ApiModule
+	adminApi    api.ApiModule
+	db          *sql.DB
+	blobStore   storage.BlobStore
+	extractor   *extraction.Worker
+	recommender *recommendation.Job
+	updates     *article.UpdateChecker
+	stopWorker  context.CancelFunc
+	stopTracing func(context.Context) error
+	config      *conf.Config
 }
 
 func NewApp(config *conf.Config) *App {
@@ -26,24 +43,77 @@ func NewApp(config *conf.Config) *App {
 }
 func (s *App) Init() error {
 	slog.Info("initializing application")
+	stopTracing, err := tracing.Setup(context.Background(), s.config.Tracing)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	s.stopTracing = stopTracing
+
 	// Initialize the database connection
 	db, err := connectDB(s.config.Database)
 	if err != nil {
 		return fmt.Errorf("failed to connect to the database: %w", err)
 	}
 	s.db = db
+	if err := article.MigrateNormalizedDOIs(context.Background(), s.db); err != nil {
+		return err
+	}
+	if err := metrics.RegisterDB(s.db); err != nil {
+		return err
+	}
 
-	s.grpcApi = grpcapi.NewServer(s.db, s.config)
+	blobStore, err := storage.New(context.Background(), s.config.Storage)
+	if err != nil {
+		return fmt.Errorf("failed to create blob store: %w", err)
+	}
+	s.blobStore = blobStore
+	s.extractor = extraction.NewWorker(s.db, s.blobStore)
+	s.recommender = recommendation.NewJob(s.db)
+	s.updates = article.NewUpdateChecker(s.db)
+
+	s.grpcApi = grpcapi.NewServer(s.db, s.blobStore, s.config)
 
 	if err := s.grpcApi.Register(); err != nil {
 		return fmt.Errorf("failed to register gRPC API: %w", err)
 	}
+	if s.config.Gateway.Port != "" {
+		s.gatewayApi = gatewayapi.NewServer(s.config)
+		if err := s.gatewayApi.Register(); err != nil {
+			return fmt.Errorf("failed to register HTTP gateway: %w", err)
+		}
+	}
+	if s.config.Admin.Port != "" {
+		s.adminApi = adminapi.NewServer()
+		if err := s.adminApi.Register(); err != nil {
+			return fmt.Errorf("failed to register admin server: %w", err)
+		}
+	}
 	slog.Info("application initialized successfully")
 	return nil
 }
 
 func (s *App) Start() error {
 	slog.Info("starting application")
+	workerCtx, cancel := context.WithCancel(context.Background())
+	s.stopWorker = cancel
+	go s.extractor.Run(workerCtx)
+	go s.recommender.Run(workerCtx)
+	go s.updates.Run(workerCtx)
+	if s.gatewayApi != nil {
+		go func() {
+			if err := s.gatewayApi.Start(s.config); err != nil {
+				slog.Error("HTTP gateway stopped", "error", err)
+			}
+		}()
+	}
+	if s.adminApi != nil {
+		go func() {
+			if err := s.adminApi.Start(s.config); err != nil {
+				slog.Error("admin server stopped", "error", err)
+			}
+		}()
+	}
+
 	if err := s.grpcApi.Start(s.config); err != nil {
 		return fmt.Errorf("failed to start gRPC API: %w", err)
 	}
@@ -54,6 +124,15 @@ func (s *App) Start() error {
 func (s *App) Stop() {
 	slog.Info("stopping application")
 	// Clean up resources here
+	if s.stopWorker != nil {
+		s.stopWorker()
+	}
+	if s.gatewayApi != nil {
+		s.gatewayApi.Stop()
+	}
+	if s.adminApi != nil {
+		s.adminApi.Stop()
+	}
 	if s.grpcApi != nil {
 		s.grpcApi.Stop()
 	}
@@ -64,6 +143,13 @@ func (s *App) Stop() {
 			slog.Info("database connection closed successfully")
 		}
 	}
+	if s.stopTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.stopTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}
 	slog.Info("application stopped successfully")
 }
 
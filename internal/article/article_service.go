@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/chiquitav2/journalful/internal/apierror"
 	"github.com/chiquitav2/journalful/internal/db"
 
 	"log/slog"
@@ -23,17 +24,62 @@ type ArticleService interface {
 	CreateArticle(ctx context.Context, request *article.CreateArticleRequest) (*article.CreateArticleResponse, error)
 	UpdateArticle(ctx context.Context, request *article.UpdateArticleRequest) (*article.UpdateArticleResponse, error)
 	DeleteArticle(ctx context.Context, request *article.DeleteArticleRequest) (*article.DeleteArticleResponse, error)
+	SearchArticles(ctx context.Context, request *article.SearchArticlesRequest) (*article.SearchArticlesResponse, error)
+	FindDuplicates(ctx context.Context, request *article.FindDuplicatesRequest) (*article.FindDuplicatesResponse, error)
+	MergeArticles(ctx context.Context, request *article.MergeArticlesRequest) (*article.MergeArticlesResponse, error)
+	ListReferences(ctx context.Context, request *article.ListReferencesRequest) (*article.ListReferencesResponse, error)
+	ListCitedBy(ctx context.Context, request *article.ListCitedByRequest) (*article.ListCitedByResponse, error)
+	GetCitationGraph(ctx context.Context, request *article.GetCitationGraphRequest) (*article.GetCitationGraphResponse, error)
+}
+
+// articleQueries is the subset of db.Queries the article service uses, so
+// tests can substitute it.
+type articleQueries interface {
+	GetArticle(ctx context.Context, id int64) (db.Article, error)
+	GetArticleByNormalizedDOI(ctx context.Context, doiNormalized string) (db.Article, error)
+	GetArticleRedirect(ctx context.Context, oldID int64) (int64, error)
+	GetArticleRedirectByDOI(ctx context.Context, oldDoi string) (int64, error)
+	ListArticlesByTitleMatch(ctx context.Context, arg db.ListArticlesByTitleMatchParams) ([]db.Article, error)
+	ListArticlesWithAuthors(ctx context.Context) ([]db.ListArticlesWithAuthorsRow, error)
+	ListArticlesByIDs(ctx context.Context, ids []int64) ([]db.Article, error)
+	SearchArticlesByMetadata(ctx context.Context, arg db.SearchArticlesByMetadataParams) ([]db.Article, error)
+	SearchAttachmentPages(ctx context.Context, arg db.SearchAttachmentPagesParams) ([]db.SearchAttachmentPagesRow, error)
+	CreateArticle(ctx context.Context, arg db.CreateArticleParams) (sql.Result, error)
+	UpdateArticle(ctx context.Context, arg db.UpdateArticleParams) error
+	DeleteArticle(ctx context.Context, id int64) error
+	ListArticleAuthorsByArticleID(ctx context.Context, articleID int64) ([]db.ListArticleAuthorsByArticleIDRow, error)
+	ListArticleAuthorsByArticleIDs(ctx context.Context, articleIds []int64) ([]db.ListArticleAuthorsByArticleIDsRow, error)
+	AddArticleAuthor(ctx context.Context, arg db.AddArticleAuthorParams) (sql.Result, error)
+	GetAuthorByName(ctx context.Context, name string) (db.Author, error)
+	CreateAuthor(ctx context.Context, arg db.CreateAuthorParams) (sql.Result, error)
+	CreateArticleReferences(ctx context.Context, args []db.CreateArticleReferenceParams) error
+	ResolveReferencesFromArticle(ctx context.Context, citingArticleID int64) error
+	ResolveReferencesToArticle(ctx context.Context, arg db.ResolveReferencesToArticleParams) error
+	ListArticleReferences(ctx context.Context, citingArticleID int64) ([]db.ListArticleReferencesRow, error)
+	ListCitingArticles(ctx context.Context, citedArticleID sql.NullInt64) ([]db.Article, error)
+	ListReferenceEdgesFrom(ctx context.Context, articleIds []int64) ([]db.ListReferenceEdgesFromRow, error)
+	ListReferenceEdgesTo(ctx context.Context, articleIds []sql.NullInt64) ([]db.ListReferenceEdgesToRow, error)
+	UpsertArticleUpdate(ctx context.Context, arg db.UpsertArticleUpdateParams) error
+	RefreshArticleFlag(ctx context.Context, arg db.RefreshArticleFlagParams) error
 }
 
 type ArticleSerivceImp struct {
-	queries     *db.Queries
-	metadataSvc *MetadataService
+	conn           *sql.DB
+	queries        articleQueries
+	metadataSvc    *MetadataService
+	libraryEntries LibraryEntryListener // optional
 }
 
 func NewArticleSerivce(conn *sql.DB) ArticleService {
+	return newArticleService(conn, nil)
+}
+
+func newArticleService(conn *sql.DB, libraryEntries LibraryEntryListener) *ArticleSerivceImp {
 	return &ArticleSerivceImp{
-		queries:     db.New(conn),
-		metadataSvc: NewMetadataService(),
+		conn:           conn,
+		queries:        db.NewTraced(conn),
+		metadataSvc:    NewMetadataService(),
+		libraryEntries: libraryEntries,
 	}
 }
 
@@ -60,6 +106,23 @@ func (s *ArticleSerivceImp) GetArticle(ctx context.Context, id int64) (*article.
 	grpcArticle, err := s.getArticleWithAuthors(ctx, func() (db.Article, error) {
 		return s.queries.GetArticle(ctx, id)
 	})
+	if status.Code(err) == codes.NotFound {
+		// The article may have been merged into another one.
+		newID, redirectErr := s.queries.GetArticleRedirect(ctx, id)
+		if redirectErr == nil {
+			grpcArticle, err = s.getArticleWithAuthors(ctx, func() (db.Article, error) {
+				return s.queries.GetArticle(ctx, newID)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &article.GetArticleResponse{Article: grpcArticle, RedirectedFromId: &id}, nil
+		}
+		if redirectErr != sql.ErrNoRows {
+			slog.Error("failed to get article redirect", "error", redirectErr)
+			return nil, status.Error(codes.Internal, "failed to get article")
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +133,24 @@ func (s *ArticleSerivceImp) GetArticle(ctx context.Context, id int64) (*article.
 }
 
 func (s *ArticleSerivceImp) GetArticleByDOI(ctx context.Context, doi string) (*article.GetArticleByDOIResponse, error) {
+	normalized := NormalizeDOI(doi)
 	grpcArticle, err := s.getArticleWithAuthors(ctx, func() (db.Article, error) {
-		return s.queries.GetArticleByDOI(ctx, doi)
+		return s.queries.GetArticleByNormalizedDOI(ctx, normalized)
 	})
+	if status.Code(err) == codes.NotFound {
+		newID, redirectErr := s.queries.GetArticleRedirectByDOI(ctx, normalized)
+		if redirectErr == nil {
+			response, err := s.GetArticle(ctx, newID)
+			if err != nil {
+				return nil, err
+			}
+			return &article.GetArticleByDOIResponse{Article: response.Article, RedirectedFromId: response.RedirectedFromId}, nil
+		}
+		if redirectErr != sql.ErrNoRows {
+			slog.Error("failed to get article redirect", "error", redirectErr)
+			return nil, status.Error(codes.Internal, "failed to get article")
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +161,6 @@ func (s *ArticleSerivceImp) GetArticleByDOI(ctx context.Context, doi string) (*a
 }
 
 func (s *ArticleSerivceImp) ListArticles(ctx context.Context, request *article.ListArticlesRequest) (*article.ListArticlesResponse, error) {
-	if request == nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid request")
-	}
 	// Fetch the articles from the database
 	articlesData, err := s.queries.ListArticlesWithAuthors(ctx)
 	if err != nil {
@@ -118,18 +193,31 @@ func (s *ArticleSerivceImp) ListArticles(ctx context.Context, request *article.L
 }
 
 func (s *ArticleSerivceImp) CreateArticle(ctx context.Context, request *article.CreateArticleRequest) (*article.CreateArticleResponse, error) {
-	// Validate the request
-	if request == nil || request.Doi == "" {
+	normalizedDOI := NormalizeDOI(request.Doi)
+	if normalizedDOI == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid request: DOI is required")
 	}
 
+	// The same DOI in another case or with a resolver prefix is still the same article
+	existing, err := s.queries.GetArticleByNormalizedDOI(ctx, normalizedDOI)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "article with this DOI already exists (id %d)", existing.ID)
+	}
+	if err != sql.ErrNoRows {
+		slog.Error("failed to check for existing article", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create article")
+	}
+
 	var meta *db.CreateArticleParams
 	var authorNames []string
-	var err error
+	var references []db.CreateArticleReferenceParams
+	var updates []Update
 
 	// Try to fetch metadata from external sources first
-	meta, authorNames, err = s.metadataSvc.FetchAndPrepareArticle(request.Doi)
-	if err != nil || meta == nil {
+	prepared, err := s.metadataSvc.FetchAndPrepareArticle(ctx, normalizedDOI)
+	if err == nil {
+		meta, authorNames, references, updates = &prepared.Article, prepared.Authors, prepared.References, prepared.Updates
+	} else {
 		// If external metadata fetch fails, use the provided request data
 		slog.Info("external metadata fetch failed, using provided data", "doi", request.Doi)
 
@@ -183,24 +271,53 @@ func (s *ArticleSerivceImp) CreateArticle(ctx context.Context, request *article.
 		}
 	}
 
-	// Create article
-	dbArticle, err := s.queries.CreateArticle(ctx, *meta)
+	// The article, its authors, references and notices are stored together,
+	// so a failure leaves no half-created article behind.
+	meta.DoiNormalized = normalizedDOI
+	var articleID int64
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		var err error
+		articleID, err = storeArticle(ctx, q, *meta, authorNames, references, updates)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			slog.Error("failed to create article", "error", err)
+			err = status.Error(codes.Internal, "failed to create article")
+		}
+		return nil, err
+	}
+
+	slog.Info("article created successfully", "id", articleID, "doi", request.Doi, "title", meta.Title)
+	return &article.CreateArticleResponse{
+		Id: articleID,
+	}, nil
+}
+
+// storeArticle creates an article with its authors, references and
+// notices, returning the new article's id.
+func storeArticle(ctx context.Context, q articleQueries, meta db.CreateArticleParams, authorNames []string, references []db.CreateArticleReferenceParams, updates []Update) (int64, error) {
+	dbArticle, err := q.CreateArticle(ctx, meta)
+	if apierror.IsDuplicateEntry(err) {
+		// Another request created the article since the DOI was checked.
+		return 0, status.Error(codes.AlreadyExists, "article with this DOI already exists")
+	}
 	if err != nil {
 		slog.Error("failed to create article", "error", err)
-		return nil, status.Error(codes.Internal, "failed to create article")
+		return 0, status.Error(codes.Internal, "failed to create article")
 	}
 
 	articleID, err := dbArticle.LastInsertId()
 	if err != nil {
 		slog.Error("failed to get last insert ID", "error", err)
-		return nil, status.Error(codes.Internal, "failed to get last insert ID")
+		return 0, status.Error(codes.Internal, "failed to get last insert ID")
 	}
 
 	// Create article authors
-	authors, err := s.FindOrCreateAuthors(ctx, authorNames)
+	authors, err := findOrCreateAuthors(ctx, q, authorNames)
 	if err != nil {
 		slog.Error("failed to find or create authors", "error", err)
-		return nil, status.Error(codes.Internal, "failed to find or create authors")
+		return 0, status.Error(codes.Internal, "failed to find or create authors")
 	}
 	for i, author := range authors {
 		if author == nil {
@@ -208,7 +325,7 @@ func (s *ArticleSerivceImp) CreateArticle(ctx context.Context, request *article.
 			continue // Skip nil authors
 		}
 		// Insert each author into the database
-		_, err = s.queries.AddArticleAuthor(ctx, db.AddArticleAuthorParams{
+		_, err = q.AddArticleAuthor(ctx, db.AddArticleAuthorParams{
 			ArticleID: articleID,
 			AuthorID:  author.Id,
 			AuthorOrder: sql.NullInt32{
@@ -218,20 +335,21 @@ func (s *ArticleSerivceImp) CreateArticle(ctx context.Context, request *article.
 		})
 		if err != nil {
 			slog.Error("failed to create article author", "error", err, "author", author.Name)
-			return nil, status.Error(codes.Internal, "failed to create article author")
+			return 0, status.Error(codes.Internal, "failed to create article author")
 		}
 	}
 
-	slog.Info("article created successfully", "id", articleID, "doi", request.Doi, "title", meta.Title)
-	return &article.CreateArticleResponse{
-		Id: articleID,
-	}, nil
+	if err := storeReferences(ctx, q, articleID, meta.DoiNormalized, references); err != nil {
+		return 0, err
+	}
+	if err := recordUpdates(ctx, q, updates); err != nil {
+		slog.Error("failed to record article updates", "error", err, "id", articleID)
+		return 0, status.Error(codes.Internal, "failed to record article updates")
+	}
+	return articleID, nil
 }
 
 func (s *ArticleSerivceImp) UpdateArticle(ctx context.Context, request *article.UpdateArticleRequest) (*article.UpdateArticleResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid request")
-	}
 	// Fetch the existing article from the database
 	_, err := s.queries.GetArticle(ctx, request.Id)
 	if err != nil {
@@ -248,11 +366,15 @@ func (s *ArticleSerivceImp) UpdateArticle(ctx context.Context, request *article.
 		db.UpdateArticleParams{
 			ID:              request.Id,
 			Doi:             request.Doi,
+			DoiNormalized:   NormalizeDOI(request.Doi),
 			Title:           request.Title,
-			Abstract:        sql.NullString{String: *request.Abstract, Valid: *request.Abstract != ""},
-			PublicationYear: sql.NullInt32{Int32: *request.PublicationYear, Valid: *request.PublicationYear != 0},
-			JournalName:     sql.NullString{String: *request.JournalName, Valid: *request.JournalName != ""},
+			Abstract:        sql.NullString{String: request.GetAbstract(), Valid: request.GetAbstract() != ""},
+			PublicationYear: sql.NullInt32{Int32: request.GetPublicationYear(), Valid: request.GetPublicationYear() != 0},
+			JournalName:     sql.NullString{String: request.GetJournalName(), Valid: request.GetJournalName() != ""},
 		})
+	if apierror.IsDuplicateEntry(err) {
+		return nil, status.Error(codes.AlreadyExists, "another article has this DOI")
+	}
 	if err != nil {
 		slog.Error("failed to update article", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update article")
@@ -262,9 +384,6 @@ func (s *ArticleSerivceImp) UpdateArticle(ctx context.Context, request *article.
 }
 
 func (s *ArticleSerivceImp) DeleteArticle(ctx context.Context, request *article.DeleteArticleRequest) (*article.DeleteArticleResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid request")
-	}
 	// Delete the article from the database
 	err := s.queries.DeleteArticle(ctx, request.Id)
 	if err != nil {
@@ -302,19 +421,24 @@ func dbToGrpcArticle(dbArticle db.Article, dbAuthors []db.ListArticleAuthorsByAr
 		Abstract:        &dbArticle.Abstract.String,
 		PublicationYear: &dbArticle.PublicationYear.Int32,
 		JournalName:     &dbArticle.JournalName.String,
+		Flag:            article.ArticleFlag(dbArticle.Flag),
 		CreatedAt:       timestamppb.New(dbArticle.CreatedAt.Time),
 		UpdatedAt:       timestamppb.New(dbArticle.UpdatedAt.Time),
 	}
 }
 
 func (s *ArticleSerivceImp) FindOrCreateAuthors(ctx context.Context, names []string) ([]*v1.Author, error) {
+	return findOrCreateAuthors(ctx, s.queries, names)
+}
+
+func findOrCreateAuthors(ctx context.Context, q articleQueries, names []string) ([]*v1.Author, error) {
 	if len(names) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no author names provided")
 	}
 
 	var grpcAuthors []*v1.Author
 	for _, name := range names {
-		author, err := s.queries.GetAuthorByName(ctx, name)
+		author, err := q.GetAuthorByName(ctx, name)
 		if err != nil && err != sql.ErrNoRows {
 			slog.Error("failed to get author by name", "name", name, "error", err)
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get author by name %s", name))
@@ -322,7 +446,7 @@ func (s *ArticleSerivceImp) FindOrCreateAuthors(ctx context.Context, names []str
 
 		if err == sql.ErrNoRows {
 			// If the author does not exist, create a new one
-			authorRow, err := s.queries.CreateAuthor(ctx,
+			authorRow, err := q.CreateAuthor(ctx,
 				db.CreateAuthorParams{
 					Name: name,
 				},
@@ -3,14 +3,20 @@ package article
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Mock db.Querier
+// Mock articleQueries
 type MockQueries struct {
 	mock.Mock
 }
@@ -20,11 +26,51 @@ func (m *MockQueries) GetArticle(ctx context.Context, id int64) (db.Article, err
 	return args.Get(0).(db.Article), args.Error(1)
 }
 
+func (m *MockQueries) GetArticleByNormalizedDOI(ctx context.Context, doiNormalized string) (db.Article, error) {
+	args := m.Called(ctx, doiNormalized)
+	return args.Get(0).(db.Article), args.Error(1)
+}
+
+func (m *MockQueries) GetArticleRedirect(ctx context.Context, oldID int64) (int64, error) {
+	args := m.Called(ctx, oldID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockQueries) GetArticleRedirectByDOI(ctx context.Context, oldDoi string) (int64, error) {
+	args := m.Called(ctx, oldDoi)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockQueries) ListArticlesByTitleMatch(ctx context.Context, params db.ListArticlesByTitleMatchParams) ([]db.Article, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]db.Article), args.Error(1)
+}
+
+func (m *MockQueries) ListArticlesByIDs(ctx context.Context, ids []int64) ([]db.Article, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]db.Article), args.Error(1)
+}
+
+func (m *MockQueries) SearchArticlesByMetadata(ctx context.Context, params db.SearchArticlesByMetadataParams) ([]db.Article, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]db.Article), args.Error(1)
+}
+
+func (m *MockQueries) SearchAttachmentPages(ctx context.Context, params db.SearchAttachmentPagesParams) ([]db.SearchAttachmentPagesRow, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]db.SearchAttachmentPagesRow), args.Error(1)
+}
+
 func (m *MockQueries) ListArticleAuthorsByArticleID(ctx context.Context, articleID int64) ([]db.ListArticleAuthorsByArticleIDRow, error) {
 	args := m.Called(ctx, articleID)
 	return args.Get(0).([]db.ListArticleAuthorsByArticleIDRow), args.Error(1)
 }
 
+func (m *MockQueries) ListArticleAuthorsByArticleIDs(ctx context.Context, articleIds []int64) ([]db.ListArticleAuthorsByArticleIDsRow, error) {
+	args := m.Called(ctx, articleIds)
+	return args.Get(0).([]db.ListArticleAuthorsByArticleIDsRow), args.Error(1)
+}
+
 func (m *MockQueries) ListArticlesWithAuthors(ctx context.Context) ([]db.ListArticlesWithAuthorsRow, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]db.ListArticlesWithAuthorsRow), args.Error(1)
@@ -60,6 +106,51 @@ func (m *MockQueries) CreateAuthor(ctx context.Context, params db.CreateAuthorPa
 	return args.Get(0).(sql.Result), args.Error(1)
 }
 
+func (m *MockQueries) CreateArticleReferences(ctx context.Context, params []db.CreateArticleReferenceParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockQueries) ResolveReferencesFromArticle(ctx context.Context, citingArticleID int64) error {
+	args := m.Called(ctx, citingArticleID)
+	return args.Error(0)
+}
+
+func (m *MockQueries) ResolveReferencesToArticle(ctx context.Context, params db.ResolveReferencesToArticleParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockQueries) ListArticleReferences(ctx context.Context, citingArticleID int64) ([]db.ListArticleReferencesRow, error) {
+	args := m.Called(ctx, citingArticleID)
+	return args.Get(0).([]db.ListArticleReferencesRow), args.Error(1)
+}
+
+func (m *MockQueries) ListCitingArticles(ctx context.Context, citedArticleID sql.NullInt64) ([]db.Article, error) {
+	args := m.Called(ctx, citedArticleID)
+	return args.Get(0).([]db.Article), args.Error(1)
+}
+
+func (m *MockQueries) ListReferenceEdgesFrom(ctx context.Context, articleIds []int64) ([]db.ListReferenceEdgesFromRow, error) {
+	args := m.Called(ctx, articleIds)
+	return args.Get(0).([]db.ListReferenceEdgesFromRow), args.Error(1)
+}
+
+func (m *MockQueries) ListReferenceEdgesTo(ctx context.Context, articleIds []sql.NullInt64) ([]db.ListReferenceEdgesToRow, error) {
+	args := m.Called(ctx, articleIds)
+	return args.Get(0).([]db.ListReferenceEdgesToRow), args.Error(1)
+}
+
+func (m *MockQueries) UpsertArticleUpdate(ctx context.Context, params db.UpsertArticleUpdateParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockQueries) RefreshArticleFlag(ctx context.Context, params db.RefreshArticleFlagParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
 func TestArticleService_GetArticle(t *testing.T) {
 	// Create a new mock querier
 	mockQueries := new(MockQueries)
@@ -99,3 +190,72 @@ func TestArticleService_GetArticle(t *testing.T) {
 	// Assert that the mock expectations were met
 	mockQueries.AssertExpectations(t)
 }
+
+// insertResult is the result of an insert that created the row with its id.
+type insertResult int64
+
+func (r insertResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r insertResult) RowsAffected() (int64, error) { return 1, nil }
+
+func TestStoreArticle(t *testing.T) {
+	mockQueries := new(MockQueries)
+	meta := db.CreateArticleParams{Doi: "10.1/Paper", DoiNormalized: "10.1/paper", Title: "Paper"}
+	mockQueries.On("CreateArticle", mock.Anything, meta).Return(insertResult(9), nil)
+	mockQueries.On("GetAuthorByName", mock.Anything, "Ada Lovelace").Return(db.Author{ID: 2, Name: "Ada Lovelace"}, nil)
+	mockQueries.On("AddArticleAuthor", mock.Anything, db.AddArticleAuthorParams{
+		ArticleID:   9,
+		AuthorID:    2,
+		AuthorOrder: sql.NullInt32{Int32: 1, Valid: true},
+	}).Return(insertResult(1), nil)
+	// All references go in one call, linked to the new article.
+	mockQueries.On("CreateArticleReferences", mock.Anything, []db.CreateArticleReferenceParams{
+		{CitingArticleID: 9, Position: 1},
+		{CitingArticleID: 9, Position: 2},
+	}).Return(nil)
+	mockQueries.On("ResolveReferencesFromArticle", mock.Anything, int64(9)).Return(nil)
+	mockQueries.On("ResolveReferencesToArticle", mock.Anything, db.ResolveReferencesToArticleParams{
+		ArticleID: sql.NullInt64{Int64: 9, Valid: true},
+		Doi:       sql.NullString{String: "10.1/paper", Valid: true},
+	}).Return(nil)
+
+	id, err := storeArticle(context.Background(), mockQueries, meta, []string{"Ada Lovelace"},
+		[]db.CreateArticleReferenceParams{{Position: 1}, {Position: 2}}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), id)
+	mockQueries.AssertExpectations(t)
+}
+
+func TestStoreArticleFailsOnReferences(t *testing.T) {
+	mockQueries := new(MockQueries)
+	mockQueries.On("CreateArticle", mock.Anything, mock.Anything).Return(insertResult(9), nil)
+	mockQueries.On("GetAuthorByName", mock.Anything, "Ada Lovelace").Return(db.Author{ID: 2}, nil)
+	mockQueries.On("AddArticleAuthor", mock.Anything, mock.Anything).Return(insertResult(1), nil)
+	mockQueries.On("CreateArticleReferences", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+	_, err := storeArticle(context.Background(), mockQueries, db.CreateArticleParams{DoiNormalized: "10.1/paper"},
+		[]string{"Ada Lovelace"}, []db.CreateArticleReferenceParams{{Position: 1}}, nil)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	mockQueries.AssertNotCalled(t, "ResolveReferencesFromArticle", mock.Anything, mock.Anything)
+}
+
+func TestStoreArticleDuplicateDOI(t *testing.T) {
+	mockQueries := new(MockQueries)
+	mockQueries.On("CreateArticle", mock.Anything, mock.Anything).Return(insertResult(0), &mysql.MySQLError{Number: 1062})
+
+	_, err := storeArticle(context.Background(), mockQueries, db.CreateArticleParams{DoiNormalized: "10.1/paper"}, nil, nil, nil)
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	mockQueries.AssertNotCalled(t, "AddArticleAuthor", mock.Anything, mock.Anything)
+}
+
+func TestUpdateArticleWithoutOptionalFields(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := newArticleService(conn, nil)
+	fake.Return("GetArticle", db.Article{ID: 1, Doi: "10.1000/xyz", Title: "Paper"})
+
+	_, err := s.UpdateArticle(context.Background(), &article.UpdateArticleRequest{Id: 1, Doi: "10.1000/xyz", Title: "Paper, revised"})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("UpdateArticle"), 1)
+}
@@ -0,0 +1,109 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/db"
+)
+
+// dataCiteProvider labels DataCite calls in the metadata metrics.
+const dataCiteProvider = "datacite"
+
+// dataCitePrefixes are DOI prefixes registered with DataCite instead of
+// CrossRef, which does not know them.
+var dataCitePrefixes = []string{
+	"10.48550/", // arXiv
+}
+
+// DataCite REST API response structs
+type DataCiteResponse struct {
+	Data struct {
+		Attributes DataCiteAttributes `json:"attributes"`
+	} `json:"data"`
+}
+
+type DataCiteAttributes struct {
+	DOI             string                `json:"doi"`
+	Titles          []DataCiteTitle       `json:"titles"`
+	Creators        []DataCiteCreator     `json:"creators"`
+	PublicationYear int                   `json:"publicationYear"`
+	Descriptions    []DataCiteDescription `json:"descriptions"`
+	URL             string                `json:"url"`
+}
+
+type DataCiteTitle struct {
+	Title string `json:"title"`
+}
+
+// DataCiteCreator is an author. Name is "Family, Given" for people and the
+// organisation's name otherwise.
+type DataCiteCreator struct {
+	Name       string `json:"name"`
+	GivenName  string `json:"givenName"`
+	FamilyName string `json:"familyName"`
+}
+
+type DataCiteDescription struct {
+	Description     string `json:"description"`
+	DescriptionType string `json:"descriptionType"`
+}
+
+func isDataCiteDOI(doi string) bool {
+	doi = NormalizeDOI(doi)
+	for _, prefix := range dataCitePrefixes {
+		if strings.HasPrefix(doi, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchAndPrepareFromDataCite looks the DOI up on DataCite. DataCite has no
+// reference lists or notices for the works we look up there.
+func (s *MetadataService) fetchAndPrepareFromDataCite(ctx context.Context, doi string) (*PreparedArticle, error) {
+	var response DataCiteResponse
+	if err := s.fetchJSON(ctx, dataCiteProvider, fmt.Sprintf("https://api.datacite.org/dois/%s", doi), &response); err != nil {
+		return nil, fmt.Errorf("failed to fetch article metadata from DOI: %w", err)
+	}
+	meta := response.Data.Attributes
+	slog.Info("Successfully fetched metadata from DataCite", "doi", doi)
+
+	prepared := &PreparedArticle{
+		Article: db.CreateArticleParams{
+			Doi: doi,
+			Url: sql.NullString{String: meta.URL, Valid: meta.URL != ""},
+		},
+	}
+	if len(meta.Titles) > 0 {
+		prepared.Article.Title = meta.Titles[0].Title
+	}
+	for _, description := range meta.Descriptions {
+		if description.DescriptionType == "Abstract" && description.Description != "" {
+			prepared.Article.Abstract = sql.NullString{String: description.Description, Valid: true}
+			break
+		}
+	}
+	if meta.PublicationYear != 0 {
+		prepared.Article.PublicationYear = sql.NullInt32{Int32: int32(meta.PublicationYear), Valid: true}
+	}
+	for _, creator := range meta.Creators {
+		prepared.Authors = append(prepared.Authors, creator.fullName())
+	}
+	return prepared, nil
+}
+
+// fullName returns the name in the "Given Family" order used for CrossRef
+// authors.
+func (c DataCiteCreator) fullName() string {
+	if c.GivenName != "" && c.FamilyName != "" {
+		return c.GivenName + " " + c.FamilyName
+	}
+	if family, given, ok := strings.Cut(c.Name, ", "); ok {
+		return given + " " + family
+	}
+	return c.Name
+}
@@ -0,0 +1,55 @@
+package article
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripFunc answers HTTP requests without a network.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+const dataCiteArXiv = `{"data": {"id": "10.48550/arxiv.2101.00001", "attributes": {
+	"doi": "10.48550/arxiv.2101.00001",
+	"titles": [{"title": "Attention Over Attention"}],
+	"creators": [
+		{"name": "Lovelace, Ada", "givenName": "Ada", "familyName": "Lovelace"},
+		{"name": "Babbage, Charles"},
+		{"name": "The Analytical Engine Collaboration"}
+	],
+	"publicationYear": 2021,
+	"descriptions": [{"description": "We study attention.", "descriptionType": "Abstract"}],
+	"url": "https://arxiv.org/abs/2101.00001"
+}}}`
+
+func TestFetchAndPrepareArticleFromDataCite(t *testing.T) {
+	var requested string
+	s := &MetadataService{client: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requested = r.URL.String()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(dataCiteArXiv))}, nil
+	})}}
+
+	prepared, err := s.FetchAndPrepareArticle(context.Background(), "10.48550/arxiv.2101.00001")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.datacite.org/dois/10.48550/arxiv.2101.00001", requested)
+	assert.Equal(t, "Attention Over Attention", prepared.Article.Title)
+	assert.Equal(t, "We study attention.", prepared.Article.Abstract.String)
+	assert.Equal(t, int32(2021), prepared.Article.PublicationYear.Int32)
+	assert.Equal(t, "https://arxiv.org/abs/2101.00001", prepared.Article.Url.String)
+	assert.Equal(t, []string{"Ada Lovelace", "Charles Babbage", "The Analytical Engine Collaboration"}, prepared.Authors)
+}
+
+func TestFetchAndPrepareArticleNotOnDataCite(t *testing.T) {
+	s := &MetadataService{client: &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(""))}, nil
+	})}}
+
+	_, err := s.FetchAndPrepareArticle(context.Background(), "10.48550/arxiv.9999.99999")
+	assert.Error(t, err)
+}
@@ -0,0 +1,238 @@
+package article
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	titleCandidateLimit = 20
+	// minTitleSimilarity is the title similarity above which two articles may
+	// be the same work, given their year and first author do not disagree.
+	minTitleSimilarity = 0.85
+	// minTitleOnlySimilarity applies when neither year nor first author can
+	// be compared.
+	minTitleOnlySimilarity = 0.95
+	// maxYearGap allows for a preprint published a while before the journal
+	// version.
+	maxYearGap = 2
+)
+
+var doiPrefixes = []string{
+	"https://doi.org/",
+	"http://doi.org/",
+	"https://dx.doi.org/",
+	"http://dx.doi.org/",
+	"doi.org/",
+	"doi:",
+}
+
+// NormalizeDOI lower-cases a DOI and strips resolver prefixes, so the
+// different ways people paste the same DOI compare equal.
+func NormalizeDOI(doi string) string {
+	doi = strings.ToLower(strings.TrimSpace(doi))
+	for _, prefix := range doiPrefixes {
+		if strings.HasPrefix(doi, prefix) {
+			doi = strings.TrimSpace(strings.TrimPrefix(doi, prefix))
+			break
+		}
+	}
+	return doi
+}
+
+func (s *ArticleSerivceImp) FindDuplicates(ctx context.Context, request *article.FindDuplicatesRequest) (*article.FindDuplicatesResponse, error) {
+	original, err := s.getArticle(ctx, request.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unique index on doi_normalized means no other article shares the
+	// DOI, so candidates only come from similar titles.
+	similarTitle, err := s.queries.ListArticlesByTitleMatch(ctx, db.ListArticlesByTitleMatchParams{
+		Title:     original.Title,
+		ExcludeID: original.ID,
+		Limit:     titleCandidateLimit,
+	})
+	if err != nil {
+		slog.Error("failed to list articles by title", "error", err)
+		return nil, status.Error(codes.Internal, "failed to find duplicates")
+	}
+
+	originalAuthor, err := s.firstAuthor(ctx, original.ID)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*article.DuplicateCandidate
+	seen := make(map[int64]bool)
+	for _, other := range similarTitle {
+		if seen[other.ID] {
+			continue
+		}
+		seen[other.ID] = true
+
+		otherAuthor, err := s.firstAuthor(ctx, other.ID)
+		if err != nil {
+			return nil, err
+		}
+		reasons, similarity, ok := matchDuplicate(
+			duplicateKey{original, originalAuthor},
+			duplicateKey{other, otherAuthor},
+		)
+		if !ok {
+			continue
+		}
+		authors, err := s.queries.ListArticleAuthorsByArticleID(ctx, other.ID)
+		if err != nil {
+			slog.Error("failed to get article authors", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get article authors")
+		}
+		candidates = append(candidates, &article.DuplicateCandidate{
+			Article:         dbToGrpcArticle(other, authors),
+			Reasons:         reasons,
+			TitleSimilarity: similarity,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if len(candidates[i].Reasons) != len(candidates[j].Reasons) {
+			return len(candidates[i].Reasons) > len(candidates[j].Reasons)
+		}
+		return candidates[i].TitleSimilarity > candidates[j].TitleSimilarity
+	})
+	return &article.FindDuplicatesResponse{Candidates: candidates}, nil
+}
+
+// firstAuthor returns the family name of an article's first author, or ""
+// if it has none.
+func (s *ArticleSerivceImp) firstAuthor(ctx context.Context, articleID int64) (string, error) {
+	authors, err := s.queries.ListArticleAuthorsByArticleID(ctx, articleID)
+	if err != nil {
+		slog.Error("failed to get article authors", "error", err)
+		return "", status.Error(codes.Internal, "failed to get article authors")
+	}
+	if len(authors) == 0 {
+		return "", nil
+	}
+	return familyName(authors[0].AuthorName), nil
+}
+
+// familyName guesses the family name from an author stored as "Given
+// Family" or "Family, Given".
+func familyName(name string) string {
+	name = strings.TrimSpace(name)
+	if family, _, ok := strings.Cut(name, ","); ok {
+		return strings.ToLower(strings.TrimSpace(family))
+	}
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[len(fields)-1])
+}
+
+type duplicateKey struct {
+	article     db.Article
+	firstAuthor string
+}
+
+// matchDuplicate decides whether two articles are likely the same work. An
+// equal normalized DOI is decisive; otherwise the titles must be nearly the
+// same and neither the year nor the first author may disagree.
+func matchDuplicate(a, b duplicateKey) ([]article.DuplicateReason, float64, bool) {
+	var reasons []article.DuplicateReason
+	similarity := titleSimilarity(a.article.Title, b.article.Title)
+
+	sameDOI := a.article.DoiNormalized != "" && a.article.DoiNormalized == b.article.DoiNormalized
+	if sameDOI {
+		reasons = append(reasons, article.DuplicateReason_DUPLICATE_REASON_SAME_DOI)
+	}
+	if similarity >= minTitleSimilarity {
+		reasons = append(reasons, article.DuplicateReason_DUPLICATE_REASON_SIMILAR_TITLE)
+	}
+
+	comparable, conflict := false, false
+	if a.article.PublicationYear.Valid && b.article.PublicationYear.Valid {
+		comparable = true
+		gap := a.article.PublicationYear.Int32 - b.article.PublicationYear.Int32
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap <= maxYearGap {
+			reasons = append(reasons, article.DuplicateReason_DUPLICATE_REASON_CLOSE_YEAR)
+		} else {
+			conflict = true
+		}
+	}
+	if a.firstAuthor != "" && b.firstAuthor != "" {
+		comparable = true
+		if a.firstAuthor == b.firstAuthor {
+			reasons = append(reasons, article.DuplicateReason_DUPLICATE_REASON_SAME_FIRST_AUTHOR)
+		} else {
+			conflict = true
+		}
+	}
+
+	switch {
+	case sameDOI:
+		return reasons, similarity, true
+	case conflict:
+		return nil, similarity, false
+	case comparable:
+		return reasons, similarity, similarity >= minTitleSimilarity
+	default:
+		return reasons, similarity, similarity >= minTitleOnlySimilarity
+	}
+}
+
+// normalizeTitle lower-cases a title and reduces it to letters and digits
+// separated by single spaces.
+func normalizeTitle(title string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// titleSimilarity is the Dice coefficient of the character bigrams of both
+// normalized titles, from 0 for nothing in common to 1 for equal titles.
+func titleSimilarity(a, b string) float64 {
+	a, b = normalizeTitle(a), normalizeTitle(b)
+	if a == b {
+		return 1
+	}
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	if len(bigramsA) == 0 || len(bigramsB) == 0 {
+		return 0
+	}
+	counts := make(map[string]int, len(bigramsA))
+	for _, bg := range bigramsA {
+		counts[bg]++
+	}
+	shared := 0
+	for _, bg := range bigramsB {
+		if counts[bg] > 0 {
+			counts[bg]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(bigramsA)+len(bigramsB))
+}
+
+func bigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	out := make([]string, len(runes)-1)
+	for i := range out {
+		out[i] = string(runes[i : i+2])
+	}
+	return out
+}
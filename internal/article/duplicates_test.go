@@ -0,0 +1,163 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNormalizeDOI(t *testing.T) {
+	assert.Equal(t, "10.1000/abc.def", NormalizeDOI(" https://doi.org/10.1000/ABC.def "))
+	assert.Equal(t, "10.1000/abc", NormalizeDOI("http://dx.doi.org/10.1000/abc"))
+	assert.Equal(t, "10.1000/abc", NormalizeDOI("doi:10.1000/ABC"))
+	assert.Equal(t, "10.1000/abc", NormalizeDOI("10.1000/abc"))
+	assert.Equal(t, "", NormalizeDOI("  "))
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, titleSimilarity("Deep Learning: A Review", "deep learning - a review"))
+	assert.Greater(t, titleSimilarity("Attention is all you need", "Attention is all you need."), 0.99)
+	assert.Greater(t, titleSimilarity("A survey of graph neural networks", "A survey on graph neural networks"), minTitleSimilarity)
+	assert.Less(t, titleSimilarity("A survey of graph neural networks", "Protein folding with transformers"), 0.5)
+}
+
+func TestFamilyName(t *testing.T) {
+	assert.Equal(t, "curie", familyName("Marie Curie"))
+	assert.Equal(t, "curie", familyName("Curie, Marie"))
+	assert.Equal(t, "", familyName(" "))
+}
+
+func TestMatchDuplicate(t *testing.T) {
+	year := func(y int32) sql.NullInt32 { return sql.NullInt32{Int32: y, Valid: true} }
+	published := db.Article{Title: "A survey of graph neural networks", DoiNormalized: "10.1/journal", PublicationYear: year(2021)}
+	preprint := db.Article{Title: "A Survey of Graph Neural Networks", DoiNormalized: "10.48550/arxiv.1", PublicationYear: year(2020)}
+
+	reasons, _, ok := matchDuplicate(duplicateKey{published, "wu"}, duplicateKey{preprint, "wu"})
+	assert.True(t, ok)
+	assert.Equal(t, []article.DuplicateReason{
+		article.DuplicateReason_DUPLICATE_REASON_SIMILAR_TITLE,
+		article.DuplicateReason_DUPLICATE_REASON_CLOSE_YEAR,
+		article.DuplicateReason_DUPLICATE_REASON_SAME_FIRST_AUTHOR,
+	}, reasons)
+
+	// A different first author means a different paper with the same title.
+	_, _, ok = matchDuplicate(duplicateKey{published, "wu"}, duplicateKey{preprint, "zhou"})
+	assert.False(t, ok)
+
+	// Years too far apart.
+	later := preprint
+	later.PublicationYear = year(2015)
+	_, _, ok = matchDuplicate(duplicateKey{published, ""}, duplicateKey{later, ""})
+	assert.False(t, ok)
+
+	// The same normalized DOI is decisive even with a different title.
+	renamed := db.Article{Title: "Something else entirely", DoiNormalized: "10.1/journal"}
+	reasons, _, ok = matchDuplicate(duplicateKey{published, ""}, duplicateKey{renamed, ""})
+	assert.True(t, ok)
+	assert.Equal(t, []article.DuplicateReason{article.DuplicateReason_DUPLICATE_REASON_SAME_DOI}, reasons)
+}
+
+func TestMergeLibraryEntries(t *testing.T) {
+	target := db.LibraryArticle{
+		ID:              1,
+		ReadingStatus:   sql.NullInt16{Int16: 1, Valid: true},
+		ReadingProgress: sql.NullInt32{Int32: 0, Valid: true},
+		Notes:           sql.NullString{String: "mine", Valid: true},
+		Isfavorite:      sql.NullBool{Bool: false, Valid: true},
+	}
+	other := db.LibraryArticle{
+		ID:              2,
+		ReadingStatus:   sql.NullInt16{Int16: 3, Valid: true},
+		ReadingProgress: sql.NullInt32{Int32: 100, Valid: true},
+		Notes:           sql.NullString{String: "from the preprint", Valid: true},
+		Isfavorite:      sql.NullBool{Bool: true, Valid: true},
+	}
+
+	params := mergeLibraryEntries(target, other)
+	assert.Equal(t, int64(1), params.ID)
+	assert.Equal(t, int16(3), params.ReadingStatus.Int16)
+	assert.Equal(t, int32(100), params.ReadingProgress.Int32)
+	assert.Equal(t, "mine\n\nfrom the preprint", params.Notes.String)
+	assert.True(t, params.Isfavorite.Bool)
+
+	other.Notes = sql.NullString{String: "mine", Valid: true}
+	assert.Equal(t, "mine", mergeLibraryEntries(target, other).Notes.String)
+}
+
+func TestMergeLibraryArticlesReportsChangedEntries(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	// Library 1 only holds the duplicate; library 2 holds both.
+	fake.Return("ListLibraryArticlesByArticleID",
+		db.LibraryArticle{ID: 10, LibraryID: 1, ArticleID: 8},
+		db.LibraryArticle{ID: 11, LibraryID: 2, ArticleID: 8})
+	fake.ReturnOnce("GetLibraryArticle")
+	fake.ReturnOnce("GetLibraryArticle", db.LibraryArticle{ID: 20, LibraryID: 2, ArticleID: 3})
+
+	merged, err := mergeLibraryArticles(context.Background(), db.New(conn), 3, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 20}, merged.updated)
+	if assert.Len(t, merged.removed, 1) {
+		assert.Equal(t, int64(11), merged.removed[0].ID)
+	}
+	assert.Len(t, fake.Calls("MoveLibraryArticle"), 1)
+	assert.Len(t, fake.Calls("DeleteLibraryArticle"), 1)
+}
+
+func TestArticleService_GetArticleFollowsRedirect(t *testing.T) {
+	mockQueries := new(MockQueries)
+	articleService := &ArticleSerivceImp{queries: mockQueries}
+
+	mockQueries.On("GetArticle", mock.Anything, int64(7)).Return(db.Article{}, sql.ErrNoRows)
+	mockQueries.On("GetArticleRedirect", mock.Anything, int64(7)).Return(int64(3), nil)
+	mockQueries.On("GetArticle", mock.Anything, int64(3)).Return(db.Article{ID: 3, Title: "Survivor"}, nil)
+	mockQueries.On("ListArticleAuthorsByArticleID", mock.Anything, int64(3)).Return([]db.ListArticleAuthorsByArticleIDRow{}, nil)
+
+	response, err := articleService.GetArticle(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), response.Article.Id)
+	assert.Equal(t, int64(7), response.GetRedirectedFromId())
+	mockQueries.AssertExpectations(t)
+}
+
+func TestMigrateNormalizedDOIsMergesDuplicates(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("HasArticlesDOINormalizedIndex", int64(0))
+	fake.Return("HasArticlesDOINormalizedColumn", int64(0))
+	fake.Return("ListArticleDOIs",
+		db.ListArticleDOIsRow{ID: 1, Doi: "10.1/Paper"},
+		db.ListArticleDOIsRow{ID: 2, Doi: "10.1/other"},
+		db.ListArticleDOIsRow{ID: 3, Doi: "https://doi.org/10.1/paper"})
+	fake.Return("CountArticleReferences", int64(0))
+
+	assert.NoError(t, MigrateNormalizedDOIs(context.Background(), conn))
+
+	assert.Len(t, fake.Calls("AddArticlesDOINormalized"), 1)
+	var set [][]any
+	for _, call := range fake.Calls("SetArticleDOINormalized") {
+		set = append(set, []any{call.Args[0], call.Args[1]})
+	}
+	assert.Equal(t, [][]any{{"10.1/paper", int64(1)}, {"10.1/other", int64(2)}}, set)
+	if redirects := fake.Calls("CreateArticleRedirect"); assert.Len(t, redirects, 1) {
+		assert.Equal(t, dbtest.Values(db.CreateArticleRedirectParams{OldID: 3, OldDoi: "10.1/paper", NewID: 1}), redirects[0].Args)
+	}
+	if deleted := fake.Calls("DeleteArticle"); assert.Len(t, deleted, 1) {
+		assert.Equal(t, int64(3), deleted[0].Args[0])
+	}
+	assert.Len(t, fake.Calls("FinishArticlesDOINormalized"), 1)
+}
+
+func TestMigrateNormalizedDOIsRunsOnce(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("HasArticlesDOINormalizedIndex", int64(1))
+
+	assert.NoError(t, MigrateNormalizedDOIs(context.Background(), conn))
+	assert.Empty(t, fake.Calls("ListArticleDOIs"))
+	assert.Empty(t, fake.Calls("FinishArticlesDOINormalized"))
+}
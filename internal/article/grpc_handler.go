@@ -3,9 +3,8 @@ package article
 import (
 	"context"
 	"database/sql"
+
 	article "github.com/chiquitav2/journalful/pkg/articles/v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type ArticleGrpcHandler struct {
@@ -14,9 +13,6 @@ type ArticleGrpcHandler struct {
 }
 
 func (h *ArticleGrpcHandler) GetArticle(ctx context.Context, request *article.GetArticleRequest) (*article.GetArticleResponse, error) {
-	if request.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "article ID cannot be empty")
-	}
 	articleData, err := h.service.GetArticle(ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -26,10 +22,6 @@ func (h *ArticleGrpcHandler) GetArticle(ctx context.Context, request *article.Ge
 }
 
 func (h *ArticleGrpcHandler) GetArticleByDOI(ctx context.Context, request *article.GetArticleByDOIRequest) (*article.GetArticleByDOIResponse, error) {
-	if request.Doi == "" {
-		return nil, status.Error(codes.InvalidArgument, "DOI cannot be empty")
-	}
-
 	articleData, err := h.service.GetArticleByDOI(ctx, request.GetDoi())
 	if err != nil {
 		return nil, err
@@ -48,9 +40,6 @@ func (h *ArticleGrpcHandler) ListArticles(ctx context.Context, request *article.
 }
 
 func (h *ArticleGrpcHandler) CreateArticle(ctx context.Context, request *article.CreateArticleRequest) (*article.CreateArticleResponse, error) {
-	if request.Doi == "" {
-		return nil, status.Error(codes.InvalidArgument, "DOI cannot be empty")
-	}
 	articleData, err := h.service.CreateArticle(ctx, request)
 	if err != nil {
 		return nil, err
@@ -59,9 +48,6 @@ func (h *ArticleGrpcHandler) CreateArticle(ctx context.Context, request *article
 }
 
 func (h *ArticleGrpcHandler) UpdateArticle(ctx context.Context, request *article.UpdateArticleRequest) (*article.UpdateArticleResponse, error) {
-	if request.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "article ID cannot be empty")
-	}
 	articleData, err := h.service.UpdateArticle(ctx, request)
 	if err != nil {
 		return nil, err
@@ -70,9 +56,6 @@ func (h *ArticleGrpcHandler) UpdateArticle(ctx context.Context, request *article
 }
 
 func (h *ArticleGrpcHandler) DeleteArticle(ctx context.Context, request *article.DeleteArticleRequest) (*article.DeleteArticleResponse, error) {
-	if request.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "article ID cannot be empty")
-	}
 	deletedArticle, err := h.service.DeleteArticle(ctx, request)
 	if err != nil {
 		return nil, err
@@ -80,8 +63,32 @@ func (h *ArticleGrpcHandler) DeleteArticle(ctx context.Context, request *article
 	return deletedArticle, nil
 }
 
-func NewArticleGrpcHandler(db *sql.DB) *ArticleGrpcHandler {
+func (h *ArticleGrpcHandler) SearchArticles(ctx context.Context, request *article.SearchArticlesRequest) (*article.SearchArticlesResponse, error) {
+	return h.service.SearchArticles(ctx, request)
+}
+
+func (h *ArticleGrpcHandler) ListReferences(ctx context.Context, request *article.ListReferencesRequest) (*article.ListReferencesResponse, error) {
+	return h.service.ListReferences(ctx, request)
+}
+
+func (h *ArticleGrpcHandler) ListCitedBy(ctx context.Context, request *article.ListCitedByRequest) (*article.ListCitedByResponse, error) {
+	return h.service.ListCitedBy(ctx, request)
+}
+
+func (h *ArticleGrpcHandler) GetCitationGraph(ctx context.Context, request *article.GetCitationGraphRequest) (*article.GetCitationGraphResponse, error) {
+	return h.service.GetCitationGraph(ctx, request)
+}
+
+func (h *ArticleGrpcHandler) FindDuplicates(ctx context.Context, request *article.FindDuplicatesRequest) (*article.FindDuplicatesResponse, error) {
+	return h.service.FindDuplicates(ctx, request)
+}
+
+func (h *ArticleGrpcHandler) MergeArticles(ctx context.Context, request *article.MergeArticlesRequest) (*article.MergeArticlesResponse, error) {
+	return h.service.MergeArticles(ctx, request)
+}
+
+func NewArticleGrpcHandler(db *sql.DB, libraryEntries LibraryEntryListener) *ArticleGrpcHandler {
 	return &ArticleGrpcHandler{
-		service: NewArticleSerivce(db),
+		service: newArticleService(db, libraryEntries),
 	}
 }
@@ -0,0 +1,199 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MergeArticles folds a duplicate article into the survivor and deletes it,
+// leaving a redirect behind for its id and DOI.
+func (s *ArticleSerivceImp) MergeArticles(ctx context.Context, request *article.MergeArticlesRequest) (*article.MergeArticlesResponse, error) {
+	survivor, err := s.getArticle(ctx, request.SurvivorId)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := s.getArticle(ctx, request.DuplicateId)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries mergedEntries
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		var err error
+		entries, err = mergeArticles(ctx, q, survivor, duplicate)
+		return err
+	})
+	if err != nil {
+		slog.Error("failed to merge articles", "survivor_id", survivor.ID, "duplicate_id", duplicate.ID, "error", err)
+		return nil, status.Error(codes.Internal, "failed to merge articles")
+	}
+	slog.Info("articles merged", "survivor_id", survivor.ID, "duplicate_id", duplicate.ID)
+	if s.libraryEntries != nil {
+		s.libraryEntries.LibraryEntriesMerged(ctx, entries.updated, entries.removed)
+	}
+
+	response, err := s.GetArticle(ctx, survivor.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &article.MergeArticlesResponse{Article: response.Article}, nil
+}
+
+// LibraryEntryListener hears about the library entries an article merge
+// changed, so that the library service can tell their watchers.
+type LibraryEntryListener interface {
+	// LibraryEntriesMerged is called once the merge is stored. updated holds
+	// the ids of entries that now hold the survivor; removed holds duplicate
+	// entries that were folded into one of those and deleted.
+	LibraryEntriesMerged(ctx context.Context, updated []int64, removed []db.LibraryArticle)
+}
+
+// mergedEntries are the library entries a merge changed.
+type mergedEntries struct {
+	updated []int64
+	removed []db.LibraryArticle
+}
+
+func mergeArticles(ctx context.Context, q *db.Queries, survivor, duplicate db.Article) (mergedEntries, error) {
+	entries, err := mergeLibraryArticles(ctx, q, survivor.ID, duplicate.ID)
+	if err != nil {
+		return entries, err
+	}
+	return entries, mergeArticleRecords(ctx, q, survivor, duplicate)
+}
+
+// mergeArticleRecords moves everything but library entries from the
+// duplicate to the survivor and deletes the duplicate.
+func mergeArticleRecords(ctx context.Context, q *db.Queries, survivor, duplicate db.Article) error {
+	if err := q.MoveReadingEventsToArticle(ctx, db.MoveReadingEventsToArticleParams{SurvivorID: survivor.ID, DuplicateID: duplicate.ID}); err != nil {
+		return err
+	}
+	if err := q.CopyArticleTags(ctx, db.CopyArticleTagsParams{SurvivorID: survivor.ID, DuplicateID: duplicate.ID}); err != nil {
+		return err
+	}
+	if err := q.CopyArticleAuthors(ctx, db.CopyArticleAuthorsParams{SurvivorID: survivor.ID, DuplicateID: duplicate.ID}); err != nil {
+		return err
+	}
+	if err := q.MoveArticleAttachments(ctx, db.MoveArticleAttachmentsParams{
+		SurvivorID:  sql.NullInt64{Int64: survivor.ID, Valid: true},
+		DuplicateID: sql.NullInt64{Int64: duplicate.ID, Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	// Keep the survivor's reference list if it has one; merging two lists
+	// of the same work would only duplicate entries.
+	references, err := q.CountArticleReferences(ctx, survivor.ID)
+	if err != nil {
+		return err
+	}
+	if references == 0 {
+		if err := q.MoveArticleReferences(ctx, db.MoveArticleReferencesParams{SurvivorID: survivor.ID, DuplicateID: duplicate.ID}); err != nil {
+			return err
+		}
+	}
+	if err := q.RepointCitedReferences(ctx, db.RepointCitedReferencesParams{
+		SurvivorID:  sql.NullInt64{Int64: survivor.ID, Valid: true},
+		DuplicateID: sql.NullInt64{Int64: duplicate.ID, Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	if err := q.RepointArticleRedirects(ctx, db.RepointArticleRedirectsParams{SurvivorID: survivor.ID, DuplicateID: duplicate.ID}); err != nil {
+		return err
+	}
+	if err := q.CreateArticleRedirect(ctx, db.CreateArticleRedirectParams{
+		OldID:  duplicate.ID,
+		OldDoi: duplicate.DoiNormalized,
+		NewID:  survivor.ID,
+	}); err != nil {
+		return err
+	}
+	return q.DeleteArticle(ctx, duplicate.ID)
+}
+
+// mergeLibraryArticles moves the duplicate's library entries to the
+// survivor. Where a library already holds the survivor, the two entries are
+// combined and everything attached to the duplicate entry moves over.
+func mergeLibraryArticles(ctx context.Context, q *db.Queries, survivorID, duplicateID int64) (mergedEntries, error) {
+	var merged mergedEntries
+	entries, err := q.ListLibraryArticlesByArticleID(ctx, duplicateID)
+	if err != nil {
+		return merged, err
+	}
+	for _, entry := range entries {
+		target, err := q.GetLibraryArticle(ctx, db.GetLibraryArticleParams{LibraryID: entry.LibraryID, ArticleID: survivorID})
+		if err == sql.ErrNoRows {
+			if err := q.MoveLibraryArticle(ctx, db.MoveLibraryArticleParams{ArticleID: survivorID, ID: entry.ID}); err != nil {
+				return merged, err
+			}
+			merged.updated = append(merged.updated, entry.ID)
+			continue
+		}
+		if err != nil {
+			return merged, err
+		}
+
+		if err := q.UpdateLibraryArticle(ctx, mergeLibraryEntries(target, entry)); err != nil {
+			return merged, err
+		}
+		if err := q.MoveAnnotationsToLibraryArticle(ctx, db.MoveAnnotationsToLibraryArticleParams{ToID: target.ID, FromID: entry.ID}); err != nil {
+			return merged, err
+		}
+		if err := q.MoveAttachmentsToLibraryArticle(ctx, db.MoveAttachmentsToLibraryArticleParams{
+			ToID:   sql.NullInt64{Int64: target.ID, Valid: true},
+			FromID: sql.NullInt64{Int64: entry.ID, Valid: true},
+		}); err != nil {
+			return merged, err
+		}
+		if err := q.MoveReviewScheduleToLibraryArticle(ctx, db.MoveReviewScheduleToLibraryArticleParams{ToID: target.ID, FromID: entry.ID}); err != nil {
+			return merged, err
+		}
+		if err := q.MoveReadingEventsToLibraryArticle(ctx, db.MoveReadingEventsToLibraryArticleParams{
+			ToID:      target.ID,
+			ArticleID: survivorID,
+			FromID:    entry.ID,
+		}); err != nil {
+			return merged, err
+		}
+		if err := q.DeleteLibraryArticle(ctx, entry.ID); err != nil {
+			return merged, err
+		}
+		merged.updated = append(merged.updated, target.ID)
+		merged.removed = append(merged.removed, entry)
+	}
+	return merged, nil
+}
+
+// mergeLibraryEntries combines two entries for the same work in one
+// library: the reading state of whichever got further, both sets of notes,
+// and favorite if either was.
+func mergeLibraryEntries(target, other db.LibraryArticle) db.UpdateLibraryArticleParams {
+	params := db.UpdateLibraryArticleParams{
+		ID:              target.ID,
+		ReadingStatus:   target.ReadingStatus,
+		ReadingProgress: target.ReadingProgress,
+		Datecompleted:   target.Datecompleted,
+		Notes:           target.Notes,
+		Isfavorite:      sql.NullBool{Bool: target.Isfavorite.Bool || other.Isfavorite.Bool, Valid: true},
+	}
+	if other.ReadingProgress.Int32 > target.ReadingProgress.Int32 {
+		params.ReadingStatus = other.ReadingStatus
+		params.ReadingProgress = other.ReadingProgress
+		params.Datecompleted = other.Datecompleted
+	}
+	switch {
+	case !other.Notes.Valid || other.Notes.String == "" || other.Notes.String == target.Notes.String:
+	case !target.Notes.Valid || target.Notes.String == "":
+		params.Notes = other.Notes
+	default:
+		params.Notes = sql.NullString{String: target.Notes.String + "\n\n" + other.Notes.String, Valid: true}
+	}
+	return params
+}
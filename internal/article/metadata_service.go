@@ -1,14 +1,21 @@
 package article
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/metrics"
 	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // CrossRef API response structs
@@ -17,13 +24,39 @@ type CrossRefResponse struct {
 }
 
 type CrossRefMessage struct {
-	DOI             string           `json:"DOI"`
-	Title           []string         `json:"title"`
-	Author          []CrossRefAuthor `json:"author"`
-	PublishedOnline CrossRefDate     `json:"published"`
-	URL             string           `json:"URL"`
-	Abstract        string           `json:"abstract"`
-	ContainerTitle  []string         `json:"container-title"`
+	DOI             string              `json:"DOI"`
+	Title           []string            `json:"title"`
+	Author          []CrossRefAuthor    `json:"author"`
+	PublishedOnline CrossRefDate        `json:"published"`
+	URL             string              `json:"URL"`
+	Abstract        string              `json:"abstract"`
+	ContainerTitle  []string            `json:"container-title"`
+	Reference       []CrossRefReference `json:"reference"`
+	UpdateTo        []CrossRefUpdate    `json:"update-to"`
+	UpdatedBy       []CrossRefUpdate    `json:"updated-by"`
+}
+
+// CrossRefUpdate links a notice to the work it is about. A retraction notice
+// lists the retracted work in update-to; the retracted work lists the notice
+// in updated-by, where CrossRef knows about it.
+type CrossRefUpdate struct {
+	DOI     string       `json:"DOI"`
+	Type    string       `json:"type"`
+	Label   string       `json:"label"`
+	Updated CrossRefDate `json:"updated"`
+}
+
+// CrossRefReference is an entry of a work's reference list. Publishers
+// deposit these with varying completeness; often only Unstructured is set.
+type CrossRefReference struct {
+	Key          string `json:"key"`
+	DOI          string `json:"DOI"`
+	ArticleTitle string `json:"article-title"`
+	Author       string `json:"author"`
+	Year         string `json:"year"`
+	JournalTitle string `json:"journal-title"`
+	VolumeTitle  string `json:"volume-title"`
+	Unstructured string `json:"unstructured"`
 }
 
 type CrossRefAuthor struct {
@@ -35,6 +68,9 @@ type CrossRefDate struct {
 	DateParts [][]int `json:"date-parts"`
 }
 
+// crossRefProvider labels CrossRef calls in the metadata metrics.
+const crossRefProvider = "crossref"
+
 type MetadataService struct {
 	client *http.Client
 }
@@ -42,15 +78,42 @@ type MetadataService struct {
 func NewMetadataService() *MetadataService {
 	return &MetadataService{
 		client: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
 	}
 }
 
-func (s *MetadataService) FetchAndPrepareArticle(doi string) (*db.CreateArticleParams, []string, error) {
-	meta, err := s.fetchArticleMetadataFromDOI(doi)
+// PreparedArticle is what CrossRef knows about a DOI, ready to be stored.
+type PreparedArticle struct {
+	Article db.CreateArticleParams
+	Authors []string
+	// References lack CitingArticleID since the article has not been stored yet.
+	References []db.CreateArticleReferenceParams
+	// Updates are notices about this article, and if it is a notice itself,
+	// about the articles it corrects or retracts.
+	Updates []Update
+}
+
+// Update is a correction, retraction or similar notice about an article.
+type Update struct {
+	ArticleDOI string // Normalized DOI of the article the notice is about
+	NoticeDOI  string // Normalized DOI of the notice
+	Type       string // CrossRef update type, e.g. "retraction"
+	Label      string
+	Date       sql.NullTime
+}
+
+// FetchAndPrepareArticle looks the DOI up on CrossRef, or on DataCite for
+// DOIs registered there.
+func (s *MetadataService) FetchAndPrepareArticle(ctx context.Context, doi string) (*PreparedArticle, error) {
+	if isDataCiteDOI(doi) {
+		return s.fetchAndPrepareFromDataCite(ctx, doi)
+	}
+
+	meta, err := s.fetchArticleMetadataFromDOI(ctx, doi)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch article metadata from DOI: %w", err)
+		return nil, fmt.Errorf("failed to fetch article metadata from DOI: %w", err)
 	}
 
 	var articleTitle string
@@ -76,48 +139,163 @@ func (s *MetadataService) FetchAndPrepareArticle(doi string) (*db.CreateArticleP
 		normalizedAuthors = append(normalizedAuthors, fullName)
 	}
 
-	return &db.CreateArticleParams{
-		Title:           articleTitle,
-		Doi:             doi,
-		Url:             sql.NullString{String: meta.URL, Valid: meta.URL != ""},
-		Abstract:        articleAbstract,
-		PublicationYear: articlePublicationYear,
-		JournalName:     articleJournalName,
-	}, normalizedAuthors, nil
+	return &PreparedArticle{
+		Article: db.CreateArticleParams{
+			Title:           articleTitle,
+			Doi:             doi,
+			Url:             sql.NullString{String: meta.URL, Valid: meta.URL != ""},
+			Abstract:        articleAbstract,
+			PublicationYear: articlePublicationYear,
+			JournalName:     articleJournalName,
+		},
+		Authors:    normalizedAuthors,
+		References: prepareReferences(meta.Reference),
+		Updates:    prepareUpdates(NormalizeDOI(doi), meta),
+	}, nil
 }
 
-func (s *MetadataService) fetchArticleMetadataFromDOI(doi string) (*CrossRefMessage, error) {
-	url := fmt.Sprintf("https://api.crossref.org/v1/works/%s", doi)
+// prepareUpdates collects the notices about the work, and the works it is a
+// notice about. New versions and editions are not notices and are skipped.
+func prepareUpdates(doi string, meta *CrossRefMessage) []Update {
+	var updates []Update
+	add := func(articleDOI, noticeDOI string, u CrossRefUpdate) {
+		updateType := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(u.Type)), "-", "_")
+		if _, ok := updateFlag(updateType); !ok || articleDOI == "" || noticeDOI == "" {
+			return
+		}
+		updates = append(updates, Update{
+			ArticleDOI: articleDOI,
+			NoticeDOI:  noticeDOI,
+			Type:       updateType,
+			Label:      strings.TrimSpace(u.Label),
+			Date:       crossRefDate(u.Updated),
+		})
+	}
+	for _, u := range meta.UpdatedBy {
+		add(doi, NormalizeDOI(u.DOI), u)
+	}
+	for _, u := range meta.UpdateTo {
+		add(NormalizeDOI(u.DOI), doi, u)
+	}
+	return updates
+}
 
-	req, err := http.NewRequest("GET", url, nil)
+func crossRefDate(date CrossRefDate) sql.NullTime {
+	if len(date.DateParts) == 0 || len(date.DateParts[0]) == 0 {
+		return sql.NullTime{}
+	}
+	parts := append(append([]int{}, date.DateParts[0]...), 1, 1) // default a missing month and day to 1
+	month, day := parts[1], parts[2]
+	if month < 1 || month > 12 {
+		month = 1
+	}
+	if day < 1 || day > 31 {
+		day = 1
+	}
+	return sql.NullTime{Time: time.Date(parts[0], time.Month(month), day, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+func prepareReferences(refs []CrossRefReference) []db.CreateArticleReferenceParams {
+	params := make([]db.CreateArticleReferenceParams, len(refs))
+	for i, ref := range refs {
+		journal := ref.JournalTitle
+		if journal == "" {
+			journal = ref.VolumeTitle
+		}
+		doi := NormalizeDOI(ref.DOI)
+		unstructured := strings.TrimSpace(ref.Unstructured)
+		if utf8.RuneCountInString(doi) > 100 {
+			// Does not fit the column; keep it readable instead of dropping it.
+			if unstructured == "" {
+				unstructured = doi
+			}
+			doi = ""
+		}
+		params[i] = db.CreateArticleReferenceParams{
+			Position:        int32(i + 1),
+			CitedDoi:        optionalString(doi, 100),
+			Title:           optionalString(ref.ArticleTitle, 512),
+			Author:          optionalString(ref.Author, 255),
+			PublicationYear: referenceYear(ref.Year),
+			JournalName:     optionalString(journal, 255),
+			Unstructured:    sql.NullString{String: unstructured, Valid: unstructured != ""},
+		}
+	}
+	return params
+}
+
+// optionalString trims s to at most maxLen runes, treating blank as NULL.
+func optionalString(s string, maxLen int) sql.NullString {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) > maxLen {
+		s = string([]rune(s)[:maxLen])
+	}
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// referenceYear parses the year CrossRef reports for a reference, which may
+// carry a disambiguation suffix such as "2001a".
+func referenceYear(year string) sql.NullInt32 {
+	year = strings.TrimSpace(year)
+	if len(year) > 4 {
+		year = year[:4]
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil || len(year) != 4 {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(y), Valid: true}
+}
+
+func (s *MetadataService) fetchArticleMetadataFromDOI(ctx context.Context, doi string) (*CrossRefMessage, error) {
+	var crossRefResponse CrossRefResponse
+	if err := s.fetchJSON(ctx, crossRefProvider, fmt.Sprintf("https://api.crossref.org/v1/works/%s", doi), &crossRefResponse); err != nil {
+		return nil, err
+	}
+	slog.Info("Successfully fetched metadata from CrossRef", "doi", doi)
+	return &crossRefResponse.Message, nil
+}
+
+// fetchJSON gets url from a metadata provider and decodes the response into
+// v, recording the call in the metadata metrics.
+func (s *MetadataService) fetchJSON(ctx context.Context, provider, url string, v any) (err error) {
+	start := time.Now()
+	result := metrics.ResultSuccess
+	defer func() {
+		if err != nil && result == metrics.ResultSuccess {
+			result = metrics.ResultError
+		}
+		metrics.ObserveMetadataRequest(provider, result, time.Since(start))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+		return fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
 	req.Header.Set("User-Agent", "Journalful/1.0")
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make HTTP request to CrossRef API: %w", err)
+		return fmt.Errorf("failed to make HTTP request to %s API: %w", provider, err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		result = metrics.ResultNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
-		slog.Error("CrossRef API returned non-OK status", "status", resp.Status, "doi", doi)
-		return nil, fmt.Errorf("CrossRef API returned non-OK status: %s", resp.Status)
+		slog.Error("metadata API returned non-OK status", "provider", provider, "status", resp.Status, "url", url)
+		return fmt.Errorf("%s API returned non-OK status: %s", provider, resp.Status)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read CrossRef API response body: %w", err)
+		return fmt.Errorf("failed to read %s API response body: %w", provider, err)
 	}
 
-	var crossRefResponse CrossRefResponse
-	err = json.Unmarshal(body, &crossRefResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal CrossRef API response: %w", err)
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s API response: %w", provider, err)
 	}
-
-	slog.Info("Successfully fetched metadata from CrossRef", "doi", doi)
-	return &crossRefResponse.Message, nil
+	return nil
 }
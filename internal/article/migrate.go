@@ -0,0 +1,75 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/chiquitav2/journalful/internal/db"
+)
+
+// MigrateNormalizedDOIs brings a database from before doi_normalized up to
+// schema.sql. It adds the column, fills it in, merges articles whose DOIs
+// only differ in case or resolver prefix into the oldest of them, and then
+// makes the column required and unique. It does nothing once the unique
+// index exists, and picks up where it left off if it was interrupted.
+func MigrateNormalizedDOIs(ctx context.Context, conn *sql.DB) error {
+	q := db.NewTraced(conn)
+	done, err := q.HasArticlesDOINormalizedIndex(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look for the normalized DOI index: %w", err)
+	}
+	if done {
+		return nil
+	}
+	slog.Info("migrating articles to normalized DOIs")
+
+	hasColumn, err := q.HasArticlesDOINormalizedColumn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look for the normalized DOI column: %w", err)
+	}
+	if !hasColumn {
+		if err := q.AddArticlesDOINormalized(ctx); err != nil {
+			return fmt.Errorf("failed to add the normalized DOI column: %w", err)
+		}
+	}
+
+	rows, err := q.ListArticleDOIs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list article DOIs: %w", err)
+	}
+	// Rows come in id order, so the first article with a DOI is the oldest
+	// and survives.
+	survivors := make(map[string]int64)
+	merged := 0
+	for _, row := range rows {
+		normalized := NormalizeDOI(row.Doi)
+		survivorID, ok := survivors[normalized]
+		if !ok {
+			survivors[normalized] = row.ID
+			if err := q.SetArticleDOINormalized(ctx, db.SetArticleDOINormalizedParams{DoiNormalized: normalized, ID: row.ID}); err != nil {
+				return fmt.Errorf("failed to set the normalized DOI of article %d: %w", row.ID, err)
+			}
+			continue
+		}
+
+		survivor := db.Article{ID: survivorID, DoiNormalized: normalized}
+		duplicate := db.Article{ID: row.ID, Doi: row.Doi, DoiNormalized: normalized}
+		err := db.WithTx(ctx, conn, func(q *db.Queries) error {
+			_, err := mergeArticles(ctx, q, survivor, duplicate)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to merge article %d into %d: %w", duplicate.ID, survivor.ID, err)
+		}
+		slog.Info("articles merged", "survivor_id", survivor.ID, "duplicate_id", duplicate.ID)
+		merged++
+	}
+
+	if err := q.FinishArticlesDOINormalized(ctx); err != nil {
+		return fmt.Errorf("failed to index the normalized DOI column: %w", err)
+	}
+	slog.Info("articles migrated to normalized DOIs", "articles", len(rows), "merged", merged)
+	return nil
+}
@@ -0,0 +1,261 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultGraphDepth = 1
+	maxGraphDepth     = 3
+	maxGraphNodes     = 500
+)
+
+// storeReferences saves the reference list of a newly created article and
+// links it to local articles in both directions: references it makes to
+// articles we already have, and dangling references elsewhere that cite it.
+func storeReferences(ctx context.Context, q articleQueries, articleID int64, doi string, references []db.CreateArticleReferenceParams) error {
+	if len(references) > 0 {
+		for i := range references {
+			references[i].CitingArticleID = articleID
+		}
+		if err := q.CreateArticleReferences(ctx, references); err != nil {
+			slog.Error("failed to create article references", "error", err, "article_id", articleID)
+			return status.Error(codes.Internal, "failed to create article references")
+		}
+		if err := q.ResolveReferencesFromArticle(ctx, articleID); err != nil {
+			slog.Error("failed to resolve article references", "error", err, "article_id", articleID)
+			return status.Error(codes.Internal, "failed to resolve article references")
+		}
+	}
+	err := q.ResolveReferencesToArticle(ctx, db.ResolveReferencesToArticleParams{
+		ArticleID: sql.NullInt64{Int64: articleID, Valid: true},
+		Doi:       sql.NullString{String: doi, Valid: true},
+	})
+	if err != nil {
+		slog.Error("failed to resolve citing references", "error", err, "article_id", articleID)
+		return status.Error(codes.Internal, "failed to resolve article references")
+	}
+	return nil
+}
+
+func (s *ArticleSerivceImp) ListReferences(ctx context.Context, request *article.ListReferencesRequest) (*article.ListReferencesResponse, error) {
+	if _, err := s.getArticle(ctx, request.ArticleId); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListArticleReferences(ctx, request.ArticleId)
+	if err != nil {
+		slog.Error("failed to list article references", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list article references")
+	}
+
+	references := make([]*article.Reference, len(rows))
+	for i, row := range rows {
+		references[i] = &article.Reference{
+			Position:     row.Position,
+			Doi:          nullableString(row.CitedDoi),
+			Title:        nullableString(sql.NullString{String: row.Title, Valid: row.Title != ""}),
+			Author:       nullableString(row.Author),
+			JournalName:  nullableString(row.JournalName),
+			Unstructured: nullableString(row.Unstructured),
+		}
+		if row.CitedArticleID.Valid {
+			references[i].CitedArticleId = &row.CitedArticleID.Int64
+		}
+		if row.PublicationYear.Valid {
+			references[i].PublicationYear = &row.PublicationYear.Int32
+		}
+	}
+	return &article.ListReferencesResponse{References: references}, nil
+}
+
+func (s *ArticleSerivceImp) ListCitedBy(ctx context.Context, request *article.ListCitedByRequest) (*article.ListCitedByResponse, error) {
+	if _, err := s.getArticle(ctx, request.ArticleId); err != nil {
+		return nil, err
+	}
+
+	citing, err := s.queries.ListCitingArticles(ctx, sql.NullInt64{Int64: request.ArticleId, Valid: true})
+	if err != nil {
+		slog.Error("failed to list citing articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list citing articles")
+	}
+
+	articles := make([]*article.Article, len(citing))
+	for i, a := range citing {
+		authors, err := s.queries.ListArticleAuthorsByArticleID(ctx, a.ID)
+		if err != nil {
+			slog.Error("failed to get article authors", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get article authors")
+		}
+		articles[i] = dbToGrpcArticle(a, authors)
+	}
+	return &article.ListCitedByResponse{Articles: articles}, nil
+}
+
+// GetCitationGraph walks the reference graph breadth-first from an article.
+// Only local articles are expanded; dangling references are leaves.
+func (s *ArticleSerivceImp) GetCitationGraph(ctx context.Context, request *article.GetCitationGraphRequest) (*article.GetCitationGraphResponse, error) {
+	root, err := s.getArticle(ctx, request.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	depth := request.Depth
+	if depth <= 0 {
+		depth = defaultGraphDepth
+	}
+	if depth > maxGraphDepth {
+		depth = maxGraphDepth
+	}
+	followReferences := request.Direction != article.CitationDirection_CITATION_DIRECTION_CITED_BY
+	followCitedBy := request.Direction != article.CitationDirection_CITATION_DIRECTION_REFERENCES
+
+	graph := newCitationGraph(maxGraphNodes)
+	graph.addNode(&article.CitationNode{
+		Key:       articleKey(root.ID),
+		ArticleId: &root.ID,
+		Doi:       &root.Doi,
+		Title:     root.Title,
+	})
+
+	frontier := []int64{root.ID}
+	for level := int32(1); level <= depth && len(frontier) > 0; level++ {
+		var next []int64
+		if followReferences {
+			edges, err := s.queries.ListReferenceEdgesFrom(ctx, frontier)
+			if err != nil {
+				slog.Error("failed to list reference edges", "error", err)
+				return nil, status.Error(codes.Internal, "failed to build citation graph")
+			}
+			for _, edge := range edges {
+				node := referenceNode(edge, level)
+				if graph.addNode(node) && node.ArticleId != nil {
+					next = append(next, *node.ArticleId)
+				}
+				graph.addEdge(articleKey(edge.CitingArticleID), node.Key)
+			}
+		}
+		if followCitedBy {
+			ids := make([]sql.NullInt64, len(frontier))
+			for i, id := range frontier {
+				ids[i] = sql.NullInt64{Int64: id, Valid: true}
+			}
+			edges, err := s.queries.ListReferenceEdgesTo(ctx, ids)
+			if err != nil {
+				slog.Error("failed to list citing edges", "error", err)
+				return nil, status.Error(codes.Internal, "failed to build citation graph")
+			}
+			for _, edge := range edges {
+				id, doi := edge.CitingArticleID, edge.CitingDoi
+				node := &article.CitationNode{Key: articleKey(id), ArticleId: &id, Doi: &doi, Title: edge.CitingTitle, Depth: level}
+				if graph.addNode(node) {
+					next = append(next, id)
+				}
+				graph.addEdge(node.Key, articleKey(edge.CitedArticleID.Int64))
+			}
+		}
+		frontier = next
+	}
+
+	return &article.GetCitationGraphResponse{
+		Nodes:     graph.nodes,
+		Edges:     graph.edges,
+		Truncated: graph.truncated,
+	}, nil
+}
+
+func (s *ArticleSerivceImp) getArticle(ctx context.Context, id int64) (db.Article, error) {
+	a, err := s.queries.GetArticle(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Article{}, status.Error(codes.NotFound, "article not found")
+		}
+		slog.Error("failed to get article", "error", err)
+		return db.Article{}, status.Error(codes.Internal, "failed to get article")
+	}
+	return a, nil
+}
+
+// citationGraph collects nodes and edges in discovery order, ignoring
+// duplicates and stopping at a node limit.
+type citationGraph struct {
+	limit     int
+	nodes     []*article.CitationNode
+	edges     []*article.CitationEdge
+	seenNodes map[string]bool
+	seenEdges map[[2]string]bool
+	truncated bool
+}
+
+func newCitationGraph(limit int) *citationGraph {
+	return &citationGraph{
+		limit:     limit,
+		seenNodes: make(map[string]bool),
+		seenEdges: make(map[[2]string]bool),
+	}
+}
+
+// addNode reports whether the node is new to the graph.
+func (g *citationGraph) addNode(node *article.CitationNode) bool {
+	if g.seenNodes[node.Key] {
+		return false
+	}
+	if len(g.nodes) >= g.limit {
+		g.truncated = true
+		return false
+	}
+	g.seenNodes[node.Key] = true
+	g.nodes = append(g.nodes, node)
+	return true
+}
+
+// addEdge adds an edge between two nodes already in the graph.
+func (g *citationGraph) addEdge(citing, cited string) {
+	key := [2]string{citing, cited}
+	if !g.seenNodes[citing] || !g.seenNodes[cited] || g.seenEdges[key] {
+		return
+	}
+	g.seenEdges[key] = true
+	g.edges = append(g.edges, &article.CitationEdge{CitingKey: citing, CitedKey: cited})
+}
+
+func articleKey(id int64) string {
+	return fmt.Sprintf("article:%d", id)
+}
+
+// referenceNode describes the cited side of a reference. Dangling
+// references with the same DOI collapse into one node; those without a DOI
+// stay separate.
+func referenceNode(edge db.ListReferenceEdgesFromRow, depth int32) *article.CitationNode {
+	node := &article.CitationNode{Title: edge.CitedTitle, Depth: depth}
+	if edge.CitedDoi.Valid {
+		doi := edge.CitedDoi.String
+		node.Doi = &doi
+	}
+	switch {
+	case edge.CitedArticleID.Valid:
+		id := edge.CitedArticleID.Int64
+		node.Key = articleKey(id)
+		node.ArticleId = &id
+	case edge.CitedDoi.Valid:
+		node.Key = "doi:" + strings.ToLower(edge.CitedDoi.String)
+	default:
+		node.Key = fmt.Sprintf("ref:%d", edge.ID)
+	}
+	return node
+}
+
+func nullableString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
@@ -0,0 +1,73 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPrepareReferences(t *testing.T) {
+	refs := prepareReferences([]CrossRefReference{
+		{DOI: "10.1000/xyz", ArticleTitle: "Cited work", Author: "Smith", Year: "2001a", JournalTitle: "Nature"},
+		{Unstructured: "  Doe J. Some book. 1999.  ", VolumeTitle: "Some book"},
+	})
+
+	assert.Len(t, refs, 2)
+	assert.Equal(t, int32(1), refs[0].Position)
+	assert.Equal(t, sql.NullString{String: "10.1000/xyz", Valid: true}, refs[0].CitedDoi)
+	assert.Equal(t, sql.NullInt32{Int32: 2001, Valid: true}, refs[0].PublicationYear)
+	assert.Equal(t, "Nature", refs[0].JournalName.String)
+
+	assert.Equal(t, int32(2), refs[1].Position)
+	assert.False(t, refs[1].CitedDoi.Valid)
+	assert.False(t, refs[1].PublicationYear.Valid)
+	assert.Equal(t, "Some book", refs[1].JournalName.String)
+	assert.Equal(t, "Doe J. Some book. 1999.", refs[1].Unstructured.String)
+}
+
+func TestCitationGraph_Limit(t *testing.T) {
+	g := newCitationGraph(2)
+	assert.True(t, g.addNode(&article.CitationNode{Key: "article:1"}))
+	assert.False(t, g.addNode(&article.CitationNode{Key: "article:1"}))
+	assert.True(t, g.addNode(&article.CitationNode{Key: "doi:10.1/a"}))
+	assert.False(t, g.addNode(&article.CitationNode{Key: "ref:7"}))
+	assert.True(t, g.truncated)
+
+	g.addEdge("article:1", "doi:10.1/a")
+	g.addEdge("article:1", "doi:10.1/a")
+	g.addEdge("article:1", "ref:7") // dropped with its node
+	assert.Len(t, g.edges, 1)
+}
+
+func TestArticleService_GetCitationGraph(t *testing.T) {
+	mockQueries := new(MockQueries)
+	articleService := &ArticleSerivceImp{queries: mockQueries}
+
+	mockQueries.On("GetArticle", mock.Anything, int64(1)).Return(db.Article{ID: 1, Doi: "10.1/root", Title: "Root"}, nil)
+	mockQueries.On("ListReferenceEdgesFrom", mock.Anything, []int64{1}).Return([]db.ListReferenceEdgesFromRow{
+		{ID: 10, CitingArticleID: 1, CitedArticleID: sql.NullInt64{Int64: 2, Valid: true}, CitedDoi: sql.NullString{String: "10.1/local", Valid: true}, CitedTitle: "Local"},
+		{ID: 11, CitingArticleID: 1, CitedDoi: sql.NullString{String: "10.1/Dangling", Valid: true}, CitedTitle: "Dangling"},
+		{ID: 12, CitingArticleID: 1, CitedTitle: "Unstructured"},
+	}, nil)
+	mockQueries.On("ListReferenceEdgesTo", mock.Anything, []sql.NullInt64{{Int64: 1, Valid: true}}).Return([]db.ListReferenceEdgesToRow{
+		{CitingArticleID: 3, CitedArticleID: sql.NullInt64{Int64: 1, Valid: true}, CitingDoi: "10.1/citer", CitingTitle: "Citer"},
+	}, nil)
+
+	response, err := articleService.GetCitationGraph(context.Background(), &article.GetCitationGraphRequest{ArticleId: 1})
+
+	assert.NoError(t, err)
+	var keys []string
+	for _, node := range response.Nodes {
+		keys = append(keys, node.Key)
+	}
+	assert.Equal(t, []string{"article:1", "article:2", "doi:10.1/dangling", "ref:12", "article:3"}, keys)
+	assert.Len(t, response.Edges, 4)
+	assert.Equal(t, &article.CitationEdge{CitingKey: "article:3", CitedKey: "article:1"}, response.Edges[3])
+	assert.False(t, response.Truncated)
+	mockQueries.AssertExpectations(t)
+}
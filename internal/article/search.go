@@ -0,0 +1,225 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+	maxPageHitsPerArticle = 5
+	snippetRadius         = 80 // characters of context on each side of a match
+)
+
+// SearchArticles matches the query against article metadata and the
+// extracted text of attached PDFs. Articles whose metadata matches come
+// first, followed by full-text matches in order of relevance.
+func (s *ArticleSerivceImp) SearchArticles(ctx context.Context, request *article.SearchArticlesRequest) (*article.SearchArticlesResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	pattern := utils.LikePattern(query)
+	metadataMatches, err := s.queries.SearchArticlesByMetadata(ctx, db.SearchArticlesByMetadataParams{
+		TitlePattern:    pattern,
+		AbstractPattern: sql.NullString{String: pattern, Valid: true},
+		Doi:             query,
+		Limit:           pageSize,
+	})
+	if err != nil {
+		slog.Error("failed to search article metadata", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search articles")
+	}
+	pages, err := s.queries.SearchAttachmentPages(ctx, db.SearchAttachmentPagesParams{
+		Query:    query,
+		ViewerID: request.UserId,
+		Limit:    pageSize * maxPageHitsPerArticle,
+	})
+	if err != nil {
+		slog.Error("failed to search attachment text", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search articles")
+	}
+
+	hits, missing := mergeSearchResults(metadataMatches, pages, query, int(pageSize))
+	if len(missing) > 0 {
+		articles, err := s.queries.ListArticlesByIDs(ctx, missing)
+		if err != nil {
+			slog.Error("failed to list articles", "error", err)
+			return nil, status.Error(codes.Internal, "failed to search articles")
+		}
+		byID := make(map[int64]db.Article, len(articles))
+		for _, a := range articles {
+			byID[a.ID] = a
+		}
+		kept := hits[:0]
+		for _, hit := range hits {
+			if hit.Article == nil {
+				a, ok := byID[hit.PageHits[0].articleID]
+				if !ok {
+					continue // deleted since the search ran
+				}
+				hit.Article = dbToGrpcArticle(a, nil)
+			}
+			kept = append(kept, hit)
+		}
+		hits = kept
+	}
+
+	ids := make([]int64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Article.Id
+	}
+	authors, err := s.listAuthorsByArticle(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	response := &article.SearchArticlesResponse{Hits: make([]*article.ArticleSearchHit, len(hits))}
+	for i, hit := range hits {
+		hit.Article.Authors = dbToGrpcArticle(db.Article{}, authors[hit.Article.Id]).Authors
+		response.Hits[i] = hit.toGrpc()
+	}
+	return response, nil
+}
+
+// listAuthorsByArticle loads the authors of several articles with one
+// query, in author order for each article.
+func (s *ArticleSerivceImp) listAuthorsByArticle(ctx context.Context, ids []int64) (map[int64][]db.ListArticleAuthorsByArticleIDRow, error) {
+	byArticle := make(map[int64][]db.ListArticleAuthorsByArticleIDRow, len(ids))
+	if len(ids) == 0 {
+		return byArticle, nil
+	}
+	rows, err := s.queries.ListArticleAuthorsByArticleIDs(ctx, ids)
+	if err != nil {
+		slog.Error("failed to get article authors", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get article authors")
+	}
+	for _, row := range rows {
+		byArticle[row.ArticleID] = append(byArticle[row.ArticleID], db.ListArticleAuthorsByArticleIDRow{
+			AuthorID:    row.AuthorID,
+			AuthorOrder: row.AuthorOrder,
+			AuthorName:  row.AuthorName,
+			ProfileID:   row.ProfileID,
+		})
+	}
+	return byArticle, nil
+}
+
+type searchHit struct {
+	Article       *article.Article
+	MetadataMatch bool
+	PageHits      []pageHit
+}
+
+type pageHit struct {
+	articleID    int64
+	attachmentID int64
+	pageNumber   int32
+	snippet      string
+}
+
+func (h searchHit) toGrpc() *article.ArticleSearchHit {
+	grpcHit := &article.ArticleSearchHit{Article: h.Article, MetadataMatch: h.MetadataMatch}
+	for _, p := range h.PageHits {
+		grpcHit.PageHits = append(grpcHit.PageHits, &article.PageHit{
+			AttachmentId: p.attachmentID,
+			PageNumber:   p.pageNumber,
+			Snippet:      p.snippet,
+		})
+	}
+	return grpcHit
+}
+
+// mergeSearchResults groups matching pages by article and puts them behind
+// the metadata matches, keeping at most limit articles. It also returns the
+// ids of articles only found through their pages, whose details still need
+// to be loaded; their hits have a nil Article.
+func mergeSearchResults(metadataMatches []db.Article, pages []db.SearchAttachmentPagesRow, query string, limit int) ([]*searchHit, []int64) {
+	var hits []*searchHit
+	byArticle := make(map[int64]*searchHit)
+	for _, a := range metadataMatches {
+		hit := &searchHit{Article: dbToGrpcArticle(a, nil), MetadataMatch: true}
+		hits = append(hits, hit)
+		byArticle[a.ID] = hit
+	}
+
+	var missing []int64
+	for _, p := range pages {
+		hit, ok := byArticle[p.ArticleID]
+		if !ok {
+			if len(hits) >= limit {
+				continue
+			}
+			hit = &searchHit{}
+			hits = append(hits, hit)
+			byArticle[p.ArticleID] = hit
+			missing = append(missing, p.ArticleID)
+		}
+		if len(hit.PageHits) >= maxPageHitsPerArticle {
+			continue
+		}
+		hit.PageHits = append(hit.PageHits, pageHit{
+			articleID:    p.ArticleID,
+			attachmentID: p.AttachmentID,
+			pageNumber:   p.PageNumber,
+			snippet:      snippet(p.Text, query),
+		})
+	}
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, missing
+}
+
+// snippet returns the text around the first occurrence of any query word,
+// or the start of the page if none occurs verbatim (full-text matching also
+// finds inflected forms).
+func snippet(text, query string) string {
+	lower := strings.ToLower(text)
+	at := -1
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if i := strings.Index(lower, word); i >= 0 && (at < 0 || i < at) {
+			at = i
+		}
+	}
+	if at < 0 {
+		at = 0
+	}
+
+	start := at
+	for n := 0; n < snippetRadius && start > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	end := at
+	for n := 0; n < 2*snippetRadius && end < len(text); n++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+
+	result := strings.TrimSpace(text[start:end])
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(text) {
+		result += "…"
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package article
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSnippet(t *testing.T) {
+	short := "Protein folding is hard."
+	assert.Equal(t, short, snippet(short, "folding"))
+
+	long := strings.Repeat("a ", 200) + "Folding happens here" + strings.Repeat(" b", 200)
+	s := snippet(long, "folding proteins")
+	assert.True(t, strings.HasPrefix(s, "…"))
+	assert.True(t, strings.HasSuffix(s, "…"))
+	assert.Contains(t, s, "Folding happens here")
+
+	// No verbatim match falls back to the start of the page.
+	assert.True(t, strings.HasPrefix(snippet(long, "unrelated"), "a a"))
+}
+
+func TestMergeSearchResults(t *testing.T) {
+	metadata := []db.Article{{ID: 1, Title: "One"}}
+	pages := []db.SearchAttachmentPagesRow{
+		{AttachmentID: 10, ArticleID: 2, PageNumber: 3, Text: "term"},
+		{AttachmentID: 11, ArticleID: 1, PageNumber: 1, Text: "term"},
+		{AttachmentID: 10, ArticleID: 2, PageNumber: 4, Text: "term"},
+		{AttachmentID: 12, ArticleID: 3, PageNumber: 1, Text: "term"},
+	}
+
+	hits, missing := mergeSearchResults(metadata, pages, "term", 2)
+	assert.Equal(t, []int64{2}, missing)
+	if assert.Len(t, hits, 2) {
+		assert.True(t, hits[0].MetadataMatch)
+		assert.Len(t, hits[0].PageHits, 1)
+		assert.False(t, hits[1].MetadataMatch)
+		assert.Nil(t, hits[1].Article)
+		assert.Len(t, hits[1].PageHits, 2)
+	}
+}
+
+func TestSearchArticlesLoadsAuthorsInOneQuery(t *testing.T) {
+	mockQueries := new(MockQueries)
+	articleService := &ArticleSerivceImp{queries: mockQueries}
+	mockQueries.On("SearchArticlesByMetadata", mock.Anything, mock.Anything).Return([]db.Article{{ID: 1, Title: "One"}}, nil)
+	mockQueries.On("SearchAttachmentPages", mock.Anything, mock.Anything).Return([]db.SearchAttachmentPagesRow{
+		{AttachmentID: 10, ArticleID: 2, PageNumber: 3, Text: "term"},
+	}, nil)
+	mockQueries.On("ListArticlesByIDs", mock.Anything, []int64{2}).Return([]db.Article{{ID: 2, Title: "Two"}}, nil)
+	mockQueries.On("ListArticleAuthorsByArticleIDs", mock.Anything, []int64{1, 2}).Return([]db.ListArticleAuthorsByArticleIDsRow{
+		{ArticleID: 1, AuthorID: 5, AuthorName: "Ada Lovelace"},
+		{ArticleID: 1, AuthorID: 6, AuthorName: "Charles Babbage"},
+		{ArticleID: 2, AuthorID: 7, AuthorName: "Grace Hopper"},
+	}, nil)
+
+	response, err := articleService.SearchArticles(context.Background(), &article.SearchArticlesRequest{Query: "term"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, response.Hits, 2) {
+		assert.Equal(t, []string{"Ada Lovelace", "Charles Babbage"}, authorNames(response.Hits[0].Article))
+		assert.Equal(t, []string{"Grace Hopper"}, authorNames(response.Hits[1].Article))
+	}
+	mockQueries.AssertExpectations(t)
+	mockQueries.AssertNotCalled(t, "ListArticleAuthorsByArticleID", mock.Anything, mock.Anything)
+}
+
+func authorNames(a *article.Article) []string {
+	var names []string
+	for _, author := range a.Authors {
+		names = append(names, author.Name)
+	}
+	return names
+}
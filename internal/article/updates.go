@@ -0,0 +1,154 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	updateCheckInterval = time.Hour
+	// recheckAfter is how long an article goes before CrossRef is asked
+	// again whether it has been corrected or retracted.
+	recheckAfter     = 7 * 24 * time.Hour
+	updateCheckBatch = 50
+	// crossRefDelay spaces out requests to stay within CrossRef's polite
+	// usage limits.
+	crossRefDelay = time.Second
+)
+
+// updateFlag maps a CrossRef update type to the flag it puts on the
+// article. Types that are not notices, like new versions, are not mapped.
+func updateFlag(updateType string) (article.ArticleFlag, bool) {
+	switch updateType {
+	case "correction", "corrigendum", "erratum", "addendum", "clarification":
+		return article.ArticleFlag_ARTICLE_FLAG_CORRECTED, true
+	case "expression_of_concern", "partial_retraction":
+		return article.ArticleFlag_ARTICLE_FLAG_EXPRESSION_OF_CONCERN, true
+	case "retraction", "withdrawal", "removal":
+		return article.ArticleFlag_ARTICLE_FLAG_RETRACTED, true
+	}
+	return article.ArticleFlag_ARTICLE_FLAG_NONE, false
+}
+
+type updateQueries interface {
+	GetArticleByNormalizedDOI(ctx context.Context, doiNormalized string) (db.Article, error)
+	UpsertArticleUpdate(ctx context.Context, arg db.UpsertArticleUpdateParams) error
+	RefreshArticleFlag(ctx context.Context, arg db.RefreshArticleFlagParams) error
+}
+
+// recordUpdates stores the notices about articles we have and refreshes
+// their flags. Notices about articles not stored here are dropped; the
+// article will list them in updated-by once it is added.
+func recordUpdates(ctx context.Context, q updateQueries, updates []Update) error {
+	for _, u := range updates {
+		flag, ok := updateFlag(u.Type)
+		if !ok {
+			continue
+		}
+		target, err := q.GetArticleByNormalizedDOI(ctx, u.ArticleDOI)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to look up updated article: %w", err)
+		}
+		if err := q.UpsertArticleUpdate(ctx, db.UpsertArticleUpdateParams{
+			ArticleID:  target.ID,
+			UpdateType: u.Type,
+			Flag:       int8(flag),
+			NoticeDoi:  u.NoticeDOI,
+			Label:      sql.NullString{String: u.Label, Valid: u.Label != ""},
+			UpdatedOn:  u.Date,
+		}); err != nil {
+			return fmt.Errorf("failed to store article update: %w", err)
+		}
+		if err := q.RefreshArticleFlag(ctx, db.RefreshArticleFlagParams{ArticleID: target.ID}); err != nil {
+			return fmt.Errorf("failed to refresh article flag: %w", err)
+		}
+	}
+	return nil
+}
+
+// DbToGrpcArticleUpdate converts a stored notice.
+func DbToGrpcArticleUpdate(u db.ArticleUpdate) *article.ArticleUpdate {
+	grpcUpdate := &article.ArticleUpdate{
+		Type:      u.UpdateType,
+		Flag:      article.ArticleFlag(u.Flag),
+		NoticeDoi: u.NoticeDoi,
+	}
+	if u.Label.Valid {
+		grpcUpdate.Label = &u.Label.String
+	}
+	if u.UpdatedOn.Valid {
+		grpcUpdate.UpdatedOn = timestamppb.New(u.UpdatedOn.Time)
+	}
+	return grpcUpdate
+}
+
+// UpdateChecker periodically asks CrossRef whether stored articles have
+// been corrected or retracted since they were added.
+type UpdateChecker struct {
+	queries  *db.Queries
+	metadata *MetadataService
+	now      func() time.Time
+}
+
+func NewUpdateChecker(conn *sql.DB) *UpdateChecker {
+	return &UpdateChecker{
+		queries:  db.NewTraced(conn),
+		metadata: NewMetadataService(),
+		now:      time.Now,
+	}
+}
+
+// Run checks articles that are due until ctx is cancelled.
+func (c *UpdateChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(updateCheckInterval)
+	defer ticker.Stop()
+	for {
+		c.checkDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *UpdateChecker) checkDue(ctx context.Context) {
+	due, err := c.queries.ListArticlesDueForUpdateCheck(ctx, db.ListArticlesDueForUpdateCheckParams{
+		CheckedBefore: sql.NullTime{Time: c.now().Add(-recheckAfter), Valid: true},
+		Limit:         updateCheckBatch,
+	})
+	if err != nil {
+		slog.Error("failed to list articles due for an update check", "error", err)
+		return
+	}
+	for _, a := range due {
+		prepared, err := c.metadata.FetchAndPrepareArticle(ctx, a.DoiNormalized)
+		if err != nil {
+			// Manually entered articles may not be known to CrossRef at all;
+			// they are marked as checked anyway so they are not retried hourly.
+			slog.Warn("failed to check article for updates", "id", a.ID, "doi", a.DoiNormalized, "error", err)
+		} else if err := recordUpdates(ctx, c.queries, prepared.Updates); err != nil {
+			slog.Error("failed to record article updates", "id", a.ID, "error", err)
+			continue
+		}
+		if err := c.queries.MarkArticleUpdatesChecked(ctx, a.ID); err != nil {
+			slog.Error("failed to mark article as checked", "id", a.ID, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(crossRefDelay):
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPrepareUpdates(t *testing.T) {
+	retracted := &CrossRefMessage{
+		UpdatedBy: []CrossRefUpdate{
+			{DOI: "10.1/NOTICE", Type: "retraction", Label: "Retraction", Updated: CrossRefDate{DateParts: [][]int{{2023, 5, 2}}}},
+			{DOI: "10.1/v2", Type: "new_version"},
+		},
+	}
+	updates := prepareUpdates("10.1/paper", retracted)
+	assert.Equal(t, []Update{{
+		ArticleDOI: "10.1/paper",
+		NoticeDOI:  "10.1/notice",
+		Type:       "retraction",
+		Label:      "Retraction",
+		Date:       sql.NullTime{Time: time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+	}}, updates)
+
+	notice := &CrossRefMessage{
+		UpdateTo: []CrossRefUpdate{{DOI: "https://doi.org/10.1/Paper", Type: "expression-of-concern", Updated: CrossRefDate{DateParts: [][]int{{2022}}}}},
+	}
+	updates = prepareUpdates("10.1/eoc", notice)
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "10.1/paper", updates[0].ArticleDOI)
+		assert.Equal(t, "10.1/eoc", updates[0].NoticeDOI)
+		assert.Equal(t, "expression_of_concern", updates[0].Type)
+		assert.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), updates[0].Date.Time)
+	}
+}
+
+func TestUpdateFlag(t *testing.T) {
+	flag, ok := updateFlag("erratum")
+	assert.True(t, ok)
+	assert.Equal(t, article.ArticleFlag_ARTICLE_FLAG_CORRECTED, flag)
+
+	flag, ok = updateFlag("withdrawal")
+	assert.True(t, ok)
+	assert.Equal(t, article.ArticleFlag_ARTICLE_FLAG_RETRACTED, flag)
+
+	_, ok = updateFlag("new_edition")
+	assert.False(t, ok)
+}
+
+func TestRecordUpdates(t *testing.T) {
+	mockQueries := new(MockQueries)
+	mockQueries.On("GetArticleByNormalizedDOI", mock.Anything, "10.1/unknown").Return(db.Article{}, sql.ErrNoRows)
+	mockQueries.On("GetArticleByNormalizedDOI", mock.Anything, "10.1/paper").Return(db.Article{ID: 4}, nil)
+	mockQueries.On("UpsertArticleUpdate", mock.Anything, db.UpsertArticleUpdateParams{
+		ArticleID:  4,
+		UpdateType: "retraction",
+		Flag:       int8(article.ArticleFlag_ARTICLE_FLAG_RETRACTED),
+		NoticeDoi:  "10.1/notice",
+	}).Return(nil)
+	mockQueries.On("RefreshArticleFlag", mock.Anything, db.RefreshArticleFlagParams{ArticleID: 4}).Return(nil)
+
+	err := recordUpdates(context.Background(), mockQueries, []Update{
+		{ArticleDOI: "10.1/unknown", NoticeDOI: "10.1/other", Type: "correction"},
+		{ArticleDOI: "10.1/paper", NoticeDOI: "10.1/notice", Type: "retraction"},
+	})
+
+	assert.NoError(t, err)
+	mockQueries.AssertExpectations(t)
+}
@@ -0,0 +1,507 @@
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultQuotaBytes     = 1 << 30   // 1 GiB per user
+	defaultMaxUploadBytes = 100 << 20 // 100 MiB per file
+	downloadChunkSize     = 64 * 1024
+)
+
+type AttachmentServiceInterface interface {
+	UploadAttachment(stream attachment.AttachmentService_UploadAttachmentServer) error
+	DownloadAttachment(request *attachment.DownloadAttachmentRequest, stream attachment.AttachmentService_DownloadAttachmentServer) error
+	GetAttachment(ctx context.Context, request *attachment.GetAttachmentRequest) (*attachment.GetAttachmentResponse, error)
+	ListAttachments(ctx context.Context, request *attachment.ListAttachmentsRequest) (*attachment.ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, request *attachment.DeleteAttachmentRequest) (*attachment.DeleteAttachmentResponse, error)
+	GetStorageUsage(ctx context.Context, request *attachment.GetStorageUsageRequest) (*attachment.GetStorageUsageResponse, error)
+	RetryExtraction(ctx context.Context, request *attachment.RetryExtractionRequest) (*attachment.RetryExtractionResponse, error)
+}
+
+type AttachmentService struct {
+	conn           *sql.DB
+	queries        *db.Queries
+	store          storage.BlobStore
+	quotaBytes     int64
+	maxUploadBytes int64
+}
+
+func NewAttachmentService(conn *sql.DB, store storage.BlobStore, cfg conf.StorageConfig) *AttachmentService {
+	s := &AttachmentService{
+		conn:           conn,
+		queries:        db.NewTraced(conn),
+		store:          store,
+		quotaBytes:     cfg.QuotaBytes,
+		maxUploadBytes: cfg.MaxUploadBytes,
+	}
+	if s.quotaBytes == 0 {
+		s.quotaBytes = defaultQuotaBytes
+	}
+	if s.maxUploadBytes == 0 {
+		s.maxUploadBytes = defaultMaxUploadBytes
+	}
+	return s
+}
+
+func (s *AttachmentService) UploadAttachment(stream attachment.AttachmentService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "missing attachment metadata")
+	}
+	if err != nil {
+		return err
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the attachment metadata")
+	}
+	filename := filepath.Base(strings.TrimSpace(metadata.Filename))
+	if filename == "" || filename == "." || filename == "/" {
+		return status.Error(codes.InvalidArgument, "a valid filename is required")
+	}
+
+	params := db.CreateAttachmentParams{OwnerID: metadata.UserId, Filename: filename}
+	switch target := metadata.Target.(type) {
+	case *attachment.AttachmentMetadata_ArticleId:
+		if err := s.checkArticle(ctx, target.ArticleId); err != nil {
+			return err
+		}
+		params.ArticleID = sql.NullInt64{Int64: target.ArticleId, Valid: true}
+	case *attachment.AttachmentMetadata_LibraryArticleId:
+		if err := s.checkLibraryArticleOwner(ctx, target.LibraryArticleId, metadata.UserId); err != nil {
+			return err
+		}
+		params.LibraryArticleID = sql.NullInt64{Int64: target.LibraryArticleId, Valid: true}
+	}
+
+	// Turn uploads away early when the quota is used up. It is checked again
+	// once the file is in.
+	used, err := s.queries.SumAttachmentSizeForOwner(ctx, metadata.UserId)
+	if err != nil {
+		slog.Error("failed to get storage usage", "error", err)
+		return status.Error(codes.Internal, "failed to get storage usage")
+	}
+	limit := min(s.maxUploadBytes, s.quotaBytes-used)
+	if limit <= 0 {
+		return status.Error(codes.ResourceExhausted, "storage quota exceeded")
+	}
+
+	tmp, err := os.CreateTemp("", "journalful-upload-*")
+	if err != nil {
+		slog.Error("failed to create upload file", "error", err)
+		return status.Error(codes.Internal, "failed to store upload")
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hash, size, err := receiveChunks(stream, tmp, limit)
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "the file is empty")
+	}
+
+	contentType, err := sniffContentType(tmp)
+	if err != nil {
+		slog.Error("failed to read upload file", "error", err)
+		return status.Error(codes.Internal, "failed to store upload")
+	}
+	if !allowedContentType(contentType) {
+		return status.Errorf(codes.InvalidArgument, "files of type %s can't be attached", contentType)
+	}
+
+	params.Sha256 = hash
+	params.ContentType = contentType
+	params.Size = size
+	var id int64
+	var exists bool
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		// Check the quota again under the owner's lock, as other uploads may
+		// have finished since the stream started.
+		if _, err := q.LockProfile(ctx, metadata.UserId); err != nil {
+			return err
+		}
+		used, err := q.SumAttachmentSizeForOwner(ctx, metadata.UserId)
+		if err != nil {
+			return err
+		}
+		if used+size > s.quotaBytes {
+			return status.Error(codes.ResourceExhausted, "storage quota exceeded")
+		}
+
+		blob := db.CreateBlobParams{Sha256: hash, Size: size, ContentType: contentType}
+		if contentType == "application/pdf" {
+			// Picked up by the extraction worker.
+			blob.ExtractionStatus = int8(attachment.ExtractionStatus_EXTRACTION_STATUS_PENDING)
+		}
+		if err := q.CreateBlob(ctx, blob); err != nil {
+			return err
+		}
+		// The blob row is locked until commit, so collectBlob can't delete
+		// the contents between this check and the new attachment.
+		exists, err = s.storeBlob(ctx, tmp, hash, size, contentType)
+		if err != nil {
+			return err
+		}
+		result, err := q.CreateAttachment(ctx, params)
+		if err != nil {
+			return err
+		}
+		id, err = result.LastInsertId()
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		slog.Error("failed to create attachment", "error", err)
+		return status.Error(codes.Internal, "failed to create attachment")
+	}
+
+	row, err := s.queries.GetAttachment(ctx, id)
+	if err != nil {
+		slog.Error("failed to get attachment", "error", err)
+		return status.Error(codes.Internal, "failed to get attachment")
+	}
+	return stream.SendAndClose(&attachment.UploadAttachmentResponse{
+		Attachment:   getRowToGrpc(row),
+		Deduplicated: exists,
+	})
+}
+
+// receiveChunks copies the uploaded chunks into w until the client closes the
+// stream, and returns the hex SHA-256 and size of the contents.
+func receiveChunks(stream attachment.AttachmentService_UploadAttachmentServer, w io.Writer, limit int64) (string, int64, error) {
+	hasher := sha256.New()
+	out := io.MultiWriter(w, hasher)
+	var size int64
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		chunk := message.GetChunk()
+		if chunk == nil {
+			return "", 0, status.Error(codes.InvalidArgument, "expected a file chunk")
+		}
+		size += int64(len(chunk))
+		if size > limit {
+			return "", 0, status.Error(codes.ResourceExhausted, "the file exceeds the upload limit or the remaining storage quota")
+		}
+		if _, err := out.Write(chunk); err != nil {
+			slog.Error("failed to write upload file", "error", err)
+			return "", 0, status.Error(codes.Internal, "failed to store upload")
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// storeBlob puts the uploaded file into the blob store unless the same
+// contents are already there, and reports whether they were.
+func (s *AttachmentService) storeBlob(ctx context.Context, file io.ReadSeeker, hash string, size int64, contentType string) (bool, error) {
+	key := storage.ContentKey(hash)
+	exists, err := s.store.Exists(ctx, key)
+	if err != nil {
+		slog.Error("failed to check blob", "error", err)
+		return false, status.Error(codes.Internal, "failed to store upload")
+	}
+	if exists {
+		return true, nil
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		slog.Error("failed to rewind upload file", "error", err)
+		return false, status.Error(codes.Internal, "failed to store upload")
+	}
+	if err := s.store.Put(ctx, key, file, size, contentType); err != nil {
+		slog.Error("failed to store blob", "error", err)
+		return false, status.Error(codes.Internal, "failed to store upload")
+	}
+	return false, nil
+}
+
+func (s *AttachmentService) DownloadAttachment(request *attachment.DownloadAttachmentRequest, stream attachment.AttachmentService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+	row, err := s.getAttachment(ctx, request.Id, request.UserId)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.store.Open(ctx, storage.ContentKey(row.Attachment.Sha256))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			slog.Error("attachment blob is missing", "attachment", row.Attachment.ID, "sha256", row.Attachment.Sha256)
+			return status.Error(codes.DataLoss, "attachment contents are missing")
+		}
+		slog.Error("failed to open blob", "error", err)
+		return status.Error(codes.Internal, "failed to read attachment")
+	}
+	defer r.Close()
+
+	if err := stream.Send(&attachment.DownloadAttachmentResponse{
+		Payload: &attachment.DownloadAttachmentResponse_Attachment{Attachment: getRowToGrpc(row)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&attachment.DownloadAttachmentResponse{
+				Payload: &attachment.DownloadAttachmentResponse_Chunk{Chunk: append([]byte(nil), buf[:n]...)},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			slog.Error("failed to read blob", "error", err)
+			return status.Error(codes.Internal, "failed to read attachment")
+		}
+	}
+}
+
+func (s *AttachmentService) GetAttachment(ctx context.Context, request *attachment.GetAttachmentRequest) (*attachment.GetAttachmentResponse, error) {
+	row, err := s.getAttachment(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment.GetAttachmentResponse{Attachment: getRowToGrpc(row)}, nil
+}
+
+func (s *AttachmentService) ListAttachments(ctx context.Context, request *attachment.ListAttachmentsRequest) (*attachment.ListAttachmentsResponse, error) {
+	var attachments []*attachment.Attachment
+	switch target := request.Target.(type) {
+	case *attachment.ListAttachmentsRequest_ArticleId:
+		rows, err := s.queries.ListAttachmentsForArticle(ctx, sql.NullInt64{Int64: target.ArticleId, Valid: true})
+		if err != nil {
+			slog.Error("failed to list attachments", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list attachments")
+		}
+		for _, row := range rows {
+			attachments = append(attachments, dbToGrpcAttachment(row.Attachment, row.ExtractionStatus, row.ExtractionError, row.PageCount))
+		}
+	case *attachment.ListAttachmentsRequest_LibraryArticleId:
+		if err := s.checkLibraryArticleViewer(ctx, target.LibraryArticleId, request.UserId); err != nil {
+			return nil, err
+		}
+		rows, err := s.queries.ListAttachmentsForLibraryArticle(ctx, sql.NullInt64{Int64: target.LibraryArticleId, Valid: true})
+		if err != nil {
+			slog.Error("failed to list attachments", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list attachments")
+		}
+		for _, row := range rows {
+			attachments = append(attachments, dbToGrpcAttachment(row.Attachment, row.ExtractionStatus, row.ExtractionError, row.PageCount))
+		}
+	}
+	return &attachment.ListAttachmentsResponse{Attachments: attachments}, nil
+}
+
+// DeleteAttachment removes an attachment, and its contents once no other
+// attachment shares them.
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, request *attachment.DeleteAttachmentRequest) (*attachment.DeleteAttachmentResponse, error) {
+	found, err := s.getAttachment(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	row := found.Attachment
+	if row.OwnerID != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the uploader can delete an attachment")
+	}
+
+	if err := s.queries.DeleteAttachment(ctx, row.ID); err != nil {
+		slog.Error("failed to delete attachment", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete attachment")
+	}
+	s.collectBlob(ctx, row.Sha256)
+	return &attachment.DeleteAttachmentResponse{Success: true}, nil
+}
+
+// collectBlob deletes a blob no attachment refers to any more. The blob row
+// stays locked while the contents are deleted, so an upload of the same file
+// waits for it and then stores the contents again.
+func (s *AttachmentService) collectBlob(ctx context.Context, hash string) {
+	err := db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		if _, err := q.LockBlob(ctx, hash); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		remaining, err := q.CountAttachmentsBySha256(ctx, hash)
+		if err != nil || remaining > 0 {
+			return err
+		}
+		if err := s.store.Delete(ctx, storage.ContentKey(hash)); err != nil {
+			return err
+		}
+		return q.DeleteBlob(ctx, hash)
+	})
+	if err != nil {
+		// The attachment is gone either way; a leftover blob only costs space.
+		slog.Error("failed to delete blob", "sha256", hash, "error", err)
+	}
+}
+
+func (s *AttachmentService) GetStorageUsage(ctx context.Context, request *attachment.GetStorageUsageRequest) (*attachment.GetStorageUsageResponse, error) {
+	used, err := s.queries.SumAttachmentSizeForOwner(ctx, request.UserId)
+	if err != nil {
+		slog.Error("failed to get storage usage", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get storage usage")
+	}
+	return &attachment.GetStorageUsageResponse{UsedBytes: used, QuotaBytes: s.quotaBytes}, nil
+}
+
+// getAttachment loads an attachment the viewer may see. Attachments on an
+// article are visible to everyone; those on a library article only to the
+// library owner, or to anyone for a public library.
+func (s *AttachmentService) getAttachment(ctx context.Context, id, viewerID int64) (db.GetAttachmentRow, error) {
+	row, err := s.queries.GetAttachment(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return row, status.Error(codes.NotFound, "attachment not found")
+		}
+		slog.Error("failed to get attachment", "error", err)
+		return row, status.Error(codes.Internal, "failed to get attachment")
+	}
+	if row.Attachment.LibraryArticleID.Valid && row.LibraryOwnerID.Int64 != viewerID && !row.LibraryIsPublic.Bool {
+		return row, status.Error(codes.NotFound, "attachment not found")
+	}
+	return row, nil
+}
+
+func (s *AttachmentService) RetryExtraction(ctx context.Context, request *attachment.RetryExtractionRequest) (*attachment.RetryExtractionResponse, error) {
+	row, err := s.getAttachment(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if row.Attachment.OwnerID != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the uploader can retry an extraction")
+	}
+	retried, err := s.queries.RetryBlobExtraction(ctx, row.Attachment.Sha256)
+	if err != nil {
+		slog.Error("failed to retry extraction", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retry extraction")
+	}
+	if retried == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "only failed extractions can be retried")
+	}
+
+	row, err = s.getAttachment(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment.RetryExtractionResponse{Attachment: getRowToGrpc(row)}, nil
+}
+
+func (s *AttachmentService) checkArticle(ctx context.Context, articleID int64) error {
+	if _, err := s.queries.GetArticle(ctx, articleID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "article not found")
+		}
+		slog.Error("failed to get article", "error", err)
+		return status.Error(codes.Internal, "failed to get article")
+	}
+	return nil
+}
+
+func (s *AttachmentService) getLibraryArticle(ctx context.Context, id int64) (db.GetLibraryArticleDetailsRow, error) {
+	row, err := s.queries.GetLibraryArticleDetails(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return row, status.Error(codes.NotFound, "library article not found")
+		}
+		slog.Error("failed to get library article", "error", err)
+		return row, status.Error(codes.Internal, "failed to get library article")
+	}
+	return row, nil
+}
+
+func (s *AttachmentService) checkLibraryArticleOwner(ctx context.Context, id, userID int64) error {
+	entry, err := s.getLibraryArticle(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry.OwnerID != userID {
+		return status.Error(codes.PermissionDenied, "only the library owner can attach files to its articles")
+	}
+	return nil
+}
+
+func (s *AttachmentService) checkLibraryArticleViewer(ctx context.Context, id, userID int64) error {
+	entry, err := s.getLibraryArticle(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry.OwnerID == userID {
+		return nil
+	}
+	lib, err := s.queries.GetLibrary(ctx, entry.LibraryID)
+	if err != nil {
+		slog.Error("failed to get library", "error", err)
+		return status.Error(codes.Internal, "failed to get library")
+	}
+	if !lib.Ispublic.Bool {
+		return status.Error(codes.NotFound, "library article not found")
+	}
+	return nil
+}
+
+func getRowToGrpc(row db.GetAttachmentRow) *attachment.Attachment {
+	return dbToGrpcAttachment(row.Attachment, row.ExtractionStatus, row.ExtractionError, row.PageCount)
+}
+
+func dbToGrpcAttachment(row db.Attachment, extractionStatus int8, extractionError sql.NullString, pageCount sql.NullInt32) *attachment.Attachment {
+	grpcAttachment := &attachment.Attachment{
+		Id:               row.ID,
+		OwnerId:          row.OwnerID,
+		Filename:         row.Filename,
+		ContentType:      row.ContentType,
+		Size:             row.Size,
+		Sha256:           row.Sha256,
+		CreatedAt:        timestamppb.New(row.CreatedAt.Time),
+		ExtractionStatus: attachment.ExtractionStatus(extractionStatus),
+	}
+	if extractionError.Valid {
+		grpcAttachment.ExtractionError = &extractionError.String
+	}
+	if pageCount.Valid {
+		grpcAttachment.PageCount = &pageCount.Int32
+	}
+	if row.ArticleID.Valid {
+		grpcAttachment.Target = &attachment.Attachment_ArticleId{ArticleId: row.ArticleID.Int64}
+	} else if row.LibraryArticleID.Valid {
+		grpcAttachment.Target = &attachment.Attachment_LibraryArticleId{LibraryArticleId: row.LibraryArticleID.Int64}
+	}
+	return grpcAttachment
+}
@@ -0,0 +1,165 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const pdfHash = "aaaabbbbccccddddeeeeffff0000111122223333444455556666777788889999"
+
+// memoryStore is a BlobStore kept in a map.
+type memoryStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{blobs: make(map[string][]byte)}
+}
+
+func (m *memoryStore) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *memoryStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.blobs[key]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memoryStore) Exists(_ context.Context, key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.blobs[key]
+	return ok, nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.blobs, key)
+	return nil
+}
+
+// uploadStream replays an upload of content to article 3 by profile 7.
+type uploadStream struct {
+	grpc.ServerStream
+	requests []*attachment.UploadAttachmentRequest
+}
+
+func newUploadStream(content string) *uploadStream {
+	return &uploadStream{requests: []*attachment.UploadAttachmentRequest{
+		{Payload: &attachment.UploadAttachmentRequest_Metadata{Metadata: &attachment.AttachmentMetadata{
+			UserId:   7,
+			Filename: "paper.pdf",
+			Target:   &attachment.AttachmentMetadata_ArticleId{ArticleId: 3},
+		}}},
+		{Payload: &attachment.UploadAttachmentRequest_Chunk{Chunk: []byte(content)}},
+	}}
+}
+
+func (s *uploadStream) Context() context.Context { return context.Background() }
+
+func (s *uploadStream) Recv() (*attachment.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *uploadStream) SendAndClose(*attachment.UploadAttachmentResponse) error { return nil }
+
+func TestUploadRechecksQuotaWhenStoring(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	store := newMemoryStore()
+	s := NewAttachmentService(conn, store, conf.StorageConfig{QuotaBytes: 100})
+	fake.Return("GetArticle", db.Article{ID: 3})
+	fake.Return("LockProfile", int64(7))
+	// Another upload finishes while this one is streamed.
+	fake.ReturnOnce("SumAttachmentSizeForOwner", int64(0))
+	fake.Return("SumAttachmentSizeForOwner", int64(95))
+
+	err := s.UploadAttachment(newUploadStream("%PDF-1.7\n1 0 obj"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Empty(t, fake.Calls("CreateAttachment"))
+	assert.Len(t, fake.Calls("ROLLBACK"), 1)
+	assert.Empty(t, store.blobs)
+}
+
+func TestUploadStoresNewBlob(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	store := newMemoryStore()
+	s := NewAttachmentService(conn, store, conf.StorageConfig{QuotaBytes: 100})
+	fake.Return("GetArticle", db.Article{ID: 3})
+	fake.Return("LockProfile", int64(7))
+	fake.Return("SumAttachmentSizeForOwner", int64(0))
+	fake.Return("GetAttachment", db.GetAttachmentRow{Attachment: db.Attachment{ID: 1, OwnerID: 7}})
+
+	err := s.UploadAttachment(newUploadStream("%PDF-1.7\n1 0 obj"))
+	assert.NoError(t, err)
+	assert.Len(t, store.blobs, 1)
+	assert.Len(t, fake.Calls("CreateBlob"), 1)
+	assert.Len(t, fake.Calls("CreateAttachment"), 1)
+	assert.Len(t, fake.Calls("COMMIT"), 1)
+}
+
+func TestCollectBlobKeepsSharedContents(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	store := newMemoryStore()
+	s := NewAttachmentService(conn, store, conf.StorageConfig{})
+	store.blobs[storage.ContentKey(pdfHash)] = []byte("%PDF")
+	fake.Return("LockBlob", pdfHash)
+	fake.Return("CountAttachmentsBySha256", int64(1))
+
+	s.collectBlob(context.Background(), pdfHash)
+	assert.Len(t, store.blobs, 1)
+	assert.Empty(t, fake.Calls("DeleteBlob"))
+}
+
+func TestCollectBlobDeletesUnreferencedContents(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	store := newMemoryStore()
+	s := NewAttachmentService(conn, store, conf.StorageConfig{})
+	store.blobs[storage.ContentKey(pdfHash)] = []byte("%PDF")
+	fake.Return("LockBlob", pdfHash)
+	fake.Return("CountAttachmentsBySha256", int64(0))
+
+	s.collectBlob(context.Background(), pdfHash)
+	assert.Empty(t, store.blobs)
+	assert.Len(t, fake.Calls("DeleteBlob"), 1)
+	assert.Len(t, fake.Calls("COMMIT"), 1)
+}
+
+func TestCollectBlobSkipsCollectedBlobs(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := NewAttachmentService(conn, newMemoryStore(), conf.StorageConfig{})
+
+	s.collectBlob(context.Background(), pdfHash)
+	assert.Empty(t, fake.Calls("CountAttachmentsBySha256"))
+}
@@ -0,0 +1,49 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/chiquitav2/journalful/pkg/attachment/v1"
+	"github.com/chiquitav2/journalful/pkg/conf"
+)
+
+type GrpcHandler struct {
+	attachment.UnimplementedAttachmentServiceServer
+	service AttachmentServiceInterface
+}
+
+func NewAttachmentGrpcHandler(conn *sql.DB, store storage.BlobStore, cfg conf.StorageConfig) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewAttachmentService(conn, store, cfg),
+	}
+}
+
+func (h *GrpcHandler) UploadAttachment(stream attachment.AttachmentService_UploadAttachmentServer) error {
+	return h.service.UploadAttachment(stream)
+}
+
+func (h *GrpcHandler) DownloadAttachment(request *attachment.DownloadAttachmentRequest, stream attachment.AttachmentService_DownloadAttachmentServer) error {
+	return h.service.DownloadAttachment(request, stream)
+}
+
+func (h *GrpcHandler) GetAttachment(ctx context.Context, request *attachment.GetAttachmentRequest) (*attachment.GetAttachmentResponse, error) {
+	return h.service.GetAttachment(ctx, request)
+}
+
+func (h *GrpcHandler) ListAttachments(ctx context.Context, request *attachment.ListAttachmentsRequest) (*attachment.ListAttachmentsResponse, error) {
+	return h.service.ListAttachments(ctx, request)
+}
+
+func (h *GrpcHandler) DeleteAttachment(ctx context.Context, request *attachment.DeleteAttachmentRequest) (*attachment.DeleteAttachmentResponse, error) {
+	return h.service.DeleteAttachment(ctx, request)
+}
+
+func (h *GrpcHandler) GetStorageUsage(ctx context.Context, request *attachment.GetStorageUsageRequest) (*attachment.GetStorageUsageResponse, error) {
+	return h.service.GetStorageUsage(ctx, request)
+}
+
+func (h *GrpcHandler) RetryExtraction(ctx context.Context, request *attachment.RetryExtractionRequest) (*attachment.RetryExtractionResponse, error) {
+	return h.service.RetryExtraction(ctx, request)
+}
@@ -0,0 +1,40 @@
+package attachment
+
+import (
+	"io"
+	"mime"
+	"net/http"
+)
+
+// allowedContentTypes lists what can be attached: papers, their supplements
+// and figures. EPUB and Office documents sniff as zip archives.
+var allowedContentTypes = map[string]bool{
+	"application/pdf":  true,
+	"application/zip":  true,
+	"application/json": true,
+	"text/plain":       true,
+	"text/csv":         true,
+	"image/png":        true,
+	"image/jpeg":       true,
+	"image/gif":        true,
+	"image/webp":       true,
+}
+
+// sniffContentType detects the media type from the first bytes of r, ignoring
+// whatever the client claims. Parameters such as charset are dropped.
+func sniffContentType(r io.ReaderAt) (string, error) {
+	head := make([]byte, 512)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "application/octet-stream", nil
+	}
+	return mediaType, nil
+}
+
+func allowedContentType(contentType string) bool {
+	return allowedContentTypes[contentType]
+}
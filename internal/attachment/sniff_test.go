@@ -0,0 +1,27 @@
+package attachment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSniffContentType(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected string
+		allowed  bool
+	}{
+		{"%PDF-1.7\n%âãÏÓ\n1 0 obj", "application/pdf", true},
+		{"plain notes about the paper", "text/plain", true},
+		{"<html><body>not a paper</body></html>", "text/html", false},
+		{"MZ\x90\x00\x03\x00\x00\x00", "application/octet-stream", false},
+	}
+	for _, tt := range tests {
+		contentType, err := sniffContentType(strings.NewReader(tt.content))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, contentType)
+		assert.Equal(t, tt.allowed, allowedContentType(contentType))
+	}
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+)
+
+// ErrInvalidToken is returned by authenticators for tokens that are
+// malformed, expired, revoked or otherwise not accepted.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims describe the caller as asserted by a verified token.
+type Claims struct {
+	UserID string // Subject of the token
+	Email  string
+	Name   string
+	Roles  []string
+	Scopes []string
+	// TokenID is the personal access token the claims come from, or 0 for
+	// identity provider tokens.
+	TokenID int64
+}
+
+// Authenticator verifies a bearer token, given without the "Bearer "
+// prefix, and returns the caller's claims.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Claims, error)
+}
+
+// New builds the authenticator selected by cfg.Auth.Mode.
+func New(ctx context.Context, cfg *conf.Config) (Authenticator, error) {
+	switch cfg.Auth.Mode {
+	case "", conf.AuthModeZitadel:
+		return NewZitadelAuthenticator(ctx, cfg.Zitadel)
+	case conf.AuthModeJWT:
+		return NewJWTAuthenticator(ctx, cfg.Auth.JWT)
+	case conf.AuthModeDev:
+		slog.Warn("development authentication is enabled; every request with the static token is accepted")
+		return NewDevAuthenticator(cfg.Auth.Dev), nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode %q", cfg.Auth.Mode)
+	}
+}
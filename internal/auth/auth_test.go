@@ -0,0 +1,231 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+type testKey struct {
+	private *rsa.PrivateKey
+	kid     string
+}
+
+func newTestKey(t *testing.T, kid string) testKey {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return testKey{private: private, kid: kid}
+}
+
+func (k testKey) public() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: &k.private.PublicKey, KeyID: k.kid, Algorithm: string(jose.RS256), Use: "sig"}
+}
+
+func (k testKey) sign(t *testing.T, claims jwt.Claims, extra map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: k.private},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), k.kid),
+	)
+	assert.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Claims(extra).Serialize()
+	assert.NoError(t, err)
+	return token
+}
+
+func writeJWKS(t *testing.T, keys ...testKey) string {
+	set := jose.JSONWebKeySet{}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, k.public())
+	}
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func validClaims() jwt.Claims {
+	return jwt.Claims{
+		Subject:  "user-1",
+		Issuer:   "https://issuer.example",
+		Audience: jwt.Audience{"journalful"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+}
+
+func TestJWTAuthenticatorAcceptsValidToken(t *testing.T) {
+	key := newTestKey(t, "k1")
+	authenticator, err := NewJWTAuthenticator(context.Background(), conf.JWTAuthConfig{
+		JWKSFile: writeJWKS(t, key),
+		Issuer:   "https://issuer.example",
+		Audience: "journalful",
+	})
+	assert.NoError(t, err)
+
+	token := key.sign(t, validClaims(), map[string]interface{}{
+		"email": "ada@example.org",
+		"name":  "Ada Lovelace",
+		"roles": []string{"admin", "reader"},
+		"scope": "library:read library:write",
+	})
+	claims, err := authenticator.Authenticate(context.Background(), token)
+	assert.NoError(t, err)
+	assert.Equal(t, &Claims{
+		UserID: "user-1",
+		Email:  "ada@example.org",
+		Name:   "Ada Lovelace",
+		Roles:  []string{"admin", "reader"},
+		Scopes: []string{"library:read", "library:write"},
+	}, claims)
+}
+
+func TestJWTAuthenticatorRejectsInvalidTokens(t *testing.T) {
+	key := newTestKey(t, "k1")
+	other := newTestKey(t, "k2")
+	authenticator, err := NewJWTAuthenticator(context.Background(), conf.JWTAuthConfig{
+		JWKSFile: writeJWKS(t, key),
+		Issuer:   "https://issuer.example",
+		Audience: "journalful",
+	})
+	assert.NoError(t, err)
+
+	wrongIssuer := validClaims()
+	wrongIssuer.Issuer = "https://elsewhere.example"
+	wrongAudience := validClaims()
+	wrongAudience.Audience = jwt.Audience{"someone-else"}
+	noAudience := validClaims()
+	noAudience.Audience = nil
+	expired := validClaims()
+	expired.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := validClaims()
+	noExpiry.Expiry = nil
+
+	tokens := map[string]string{
+		"malformed":      "not.a.jwt",
+		"wrong issuer":   key.sign(t, wrongIssuer, nil),
+		"wrong audience": key.sign(t, wrongAudience, nil),
+		"no audience":    key.sign(t, noAudience, nil),
+		"expired":        key.sign(t, expired, nil),
+		"no expiry":      key.sign(t, noExpiry, nil),
+		"unknown key":    other.sign(t, validClaims(), nil),
+		"forged key id":  testKey{private: other.private, kid: "k1"}.sign(t, validClaims(), nil),
+	}
+	for name, token := range tokens {
+		_, err := authenticator.Authenticate(context.Background(), token)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
+
+func TestJWTAuthenticatorRequiresIssuerAndAudience(t *testing.T) {
+	jwks := writeJWKS(t, newTestKey(t, "k1"))
+	_, err := NewJWTAuthenticator(context.Background(), conf.JWTAuthConfig{JWKSFile: jwks, Issuer: "https://issuer.example"})
+	assert.Error(t, err)
+	_, err = NewJWTAuthenticator(context.Background(), conf.JWTAuthConfig{JWKSFile: jwks, Audience: "journalful"})
+	assert.Error(t, err)
+}
+
+func TestJWTAuthenticatorRefetchesRotatedKeys(t *testing.T) {
+	old := newTestKey(t, "old")
+	rotated := newTestKey(t, "new")
+	current := []testKey{old}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		set := jose.JSONWebKeySet{}
+		for _, k := range current {
+			set.Keys = append(set.Keys, k.public())
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	defer server.Close()
+
+	authenticator, err := NewJWTAuthenticator(context.Background(), conf.JWTAuthConfig{
+		JWKSURL:  server.URL,
+		Issuer:   "https://issuer.example",
+		Audience: "journalful",
+	})
+	assert.NoError(t, err)
+	now := time.Now()
+	authenticator.keys.now = func() time.Time { return now }
+
+	_, err = authenticator.Authenticate(context.Background(), old.sign(t, validClaims(), nil))
+	assert.NoError(t, err)
+
+	current = []testKey{old, rotated}
+	token := rotated.sign(t, validClaims(), nil)
+	_, err = authenticator.Authenticate(context.Background(), token)
+	assert.ErrorIs(t, err, ErrInvalidToken, "refetching is rate limited")
+
+	now = now.Add(2 * jwksMinRefetch)
+	_, err = authenticator.Authenticate(context.Background(), token)
+	assert.NoError(t, err)
+}
+
+func TestRolesFromZitadelClaim(t *testing.T) {
+	roles := rolesFromClaim(map[string]interface{}{
+		"reader": map[string]interface{}{"org1": "example.org"},
+		"admin":  map[string]interface{}{"org1": "example.org"},
+	})
+	assert.Equal(t, []string{"admin", "reader"}, roles)
+}
+
+func TestDevAuthenticator(t *testing.T) {
+	authenticator := NewDevAuthenticator(conf.DevAuthConfig{Token: "dev-token", UserID: "dev-user", Roles: []string{"admin"}})
+
+	claims, err := authenticator.Authenticate(context.Background(), "dev-token")
+	assert.NoError(t, err)
+	assert.Equal(t, "dev-user", claims.UserID)
+	assert.Equal(t, []string{"admin"}, claims.Roles)
+
+	_, err = authenticator.Authenticate(context.Background(), "guess")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = NewDevAuthenticator(conf.DevAuthConfig{}).Authenticate(context.Background(), "")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestPrincipalContext(t *testing.T) {
+	_, ok := PrincipalFromContext(context.Background())
+	assert.False(t, ok)
+	_, ok = UserID(context.Background())
+	assert.False(t, ok)
+
+	ctx := WithPrincipal(context.Background(), NewPrincipal(&Claims{UserID: "user-1", Roles: []string{"admin"}}, 0))
+	userID, ok := UserID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "user-1", userID)
+	_, ok = ProfileID(ctx)
+	assert.False(t, ok, "no profile yet")
+
+	ctx = WithPrincipal(context.Background(), NewPrincipal(&Claims{UserID: "user-1"}, 42))
+	profileID, ok := ProfileID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), profileID)
+}
+
+func TestPolicyAllows(t *testing.T) {
+	admin := &Principal{UserID: "a", Roles: []string{RoleAdmin}, Scopes: []string{"library:write"}}
+	reader := &Principal{UserID: "r", Roles: []string{"reader"}}
+
+	assert.True(t, AdminOnly.Allows(admin))
+	assert.False(t, AdminOnly.Allows(reader))
+	assert.False(t, AdminOnly.Allows(nil))
+	assert.True(t, Policy{}.Allows(reader))
+
+	writer := Policy{Scopes: []string{"library:write"}}
+	assert.True(t, writer.Allows(admin))
+	assert.False(t, writer.Allows(reader))
+	assert.False(t, Policy{AnyRole: []string{"reader", "editor"}, Scopes: []string{"library:write"}}.Allows(reader))
+	assert.True(t, Policy{AnyRole: []string{"reader", "editor"}}.Allows(reader))
+	assert.False(t, Policy{}.Allows(Anonymous()))
+}
@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	defaultRolesClaim = "roles"
+	// jwksRefreshInterval is how often a JWKS URL is fetched again, so that
+	// rotated keys are picked up.
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefetch limits refetches triggered by tokens signed with an
+	// unknown key id, so garbage tokens can't hammer the identity provider.
+	jwksMinRefetch = time.Minute
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTAuthenticator verifies signed JWTs locally against a JSON Web Key Set,
+// read from a file or fetched from a URL.
+type JWTAuthenticator struct {
+	keys       *keySet
+	issuer     string
+	audience   string
+	rolesClaim string
+	now        func() time.Time
+}
+
+func NewJWTAuthenticator(ctx context.Context, cfg conf.JWTAuthConfig) (*JWTAuthenticator, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, fmt.Errorf("jwt auth requires an issuer and an audience")
+	}
+	keys := &keySet{file: cfg.JWKSFile, url: cfg.JWKSURL, client: &http.Client{Timeout: 10 * time.Second}, now: time.Now}
+	if err := keys.load(ctx); err != nil {
+		return nil, err
+	}
+	rolesClaim := cfg.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = defaultRolesClaim
+	}
+	return &JWTAuthenticator{
+		keys:       keys,
+		issuer:     cfg.Issuer,
+		audience:   cfg.Audience,
+		rolesClaim: rolesClaim,
+		now:        time.Now,
+	}, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (*Claims, error) {
+	parsed, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if len(parsed.Headers) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one signature", ErrInvalidToken)
+	}
+	key, err := a.keys.key(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var registered jwt.Claims
+	var custom map[string]interface{}
+	if err := parsed.Claims(key, &registered, &custom); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	expected := jwt.Expected{Issuer: a.issuer, AnyAudience: jwt.Audience{a.audience}, Time: a.now()}
+	if err := registered.Validate(expected); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if registered.Expiry == nil {
+		return nil, fmt.Errorf("%w: token does not expire", ErrInvalidToken)
+	}
+	if registered.Subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidToken)
+	}
+
+	claims := &Claims{UserID: registered.Subject}
+	claims.Email, _ = custom["email"].(string)
+	claims.Name, _ = custom["name"].(string)
+	claims.Roles = rolesFromClaim(custom[a.rolesClaim])
+	claims.Scopes = scopesFromClaims(custom)
+	return claims, nil
+}
+
+// scopesFromClaims reads the OAuth "scope" claim, a space-delimited string,
+// falling back to the "scp" list some providers issue instead.
+func scopesFromClaims(custom map[string]interface{}) []string {
+	if scope, ok := custom["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	return rolesFromClaim(custom["scp"])
+}
+
+// rolesFromClaim accepts roles as a list of names, or as an object keyed by
+// role name the way Zitadel issues them.
+func rolesFromClaim(value interface{}) []string {
+	var roles []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, r := range v {
+			if role, ok := r.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+	case map[string]interface{}:
+		for role := range v {
+			roles = append(roles, role)
+		}
+		sort.Strings(roles)
+	case string:
+		roles = []string{v}
+	}
+	return roles
+}
+
+// keySet holds the verification keys, refreshing them from the URL when
+// they get old or a token names a key id we don't know.
+type keySet struct {
+	file   string
+	url    string
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	keys      jose.JSONWebKeySet
+	checkedAt time.Time
+}
+
+func (s *keySet) load(ctx context.Context) error {
+	var data []byte
+	var err error
+	switch {
+	case s.file != "":
+		data, err = os.ReadFile(s.file)
+		if err != nil {
+			return fmt.Errorf("failed to read JWKS file: %w", err)
+		}
+	case s.url != "":
+		data, err = s.fetch(ctx)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("either a JWKS file or URL is required")
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return fmt.Errorf("JWKS contains no keys")
+	}
+	s.mu.Lock()
+	s.keys = keys
+	s.checkedAt = s.now()
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *keySet) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	return data, nil
+}
+
+func (s *keySet) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	s.mu.Lock()
+	key := s.lookup(kid)
+	sinceCheck := s.now().Sub(s.checkedAt)
+	refresh := s.url != "" && (sinceCheck > jwksRefreshInterval || (key == nil && sinceCheck > jwksMinRefetch))
+	if refresh {
+		// Also counts failed attempts, so an unreachable provider is not
+		// retried on every request.
+		s.checkedAt = s.now()
+	}
+	s.mu.Unlock()
+
+	if refresh {
+		if err := s.load(ctx); err != nil {
+			slog.Warn("failed to refresh JWKS", "error", err)
+		} else {
+			s.mu.Lock()
+			key = s.lookup(kid)
+			s.mu.Unlock()
+		}
+	}
+	if key == nil {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, kid)
+	}
+	return key, nil
+}
+
+// lookup finds the key by id. Tokens without a key id are accepted only if
+// the set has a single key. Callers hold s.mu.
+func (s *keySet) lookup(kid string) *jose.JSONWebKey {
+	if kid == "" {
+		if len(s.keys.Keys) == 1 {
+			return &s.keys.Keys[0]
+		}
+		return nil
+	}
+	for i := range s.keys.Keys {
+		if s.keys.Keys[i].KeyID == kid && s.keys.Keys[i].Use != "enc" {
+			return &s.keys.Keys[i]
+		}
+	}
+	return nil
+}
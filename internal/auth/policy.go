@@ -0,0 +1,39 @@
+package auth
+
+import "slices"
+
+// Scopes of personal access tokens. Each includes the ones before it.
+const (
+	ScopeRead         = "read"
+	ScopeLibraryWrite = "library:write"
+	ScopeWrite        = "write"
+	ScopeAdmin        = "admin"
+)
+
+// Policy is what an RPC requires of its caller beyond a valid token. A
+// principal satisfies it with any one of AnyRole, if set, and all of
+// Scopes.
+type Policy struct {
+	AnyRole []string
+	Scopes  []string
+}
+
+// AdminOnly restricts an RPC to administrators.
+var AdminOnly = Policy{AnyRole: []string{RoleAdmin}}
+
+// Allows reports whether the principal satisfies the policy. Anonymous
+// callers never do.
+func (p Policy) Allows(principal *Principal) bool {
+	if principal.IsAnonymous() {
+		return false
+	}
+	if len(p.AnyRole) > 0 && !slices.ContainsFunc(p.AnyRole, principal.HasRole) {
+		return false
+	}
+	for _, scope := range p.Scopes {
+		if !principal.HasScope(scope) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// RoleAdmin is granted to operators who may delete shared records and see
+// every user's data.
+const RoleAdmin = "admin"
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID string // Subject of the identity provider's token
+	// ProfileID is the caller's profile, or 0 if they have not created one yet.
+	ProfileID int64
+	Email     string
+	Name      string
+	Roles     []string
+	Scopes    []string
+	// TokenID is the personal access token the request was made with, or 0
+	// if the caller logged in with the identity provider. Only access token
+	// requests are limited by their scopes.
+	TokenID int64
+}
+
+// Anonymous is the principal of calls to public methods made without a
+// token.
+func Anonymous() *Principal {
+	return &Principal{}
+}
+
+// NewPrincipal builds the principal for verified claims.
+func NewPrincipal(claims *Claims, profileID int64) *Principal {
+	return &Principal{
+		UserID:    claims.UserID,
+		ProfileID: profileID,
+		Email:     claims.Email,
+		Name:      claims.Name,
+		Roles:     claims.Roles,
+		Scopes:    claims.Scopes,
+		TokenID:   claims.TokenID,
+	}
+}
+
+func (p *Principal) IsAnonymous() bool {
+	return p == nil || p.UserID == ""
+}
+
+func (p *Principal) HasRole(role string) bool {
+	return p != nil && slices.Contains(p.Roles, role)
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return p != nil && slices.Contains(p.Scopes, scope)
+}
+
+// IsAdmin reports whether the caller has the admin role and, with a personal
+// access token, the admin scope.
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin) && (!p.IsAccessToken() || p.HasScope(ScopeAdmin))
+}
+
+func (p *Principal) IsAccessToken() bool {
+	return p != nil && p.TokenID != 0
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated caller.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated caller, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// UserID returns the authenticated caller's user id. It is false for
+// anonymous callers.
+func UserID(ctx context.Context) (string, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		return "", false
+	}
+	return principal.UserID, true
+}
+
+// ProfileID returns the authenticated caller's profile id. It is false if
+// there is no caller or they have no profile yet.
+func ProfileID(ctx context.Context) (int64, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.ProfileID == 0 {
+		return 0, false
+	}
+	return principal.ProfileID, true
+}
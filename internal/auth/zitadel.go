@@ -3,25 +3,56 @@ package auth
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/chiquitav2/journalful/pkg/conf"
 	"github.com/zitadel/zitadel-go/v3/pkg/authorization"
 	"github.com/zitadel/zitadel-go/v3/pkg/authorization/oauth"
 	"github.com/zitadel/zitadel-go/v3/pkg/zitadel"
 )
 
-func NewZitadelAuthorizer(ctx context.Context, domain, keypath string) (*authorization.Authorizer[*oauth.IntrospectionContext], error) {
+const zitadelRolesClaim = "urn:zitadel:iam:org:project:roles"
+
+// ZitadelAuthenticator checks tokens with Zitadel's introspection endpoint.
+type ZitadelAuthenticator struct {
+	authorizer *authorization.Authorizer[*oauth.IntrospectionContext]
+}
+
+func NewZitadelAuthenticator(ctx context.Context, cfg conf.ZitadelConfig) (*ZitadelAuthenticator, error) {
 	var zitadelOptions []zitadel.Option
 	// if insecure {
 	// 	zitadelOptions = append(zitadelOptions, zitadel.WithInsecure(domain))
 	// }
 
-	instance := zitadel.New(domain, zitadelOptions...)
+	instance := zitadel.New(cfg.Domain, zitadelOptions...)
 
 	//authorizer, err := authorization.New(ctx, instance, oauth.WithIntrospection[*oauth.IntrospectionContext](oauth.ClientIDSecretIntrospectionAuthentication(clientID, clientSecret)))
-	authorizer, err := authorization.New(ctx, instance, oauth.DefaultAuthorization(keypath))
+	authorizer, err := authorization.New(ctx, instance, oauth.DefaultAuthorization(cfg.KeyPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create authorizer: %w", err)
 	}
 
-	return authorizer, nil
+	return &ZitadelAuthenticator{authorizer: authorizer}, nil
+}
+
+func (a *ZitadelAuthenticator) Authenticate(ctx context.Context, token string) (*Claims, error) {
+	introspection, err := a.authorizer.CheckAuthorization(ctx, "Bearer "+token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	claims := &Claims{
+		UserID: introspection.UserID(),
+		Email:  introspection.Email,
+		Name:   introspection.Name,
+		Scopes: introspection.Scope,
+	}
+	// Roles are granted per organization: {"role": {"orgID": "domain"}}.
+	if roles, ok := introspection.Claims[zitadelRolesClaim].(map[string]interface{}); ok {
+		for role := range roles {
+			claims.Roles = append(claims.Roles, role)
+		}
+		sort.Strings(claims.Roles)
+	}
+	return claims, nil
 }
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"strings"
+)
+
+// This file is not generated by sqlc, which can't generate multi-row
+// inserts for MySQL. The statements keep the "-- name:" line so they are
+// traced like the generated queries.
+
+// maxBatchRows keeps a statement well under MySQL's limit of 65535
+// placeholders.
+const maxBatchRows = 500
+
+const createArticleReferences = `-- name: CreateArticleReferences :exec
+INSERT INTO article_references (citing_article_id, position, cited_doi, title, author, publication_year, journal_name, unstructured)
+VALUES `
+
+// CreateArticleReferences inserts a reference list with one statement per
+// batch of rows.
+func (q *Queries) CreateArticleReferences(ctx context.Context, args []CreateArticleReferenceParams) error {
+	rows := make([][]interface{}, len(args))
+	for i, arg := range args {
+		rows[i] = []interface{}{
+			arg.CitingArticleID,
+			arg.Position,
+			arg.CitedDoi,
+			arg.Title,
+			arg.Author,
+			arg.PublicationYear,
+			arg.JournalName,
+			arg.Unstructured,
+		}
+	}
+	return q.insertRows(ctx, createArticleReferences, rows)
+}
+
+const createRecommendations = `-- name: CreateRecommendations :exec
+INSERT INTO article_recommendations (article_id, recommended_article_id, score, shared_authors, shared_tags, co_citations, co_occurrences, abstract_similarity)
+VALUES `
+
+// CreateRecommendations inserts recommendations with one statement per
+// batch of rows.
+func (q *Queries) CreateRecommendations(ctx context.Context, args []CreateRecommendationParams) error {
+	rows := make([][]interface{}, len(args))
+	for i, arg := range args {
+		rows[i] = []interface{}{
+			arg.ArticleID,
+			arg.RecommendedArticleID,
+			arg.Score,
+			arg.SharedAuthors,
+			arg.SharedTags,
+			arg.CoCitations,
+			arg.CoOccurrences,
+			arg.AbstractSimilarity,
+		}
+	}
+	return q.insertRows(ctx, createRecommendations, rows)
+}
+
+const createReadingEvents = `-- name: CreateReadingEvents :exec
+INSERT INTO reading_events (profile_id, library_id, article_id, library_article_id, event_type, from_status, to_status, progress)
+VALUES `
+
+// CreateReadingEvents inserts reading events with one statement per batch
+// of rows.
+func (q *Queries) CreateReadingEvents(ctx context.Context, args []CreateReadingEventParams) error {
+	rows := make([][]interface{}, len(args))
+	for i, arg := range args {
+		rows[i] = []interface{}{
+			arg.ProfileID,
+			arg.LibraryID,
+			arg.ArticleID,
+			arg.LibraryArticleID,
+			arg.EventType,
+			arg.FromStatus,
+			arg.ToStatus,
+			arg.Progress,
+		}
+	}
+	return q.insertRows(ctx, createReadingEvents, rows)
+}
+
+// insertRows runs insert, which ends in VALUES, with a placeholder group per
+// row, maxBatchRows rows at a time.
+func (q *Queries) insertRows(ctx context.Context, insert string, rows [][]interface{}) error {
+	for len(rows) > 0 {
+		batch := rows[:min(len(rows), maxBatchRows)]
+		rows = rows[len(batch):]
+
+		group := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(batch[0])), ", ") + ")"
+		var query strings.Builder
+		query.WriteString(insert)
+		args := make([]interface{}, 0, len(batch)*len(batch[0]))
+		for i, row := range batch {
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString(group)
+			args = append(args, row...)
+		}
+		if _, err := q.db.ExecContext(ctx, query.String(), args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
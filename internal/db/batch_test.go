@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateArticleReferencesBatchesRows(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	refs := make([]CreateArticleReferenceParams, maxBatchRows+1)
+	for i := range refs {
+		refs[i] = CreateArticleReferenceParams{CitingArticleID: 9, Position: int32(i + 1)}
+	}
+
+	err := New(conn).CreateArticleReferences(context.Background(), refs)
+	assert.NoError(t, err)
+
+	calls := fake.Calls("CreateArticleReferences")
+	if assert.Len(t, calls, 2) {
+		assert.Len(t, calls[0].Args, maxBatchRows*8)
+		assert.Len(t, calls[1].Args, 8)
+		assert.Equal(t, int64(maxBatchRows+1), calls[1].Args[1], "the last row is the last reference")
+	}
+}
+
+func TestCreateArticleReferencesWithoutRows(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+
+	err := New(conn).CreateArticleReferences(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, fake.Calls("CreateArticleReferences"))
+}
+
+func TestInsertRowsPlaceholders(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+
+	err := New(conn).insertRows(context.Background(), "INSERT INTO t (a, b) VALUES ", [][]interface{}{{1, 2}, {3, 4}})
+	assert.NoError(t, err)
+	// Statements without a name line are recorded by their text.
+	calls := fake.Calls("INSERT INTO t (a, b) VALUES (?, ?), (?, ?)")
+	if assert.Len(t, calls, 1) {
+		assert.Len(t, calls[0].Args, 4)
+	}
+}
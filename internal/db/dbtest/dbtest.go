@@ -0,0 +1,236 @@
+// Package dbtest provides a fake database for service tests. Queries are
+// answered with canned rows by their name in query.sql, and every statement
+// is recorded with its arguments. Transactions are recorded as COMMIT and
+// ROLLBACK calls.
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Call is a statement the code under test ran.
+type Call struct {
+	Name string
+	Args []driver.Value
+}
+
+// Fake holds the canned rows and the recorded calls.
+type Fake struct {
+	mu           sync.Mutex
+	rows         map[string][][]driver.Value
+	next         map[string][][][]driver.Value
+	errs         map[string]error
+	calls        []Call
+	lastInsertID int64
+}
+
+// Open returns a database backed by a new Fake. It is closed when the test
+// ends.
+func Open(t testing.TB) (*sql.DB, *Fake) {
+	fake := &Fake{
+		rows: make(map[string][][]driver.Value),
+		next: make(map[string][][][]driver.Value),
+		errs: make(map[string]error),
+	}
+	conn := sql.OpenDB(connector{fake: fake})
+	t.Cleanup(func() { conn.Close() })
+	return conn, fake
+}
+
+// Return makes the named query return the given rows. Each row is a sqlc
+// row struct, or a single value for queries that return one column.
+// Queries without rows return none.
+func (f *Fake) Return(name string, rows ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rows[name] = rowValues(rows)
+}
+
+// ReturnOnce makes the next call of the named query return the given rows.
+// Later calls return the rows set with Return.
+func (f *Fake) ReturnOnce(name string, rows ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next[name] = append(f.next[name], rowValues(rows))
+}
+
+func rowValues(rows []any) [][]driver.Value {
+	values := make([][]driver.Value, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, Values(row))
+	}
+	return values
+}
+
+// Fail makes the named statement fail with err.
+func (f *Fake) Fail(name string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errs[name] = err
+}
+
+// Calls returns the recorded calls of the named statement, in order.
+func (f *Fake) Calls(name string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []Call
+	for _, call := range f.calls {
+		if call.Name == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Values flattens a sqlc params or row struct into the driver values of its
+// fields, in the order sqlc binds and scans them. Other values become a
+// single driver value.
+func Values(v any) []driver.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Struct || isValuer(rv) {
+		return []driver.Value{toValue(v)}
+	}
+	var values []driver.Value
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Field(i)
+		if field.Kind() == reflect.Struct && !isValuer(field) {
+			values = append(values, Values(field.Interface())...)
+			continue
+		}
+		values = append(values, toValue(field.Interface()))
+	}
+	return values
+}
+
+func isValuer(v reflect.Value) bool {
+	_, ok := v.Interface().(driver.Valuer)
+	return ok || v.Type() == reflect.TypeOf(time.Time{})
+}
+
+func toValue(v any) driver.Value {
+	value, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		panic(fmt.Sprintf("dbtest: unsupported value %T: %v", v, err))
+	}
+	return value
+}
+
+func (f *Fake) record(query string, args []driver.NamedValue) (string, error) {
+	name := queryName(query)
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{Name: name, Args: values})
+	return name, f.errs[name]
+}
+
+// queryName takes the name from the "-- name: GetArticle :one" line sqlc
+// puts in front of every query.
+func queryName(query string) string {
+	header, _, _ := strings.Cut(query, "\n")
+	if fields := strings.Fields(strings.TrimPrefix(header, "-- name:")); strings.HasPrefix(header, "-- name:") && len(fields) > 0 {
+		return fields[0]
+	}
+	return strings.TrimSpace(query)
+}
+
+type connector struct {
+	fake *Fake
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) { return conn(c), nil }
+func (c connector) Driver() driver.Driver                        { return nil }
+
+type conn struct {
+	fake *Fake
+}
+
+func (c conn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("dbtest: prepared statements are not supported")
+}
+func (c conn) Close() error              { return nil }
+func (c conn) Begin() (driver.Tx, error) { return tx(c), nil }
+
+func (c conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.fake.record(query, args); err != nil {
+		return nil, err
+	}
+	c.fake.mu.Lock()
+	defer c.fake.mu.Unlock()
+	c.fake.lastInsertID++
+	return result{lastInsertID: c.fake.lastInsertID}, nil
+}
+
+func (c conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	name, err := c.fake.record(query, args)
+	if err != nil {
+		return nil, err
+	}
+	c.fake.mu.Lock()
+	defer c.fake.mu.Unlock()
+	if next := c.fake.next[name]; len(next) > 0 {
+		c.fake.next[name] = next[1:]
+		return &rows{values: next[0]}, nil
+	}
+	return &rows{values: c.fake.rows[name]}, nil
+}
+
+type tx struct {
+	fake *Fake
+}
+
+func (t tx) Commit() error {
+	_, err := t.fake.record("COMMIT", nil)
+	return err
+}
+
+func (t tx) Rollback() error {
+	_, err := t.fake.record("ROLLBACK", nil)
+	return err
+}
+
+type result struct {
+	lastInsertID int64
+}
+
+func (r result) LastInsertId() (int64, error) { return r.lastInsertID, nil }
+func (r result) RowsAffected() (int64, error) { return 1, nil }
+
+type rows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *rows) Columns() []string {
+	if len(r.values) == 0 {
+		return nil
+	}
+	columns := make([]string, len(r.values[0]))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	return columns
+}
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+)
+
+// This file is not generated by sqlc.
+
+const getLock = `-- name: GetLock :one
+SELECT GET_LOCK(?, 0)`
+
+const releaseLock = `-- name: ReleaseLock :one
+SELECT RELEASE_LOCK(?)`
+
+// WithLock runs fn while holding the named MySQL user lock and reports
+// whether it ran. It returns straight away when another session holds the
+// lock, which makes it fit for work only one instance should do at a time.
+//
+// User locks belong to the session that took them, so a connection is kept
+// out of the pool until fn returns. fn can use conn as usual.
+func WithLock(ctx context.Context, conn *sql.DB, name string, fn func() error) (bool, error) {
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer c.Close()
+
+	q := NewTraced(c)
+	var acquired sql.NullInt64
+	if err := q.db.QueryRowContext(ctx, getLock, name).Scan(&acquired); err != nil {
+		return false, err
+	}
+	if acquired.Int64 != 1 {
+		return false, nil
+	}
+	defer func() {
+		var released sql.NullInt64
+		if err := q.db.QueryRowContext(context.WithoutCancel(ctx), releaseLock, name).Scan(&released); err != nil {
+			slog.Error("failed to release lock", "lock", name, "error", err)
+			// Closing the session is the other way to let go of the lock.
+			_ = c.Raw(func(any) error { return driver.ErrBadConn })
+		}
+	}()
+	return true, fn()
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithLockRunsAndReleases(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("GetLock", int64(1))
+	fake.Return("ReleaseLock", int64(1))
+
+	ran := false
+	locked, err := WithLock(context.Background(), conn, "job", func() error {
+		ran = true
+		assert.Empty(t, fake.Calls("ReleaseLock"), "the lock is held while fn runs")
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	assert.True(t, ran)
+	if calls := fake.Calls("GetLock"); assert.Len(t, calls, 1) {
+		assert.Equal(t, "job", calls[0].Args[0])
+	}
+	assert.Len(t, fake.Calls("ReleaseLock"), 1)
+}
+
+func TestWithLockSkipsWhenHeldElsewhere(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("GetLock", int64(0))
+
+	locked, err := WithLock(context.Background(), conn, "job", func() error {
+		t.Error("fn ran without the lock")
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, locked)
+	assert.Empty(t, fake.Calls("ReleaseLock"))
+}
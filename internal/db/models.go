@@ -6,18 +6,58 @@ package db
 
 import (
 	"database/sql"
+	"time"
 )
 
+type AccessToken struct {
+	ID          int64
+	ProfileID   int64
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	ExpiresAt   time.Time
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime
+	CreatedAt   sql.NullTime
+}
+
+type Annotation struct {
+	ID               int64
+	LibraryArticleID int64
+	AuthorID         int64
+	Quote            sql.NullString
+	Comment          sql.NullString
+	Page             sql.NullInt32
+	Location         sql.NullString
+	// 0:Unspecified, 1:Yellow, 2:Green, 3:Blue, 4:Pink, 5:Purple
+	Color int8
+	// 1:Private, 2:Shared
+	Visibility int8
+	CreatedAt  sql.NullTime
+	UpdatedAt  sql.NullTime
+}
+
+type AnnotationTag struct {
+	AnnotationID int64
+	TagID        int64
+	CreatedAt    sql.NullTime
+}
+
 type Article struct {
 	ID              int64
 	Doi             string
+	DoiNormalized   string
 	Title           string
 	Abstract        sql.NullString
 	Url             sql.NullString
 	PublicationYear sql.NullInt32
 	JournalName     sql.NullString
-	CreatedAt       sql.NullTime
-	UpdatedAt       sql.NullTime
+	// 0:None, 1:Corrected, 2:ExpressionOfConcern, 3:Retracted
+	Flag             int8
+	UpdatesCheckedAt sql.NullTime
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
 }
 
 type ArticleAuthor struct {
@@ -27,12 +67,70 @@ type ArticleAuthor struct {
 	CreatedAt   sql.NullTime
 }
 
+type ArticleRecommendation struct {
+	ArticleID            int64
+	RecommendedArticleID int64
+	Score                float64
+	SharedAuthors        int32
+	SharedTags           int32
+	CoCitations          int32
+	CoOccurrences        int32
+	AbstractSimilarity   float64
+	ComputedAt           sql.NullTime
+}
+
+type ArticleRedirect struct {
+	OldID    int64
+	OldDoi   string
+	NewID    int64
+	MergedAt sql.NullTime
+}
+
+type ArticleReference struct {
+	ID              int64
+	CitingArticleID int64
+	Position        int32
+	CitedDoi        sql.NullString
+	CitedArticleID  sql.NullInt64
+	Title           sql.NullString
+	Author          sql.NullString
+	PublicationYear sql.NullInt32
+	JournalName     sql.NullString
+	Unstructured    sql.NullString
+	CreatedAt       sql.NullTime
+}
+
 type ArticleTag struct {
 	ArticleID int64
 	TagID     int64
 	CreatedAt sql.NullTime
 }
 
+type ArticleUpdate struct {
+	ID         int64
+	ArticleID  int64
+	UpdateType string
+	// 1:Corrected, 2:ExpressionOfConcern, 3:Retracted
+	Flag      int8
+	NoticeDoi string
+	Label     sql.NullString
+	UpdatedOn sql.NullTime
+	CreatedAt sql.NullTime
+}
+
+type Attachment struct {
+	ID               int64
+	OwnerID          int64
+	Sha256           string
+	Filename         string
+	ContentType      string
+	Size             int64
+	ArticleID        sql.NullInt64
+	LibraryArticleID sql.NullInt64
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+}
+
 type Author struct {
 	ID        int64
 	Name      string
@@ -41,6 +139,25 @@ type Author struct {
 	UpdatedAt sql.NullTime
 }
 
+type Blob struct {
+	Sha256      string
+	Size        int64
+	ContentType string
+	// 0:NotApplicable, 1:Pending, 2:Processing, 3:Done, 4:Failed
+	ExtractionStatus     int8
+	ExtractionError      sql.NullString
+	ExtractionLeaseUntil sql.NullTime
+	PageCount            sql.NullInt32
+	ExtractedAt          sql.NullTime
+	CreatedAt            sql.NullTime
+}
+
+type BlobPage struct {
+	Sha256     string
+	PageNumber int32
+	Text       string
+}
+
 type Library struct {
 	ID          int64
 	OwnerID     int64
@@ -68,13 +185,55 @@ type LibraryArticle struct {
 }
 
 type Profile struct {
-	ID          int64
-	UserID      string
-	Name        string
-	Bio         sql.NullString
-	Institution sql.NullString
-	CreatedAt   sql.NullTime
-	UpdatedAt   sql.NullTime
+	ID               int64
+	UserID           string
+	Name             string
+	Bio              sql.NullString
+	Institution      sql.NullString
+	AdminConfirmedAt sql.NullTime
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+}
+
+type ReadingEvent struct {
+	ID               int64
+	ProfileID        int64
+	LibraryID        int64
+	ArticleID        int64
+	LibraryArticleID int64
+	// 1:Added, 2:StatusChanged, 3:ProgressUpdated, 4:NoteEdited, 5:Opened, 6:Removed, 7:FavoriteChanged, 8:Reviewed
+	EventType  int8
+	FromStatus sql.NullInt16
+	ToStatus   sql.NullInt16
+	Progress   sql.NullInt32
+	OccurredAt sql.NullTime
+}
+
+type ReadingGoal struct {
+	ID        int64
+	ProfileID int64
+	Name      string
+	// 1:ReadCount, 2:FinishLibrary
+	GoalType    int8
+	TargetCount sql.NullInt32
+	// 1:Day, 2:Week, 3:Month, 4:Year
+	Period    sql.NullInt16
+	LibraryID sql.NullInt64
+	DueDate   sql.NullTime
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+type ReviewSchedule struct {
+	LibraryArticleID int64
+	EaseFactor       float64
+	IntervalDays     int32
+	Repetitions      int32
+	DueDate          time.Time
+	LastReviewedAt   sql.NullTime
+	LastRating       sql.NullInt16
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
 }
 
 type Tag struct {
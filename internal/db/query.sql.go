@@ -8,8 +8,24 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 )
 
+const addAnnotationTag = `-- name: AddAnnotationTag :exec
+INSERT IGNORE INTO annotation_tags (annotation_id, tag_id) VALUES (?, ?)
+`
+
+type AddAnnotationTagParams struct {
+	AnnotationID int64
+	TagID        int64
+}
+
+func (q *Queries) AddAnnotationTag(ctx context.Context, arg AddAnnotationTagParams) error {
+	_, err := q.db.ExecContext(ctx, addAnnotationTag, arg.AnnotationID, arg.TagID)
+	return err
+}
+
 const addArticleAuthor = `-- name: AddArticleAuthor :execresult
 
 INSERT INTO article_authors (article_id, author_id, author_order) VALUES (?, ?, ?)
@@ -54,12 +70,340 @@ func (q *Queries) AddLibraryArticle(ctx context.Context, arg AddLibraryArticlePa
 	)
 }
 
+const claimBlobForExtraction = `-- name: ClaimBlobForExtraction :execrows
+UPDATE blobs SET extraction_status = 2, extraction_lease_until = ? WHERE sha256 = ? AND extraction_status = 1
+`
+
+type ClaimBlobForExtractionParams struct {
+	ExtractionLeaseUntil sql.NullTime
+	Sha256               string
+}
+
+func (q *Queries) ClaimBlobForExtraction(ctx context.Context, arg ClaimBlobForExtractionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimBlobForExtraction, arg.ExtractionLeaseUntil, arg.Sha256)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const clearProfileAdmin = `-- name: ClearProfileAdmin :exec
+UPDATE profiles SET admin_confirmed_at = NULL WHERE id = ?
+`
+
+func (q *Queries) ClearProfileAdmin(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, clearProfileAdmin, id)
+	return err
+}
+
+const completeBlobExtraction = `-- name: CompleteBlobExtraction :exec
+UPDATE blobs
+SET extraction_status = 3, extraction_error = NULL, extraction_lease_until = NULL, page_count = ?, extracted_at = CURRENT_TIMESTAMP
+WHERE sha256 = ?
+`
+
+type CompleteBlobExtractionParams struct {
+	PageCount sql.NullInt32
+	Sha256    string
+}
+
+func (q *Queries) CompleteBlobExtraction(ctx context.Context, arg CompleteBlobExtractionParams) error {
+	_, err := q.db.ExecContext(ctx, completeBlobExtraction, arg.PageCount, arg.Sha256)
+	return err
+}
+
+const confirmProfileAdmin = `-- name: ConfirmProfileAdmin :exec
+UPDATE profiles SET admin_confirmed_at = ? WHERE id = ?
+`
+
+type ConfirmProfileAdminParams struct {
+	ConfirmedAt sql.NullTime
+	ID          int64
+}
+
+// ConfirmProfileAdmin records that the identity provider vouched for the
+// admin role.
+func (q *Queries) ConfirmProfileAdmin(ctx context.Context, arg ConfirmProfileAdminParams) error {
+	_, err := q.db.ExecContext(ctx, confirmProfileAdmin, arg.ConfirmedAt, arg.ID)
+	return err
+}
+
+const copyArticleAuthors = `-- name: CopyArticleAuthors :exec
+INSERT IGNORE INTO article_authors (article_id, author_id, author_order)
+SELECT ?, aa.author_id, aa.author_order FROM article_authors aa WHERE aa.article_id = ?
+`
+
+type CopyArticleAuthorsParams struct {
+	SurvivorID  int64
+	DuplicateID int64
+}
+
+func (q *Queries) CopyArticleAuthors(ctx context.Context, arg CopyArticleAuthorsParams) error {
+	_, err := q.db.ExecContext(ctx, copyArticleAuthors, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const copyArticleTags = `-- name: CopyArticleTags :exec
+INSERT IGNORE INTO article_tags (article_id, tag_id)
+SELECT ?, t.tag_id FROM article_tags t WHERE t.article_id = ?
+`
+
+type CopyArticleTagsParams struct {
+	SurvivorID  int64
+	DuplicateID int64
+}
+
+func (q *Queries) CopyArticleTags(ctx context.Context, arg CopyArticleTagsParams) error {
+	_, err := q.db.ExecContext(ctx, copyArticleTags, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const countArticleReferences = `-- name: CountArticleReferences :one
+SELECT COUNT(*) FROM article_references WHERE citing_article_id = ?
+`
+
+func (q *Queries) CountArticleReferences(ctx context.Context, citingArticleID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countArticleReferences, citingArticleID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countAttachmentsBySha256 = `-- name: CountAttachmentsBySha256 :one
+SELECT COUNT(*) FROM attachments WHERE sha256 = ?
+`
+
+func (q *Queries) CountAttachmentsBySha256(ctx context.Context, sha256 string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAttachmentsBySha256, sha256)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCatalog = `-- name: CountCatalog :one
+
+SELECT
+    (SELECT COUNT(*) FROM articles) AS articles,
+    (SELECT COUNT(*) FROM library) AS libraries,
+    (SELECT COUNT(*) FROM profiles) AS profiles
+`
+
+type CountCatalogRow struct {
+	Articles  int64
+	Libraries int64
+	Profiles  int64
+}
+
+// Metrics
+func (q *Queries) CountCatalog(ctx context.Context) (CountCatalogRow, error) {
+	row := q.db.QueryRowContext(ctx, countCatalog)
+	var i CountCatalogRow
+	err := row.Scan(&i.Articles, &i.Libraries, &i.Profiles)
+	return i, err
+}
+
+const countDueReviews = `-- name: CountDueReviews :one
+SELECT COUNT(*)
+FROM review_schedules rs
+         JOIN library_articles la ON rs.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ? AND rs.due_date <= ?
+`
+
+type CountDueReviewsParams struct {
+	OwnerID int64
+	DueDate time.Time
+}
+
+func (q *Queries) CountDueReviews(ctx context.Context, arg CountDueReviewsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDueReviews, arg.OwnerID, arg.DueDate)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countLibraryArticlesByStatusForOwner = `-- name: CountLibraryArticlesByStatusForOwner :many
+SELECT
+    la.library_id,
+    la.reading_status,
+    COUNT(*) AS article_count
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ?
+GROUP BY la.library_id, la.reading_status
+`
+
+type CountLibraryArticlesByStatusForOwnerRow struct {
+	LibraryID     int64
+	ReadingStatus sql.NullInt16
+	ArticleCount  int64
+}
+
+func (q *Queries) CountLibraryArticlesByStatusForOwner(ctx context.Context, ownerID int64) ([]CountLibraryArticlesByStatusForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, countLibraryArticlesByStatusForOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountLibraryArticlesByStatusForOwnerRow
+	for rows.Next() {
+		var i CountLibraryArticlesByStatusForOwnerRow
+		if err := rows.Scan(&i.LibraryID, &i.ReadingStatus, &i.ArticleCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countLibraryArticlesFiltered = `-- name: CountLibraryArticlesFiltered :one
+SELECT COUNT(*)
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = ?
+  AND (? IS NULL OR la.reading_status = ?)
+  AND (? IS NULL OR la.isFavorite = ?)
+  AND (? IS NULL OR a.title LIKE ? OR a.doi LIKE ?)
+`
+
+type CountLibraryArticlesFilteredParams struct {
+	LibraryID     int64
+	ReadingStatus sql.NullInt16
+	IsFavorite    sql.NullBool
+	Query         sql.NullString
+}
+
+func (q *Queries) CountLibraryArticlesFiltered(ctx context.Context, arg CountLibraryArticlesFilteredParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countLibraryArticlesFiltered,
+		arg.LibraryID,
+		arg.ReadingStatus,
+		arg.ReadingStatus,
+		arg.IsFavorite,
+		arg.IsFavorite,
+		arg.Query,
+		arg.Query,
+		arg.Query,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTagsForOwner = `-- name: CountTagsForOwner :many
+SELECT
+    t.name,
+    COUNT(DISTINCT la.article_id) AS article_count
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+         JOIN article_tags at ON at.article_id = la.article_id
+         JOIN tags t ON at.tag_id = t.id
+WHERE l.owner_id = ?
+GROUP BY t.id, t.name
+ORDER BY article_count DESC, t.name
+LIMIT ?
+`
+
+type CountTagsForOwnerParams struct {
+	OwnerID int64
+	Limit   int32
+}
+
+type CountTagsForOwnerRow struct {
+	Name         string
+	ArticleCount int64
+}
+
+func (q *Queries) CountTagsForOwner(ctx context.Context, arg CountTagsForOwnerParams) ([]CountTagsForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, countTagsForOwner, arg.OwnerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountTagsForOwnerRow
+	for rows.Next() {
+		var i CountTagsForOwnerRow
+		if err := rows.Scan(&i.Name, &i.ArticleCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createAccessToken = `-- name: CreateAccessToken :execresult
+INSERT INTO access_tokens (profile_id, name, token_hash, token_prefix, scopes, expires_at) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateAccessTokenParams struct {
+	ProfileID   int64
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) CreateAccessToken(ctx context.Context, arg CreateAccessTokenParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createAccessToken,
+		arg.ProfileID,
+		arg.Name,
+		arg.TokenHash,
+		arg.TokenPrefix,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+}
+
+const createAnnotation = `-- name: CreateAnnotation :execresult
+
+INSERT INTO annotations (library_article_id, author_id, quote, comment, page, location, color, visibility)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateAnnotationParams struct {
+	LibraryArticleID int64
+	AuthorID         int64
+	Quote            sql.NullString
+	Comment          sql.NullString
+	Page             sql.NullInt32
+	Location         sql.NullString
+	Color            int8
+	Visibility       int8
+}
+
+// Annotations (annotations, annotation_tags)
+func (q *Queries) CreateAnnotation(ctx context.Context, arg CreateAnnotationParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createAnnotation,
+		arg.LibraryArticleID,
+		arg.AuthorID,
+		arg.Quote,
+		arg.Comment,
+		arg.Page,
+		arg.Location,
+		arg.Color,
+		arg.Visibility,
+	)
+}
+
 const createArticle = `-- name: CreateArticle :execresult
-INSERT INTO articles (doi, title, abstract, url, publication_year, journal_name) VALUES (?, ?, ?, ?, ?, ?)
+INSERT INTO articles (doi, doi_normalized, title, abstract, url, publication_year, journal_name) VALUES (?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateArticleParams struct {
 	Doi             string
+	DoiNormalized   string
 	Title           string
 	Abstract        sql.NullString
 	Url             sql.NullString
@@ -70,6 +414,7 @@ type CreateArticleParams struct {
 func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, createArticle,
 		arg.Doi,
+		arg.DoiNormalized,
 		arg.Title,
 		arg.Abstract,
 		arg.Url,
@@ -78,6 +423,78 @@ func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (s
 	)
 }
 
+const createArticleRedirect = `-- name: CreateArticleRedirect :exec
+INSERT INTO article_redirects (old_id, old_doi, new_id) VALUES (?, ?, ?)
+`
+
+type CreateArticleRedirectParams struct {
+	OldID  int64
+	OldDoi string
+	NewID  int64
+}
+
+func (q *Queries) CreateArticleRedirect(ctx context.Context, arg CreateArticleRedirectParams) error {
+	_, err := q.db.ExecContext(ctx, createArticleRedirect, arg.OldID, arg.OldDoi, arg.NewID)
+	return err
+}
+
+const createArticleReference = `-- name: CreateArticleReference :exec
+INSERT INTO article_references (citing_article_id, position, cited_doi, title, author, publication_year, journal_name, unstructured)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateArticleReferenceParams struct {
+	CitingArticleID int64
+	Position        int32
+	CitedDoi        sql.NullString
+	Title           sql.NullString
+	Author          sql.NullString
+	PublicationYear sql.NullInt32
+	JournalName     sql.NullString
+	Unstructured    sql.NullString
+}
+
+func (q *Queries) CreateArticleReference(ctx context.Context, arg CreateArticleReferenceParams) error {
+	_, err := q.db.ExecContext(ctx, createArticleReference,
+		arg.CitingArticleID,
+		arg.Position,
+		arg.CitedDoi,
+		arg.Title,
+		arg.Author,
+		arg.PublicationYear,
+		arg.JournalName,
+		arg.Unstructured,
+	)
+	return err
+}
+
+const createAttachment = `-- name: CreateAttachment :execresult
+INSERT INTO attachments (owner_id, sha256, filename, content_type, size, article_id, library_article_id)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateAttachmentParams struct {
+	OwnerID          int64
+	Sha256           string
+	Filename         string
+	ContentType      string
+	Size             int64
+	ArticleID        sql.NullInt64
+	LibraryArticleID sql.NullInt64
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createAttachment,
+		arg.OwnerID,
+		arg.Sha256,
+		arg.Filename,
+		arg.ContentType,
+		arg.Size,
+		arg.ArticleID,
+		arg.LibraryArticleID,
+	)
+}
+
 const createAuthor = `-- name: CreateAuthor :execresult
 INSERT INTO authors (name, profile_id) VALUES (?, ?)
 `
@@ -91,6 +508,75 @@ func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (sql
 	return q.db.ExecContext(ctx, createAuthor, arg.Name, arg.ProfileID)
 }
 
+const createBlob = `-- name: CreateBlob :exec
+
+INSERT INTO blobs (sha256, size, content_type, extraction_status) VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE sha256 = sha256
+`
+
+type CreateBlobParams struct {
+	Sha256           string
+	Size             int64
+	ContentType      string
+	ExtractionStatus int8
+}
+
+// Attachments (blobs, attachments)
+// Creates the blob row, or takes the row lock on the existing one, so the
+// contents are not deleted while an upload refers to them.
+func (q *Queries) CreateBlob(ctx context.Context, arg CreateBlobParams) error {
+	_, err := q.db.ExecContext(ctx, createBlob,
+		arg.Sha256,
+		arg.Size,
+		arg.ContentType,
+		arg.ExtractionStatus,
+	)
+	return err
+}
+
+const createBlobPage = `-- name: CreateBlobPage :exec
+INSERT INTO blob_pages (sha256, page_number, text) VALUES (?, ?, ?)
+`
+
+type CreateBlobPageParams struct {
+	Sha256     string
+	PageNumber int32
+	Text       string
+}
+
+func (q *Queries) CreateBlobPage(ctx context.Context, arg CreateBlobPageParams) error {
+	_, err := q.db.ExecContext(ctx, createBlobPage, arg.Sha256, arg.PageNumber, arg.Text)
+	return err
+}
+
+const createGoal = `-- name: CreateGoal :execresult
+
+INSERT INTO reading_goals (profile_id, name, goal_type, target_count, period, library_id, due_date) VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateGoalParams struct {
+	ProfileID   int64
+	Name        string
+	GoalType    int8
+	TargetCount sql.NullInt32
+	Period      sql.NullInt16
+	LibraryID   sql.NullInt64
+	DueDate     sql.NullTime
+}
+
+// Reading goals (reading_goals)
+func (q *Queries) CreateGoal(ctx context.Context, arg CreateGoalParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createGoal,
+		arg.ProfileID,
+		arg.Name,
+		arg.GoalType,
+		arg.TargetCount,
+		arg.Period,
+		arg.LibraryID,
+		arg.DueDate,
+	)
+}
+
 const createLibrary = `-- name: CreateLibrary :execresult
 INSERT INTO library (owner_id, name, description, isPublic, isDefault) VALUES (?, ?, ?, ?, ?)
 `
@@ -133,77 +619,364 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (s
 	)
 }
 
-const deleteArticle = `-- name: DeleteArticle :exec
-DELETE FROM articles WHERE id = ?
+const createReadingEvent = `-- name: CreateReadingEvent :exec
+
+INSERT INTO reading_events (profile_id, library_id, article_id, library_article_id, event_type, from_status, to_status, progress)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) DeleteArticle(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteArticle, id)
+type CreateReadingEventParams struct {
+	ProfileID        int64
+	LibraryID        int64
+	ArticleID        int64
+	LibraryArticleID int64
+	EventType        int8
+	FromStatus       sql.NullInt16
+	ToStatus         sql.NullInt16
+	Progress         sql.NullInt32
+}
+
+// Reading activity log (reading_events)
+func (q *Queries) CreateReadingEvent(ctx context.Context, arg CreateReadingEventParams) error {
+	_, err := q.db.ExecContext(ctx, createReadingEvent,
+		arg.ProfileID,
+		arg.LibraryID,
+		arg.ArticleID,
+		arg.LibraryArticleID,
+		arg.EventType,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Progress,
+	)
 	return err
 }
 
-const deleteArticleAuthor = `-- name: DeleteArticleAuthor :exec
-DELETE FROM article_authors WHERE article_id = ? AND author_id = ?
+const createRecommendation = `-- name: CreateRecommendation :exec
+INSERT INTO article_recommendations (article_id, recommended_article_id, score, shared_authors, shared_tags, co_citations, co_occurrences, abstract_similarity)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-type DeleteArticleAuthorParams struct {
-	ArticleID int64
-	AuthorID  int64
+type CreateRecommendationParams struct {
+	ArticleID            int64
+	RecommendedArticleID int64
+	Score                float64
+	SharedAuthors        int32
+	SharedTags           int32
+	CoCitations          int32
+	CoOccurrences        int32
+	AbstractSimilarity   float64
 }
 
-func (q *Queries) DeleteArticleAuthor(ctx context.Context, arg DeleteArticleAuthorParams) error {
-	_, err := q.db.ExecContext(ctx, deleteArticleAuthor, arg.ArticleID, arg.AuthorID)
+func (q *Queries) CreateRecommendation(ctx context.Context, arg CreateRecommendationParams) error {
+	_, err := q.db.ExecContext(ctx, createRecommendation,
+		arg.ArticleID,
+		arg.RecommendedArticleID,
+		arg.Score,
+		arg.SharedAuthors,
+		arg.SharedTags,
+		arg.CoCitations,
+		arg.CoOccurrences,
+		arg.AbstractSimilarity,
+	)
 	return err
 }
 
-const deleteAuthor = `-- name: DeleteAuthor :exec
-DELETE FROM authors WHERE id = ?
+const createReviewSchedule = `-- name: CreateReviewSchedule :exec
+
+INSERT INTO review_schedules (library_article_id, ease_factor, interval_days, repetitions, due_date) VALUES (?, ?, ?, ?, ?)
 `
 
-func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteAuthor, id)
-	return err
+type CreateReviewScheduleParams struct {
+	LibraryArticleID int64
+	EaseFactor       float64
+	IntervalDays     int32
+	Repetitions      int32
+	DueDate          time.Time
 }
 
-const deleteLibrary = `-- name: DeleteLibrary :exec
-DELETE FROM library WHERE id = ?
-`
-
-func (q *Queries) DeleteLibrary(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteLibrary, id)
+// Spaced-repetition review schedules (review_schedules)
+func (q *Queries) CreateReviewSchedule(ctx context.Context, arg CreateReviewScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, createReviewSchedule,
+		arg.LibraryArticleID,
+		arg.EaseFactor,
+		arg.IntervalDays,
+		arg.Repetitions,
+		arg.DueDate,
+	)
 	return err
 }
 
-const deleteLibraryArticle = `-- name: DeleteLibraryArticle :exec
-DELETE FROM library_articles WHERE id = ?
+const deleteAllRecommendations = `-- name: DeleteAllRecommendations :exec
+DELETE FROM article_recommendations
 `
 
-func (q *Queries) DeleteLibraryArticle(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteLibraryArticle, id)
+func (q *Queries) DeleteAllRecommendations(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteAllRecommendations)
 	return err
 }
 
-const deleteProfile = `-- name: DeleteProfile :exec
-DELETE FROM profiles WHERE id = ?
+const deleteAnnotation = `-- name: DeleteAnnotation :exec
+DELETE FROM annotations WHERE id = ?
 `
 
-func (q *Queries) DeleteProfile(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteProfile, id)
+func (q *Queries) DeleteAnnotation(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAnnotation, id)
 	return err
 }
 
-const deleteSavedArticle = `-- name: DeleteSavedArticle :exec
-DELETE FROM library_articles WHERE id = ?
+const deleteAnnotationTags = `-- name: DeleteAnnotationTags :exec
+DELETE FROM annotation_tags WHERE annotation_id = ?
 `
 
-func (q *Queries) DeleteSavedArticle(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteSavedArticle, id)
+func (q *Queries) DeleteAnnotationTags(ctx context.Context, annotationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAnnotationTags, annotationID)
 	return err
 }
 
-const getArticle = `-- name: GetArticle :one
+const deleteArticle = `-- name: DeleteArticle :exec
+DELETE FROM articles WHERE id = ?
+`
+
+func (q *Queries) DeleteArticle(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteArticle, id)
+	return err
+}
+
+const deleteArticleAuthor = `-- name: DeleteArticleAuthor :exec
+DELETE FROM article_authors WHERE article_id = ? AND author_id = ?
+`
+
+type DeleteArticleAuthorParams struct {
+	ArticleID int64
+	AuthorID  int64
+}
+
+func (q *Queries) DeleteArticleAuthor(ctx context.Context, arg DeleteArticleAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, deleteArticleAuthor, arg.ArticleID, arg.AuthorID)
+	return err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :exec
+DELETE FROM attachments WHERE id = ?
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAttachment, id)
+	return err
+}
+
+const deleteAuthor = `-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?
+`
+
+func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthor, id)
+	return err
+}
+
+const deleteBlob = `-- name: DeleteBlob :exec
+DELETE FROM blobs WHERE sha256 = ?
+`
+
+func (q *Queries) DeleteBlob(ctx context.Context, sha256 string) error {
+	_, err := q.db.ExecContext(ctx, deleteBlob, sha256)
+	return err
+}
+
+const deleteBlobPages = `-- name: DeleteBlobPages :exec
+DELETE FROM blob_pages WHERE sha256 = ?
+`
+
+func (q *Queries) DeleteBlobPages(ctx context.Context, sha256 string) error {
+	_, err := q.db.ExecContext(ctx, deleteBlobPages, sha256)
+	return err
+}
+
+const deleteGoal = `-- name: DeleteGoal :exec
+DELETE FROM reading_goals WHERE id = ?
+`
+
+func (q *Queries) DeleteGoal(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGoal, id)
+	return err
+}
+
+const deleteLibrary = `-- name: DeleteLibrary :exec
+DELETE FROM library WHERE id = ?
+`
+
+func (q *Queries) DeleteLibrary(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteLibrary, id)
+	return err
+}
+
+const deleteLibraryArticle = `-- name: DeleteLibraryArticle :exec
+DELETE FROM library_articles WHERE id = ?
+`
+
+func (q *Queries) DeleteLibraryArticle(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteLibraryArticle, id)
+	return err
+}
+
+const deleteProfile = `-- name: DeleteProfile :exec
+DELETE FROM profiles WHERE id = ?
+`
+
+func (q *Queries) DeleteProfile(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteProfile, id)
+	return err
+}
+
+const deleteReviewSchedule = `-- name: DeleteReviewSchedule :exec
+DELETE FROM review_schedules WHERE library_article_id = ?
+`
+
+func (q *Queries) DeleteReviewSchedule(ctx context.Context, libraryArticleID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteReviewSchedule, libraryArticleID)
+	return err
+}
+
+const deleteSavedArticle = `-- name: DeleteSavedArticle :exec
+DELETE FROM library_articles WHERE id = ?
+`
+
+func (q *Queries) DeleteSavedArticle(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedArticle, id)
+	return err
+}
+
+const failBlobExtraction = `-- name: FailBlobExtraction :exec
+UPDATE blobs
+SET extraction_status = 4, extraction_error = ?, extraction_lease_until = NULL, extracted_at = CURRENT_TIMESTAMP
+WHERE sha256 = ?
+`
+
+type FailBlobExtractionParams struct {
+	ExtractionError sql.NullString
+	Sha256          string
+}
+
+func (q *Queries) FailBlobExtraction(ctx context.Context, arg FailBlobExtractionParams) error {
+	_, err := q.db.ExecContext(ctx, failBlobExtraction, arg.ExtractionError, arg.Sha256)
+	return err
+}
+
+const getAccessToken = `-- name: GetAccessToken :one
+SELECT id, profile_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at FROM access_tokens WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetAccessToken(ctx context.Context, id int64) (AccessToken, error) {
+	row := q.db.QueryRowContext(ctx, getAccessToken, id)
+	var i AccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Name,
+		&i.TokenHash,
+		&i.TokenPrefix,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAccessTokenByHash = `-- name: GetAccessTokenByHash :one
+SELECT
+    t.id,
+    t.profile_id,
+    t.scopes,
+    t.expires_at,
+    t.last_used_at,
+    t.revoked_at,
+    p.user_id,
+    p.name AS profile_name,
+    p.admin_confirmed_at
+FROM access_tokens t
+         JOIN profiles p ON t.profile_id = p.id
+WHERE t.token_hash = ?
+LIMIT 1
+`
+
+type GetAccessTokenByHashRow struct {
+	ID               int64
+	ProfileID        int64
+	Scopes           string
+	ExpiresAt        time.Time
+	LastUsedAt       sql.NullTime
+	RevokedAt        sql.NullTime
+	UserID           string
+	ProfileName      string
+	AdminConfirmedAt sql.NullTime
+}
+
+func (q *Queries) GetAccessTokenByHash(ctx context.Context, tokenHash string) (GetAccessTokenByHashRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccessTokenByHash, tokenHash)
+	var i GetAccessTokenByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.UserID,
+		&i.ProfileName,
+		&i.AdminConfirmedAt,
+	)
+	return i, err
+}
+
+const getAnnotation = `-- name: GetAnnotation :one
+SELECT
+    an.id, an.library_article_id, an.author_id, an.quote, an.comment, an.page, an.location, an.color, an.visibility, an.created_at, an.updated_at,
+    la.library_id,
+    la.article_id,
+    l.owner_id,
+    l.isPublic
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE an.id = ? LIMIT 1
+`
 
-SELECT id, doi, title, abstract, url, publication_year, journal_name, created_at, updated_at FROM articles WHERE id = ? LIMIT 1
+type GetAnnotationRow struct {
+	Annotation Annotation
+	LibraryID  int64
+	ArticleID  int64
+	OwnerID    int64
+	Ispublic   sql.NullBool
+}
+
+func (q *Queries) GetAnnotation(ctx context.Context, id int64) (GetAnnotationRow, error) {
+	row := q.db.QueryRowContext(ctx, getAnnotation, id)
+	var i GetAnnotationRow
+	err := row.Scan(
+		&i.Annotation.ID,
+		&i.Annotation.LibraryArticleID,
+		&i.Annotation.AuthorID,
+		&i.Annotation.Quote,
+		&i.Annotation.Comment,
+		&i.Annotation.Page,
+		&i.Annotation.Location,
+		&i.Annotation.Color,
+		&i.Annotation.Visibility,
+		&i.Annotation.CreatedAt,
+		&i.Annotation.UpdatedAt,
+		&i.LibraryID,
+		&i.ArticleID,
+		&i.OwnerID,
+		&i.Ispublic,
+	)
+	return i, err
+}
+
+const getArticle = `-- name: GetArticle :one
+
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles WHERE id = ? LIMIT 1
 `
 
 // Academic articles/papers
@@ -213,11 +986,14 @@ func (q *Queries) GetArticle(ctx context.Context, id int64) (Article, error) {
 	err := row.Scan(
 		&i.ID,
 		&i.Doi,
+		&i.DoiNormalized,
 		&i.Title,
 		&i.Abstract,
 		&i.Url,
 		&i.PublicationYear,
 		&i.JournalName,
+		&i.Flag,
+		&i.UpdatesCheckedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -225,7 +1001,7 @@ func (q *Queries) GetArticle(ctx context.Context, id int64) (Article, error) {
 }
 
 const getArticleByDOI = `-- name: GetArticleByDOI :one
-SELECT id, doi, title, abstract, url, publication_year, journal_name, created_at, updated_at FROM articles WHERE doi = ? LIMIT 1
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles WHERE doi = ? LIMIT 1
 `
 
 func (q *Queries) GetArticleByDOI(ctx context.Context, doi string) (Article, error) {
@@ -234,17 +1010,113 @@ func (q *Queries) GetArticleByDOI(ctx context.Context, doi string) (Article, err
 	err := row.Scan(
 		&i.ID,
 		&i.Doi,
+		&i.DoiNormalized,
+		&i.Title,
+		&i.Abstract,
+		&i.Url,
+		&i.PublicationYear,
+		&i.JournalName,
+		&i.Flag,
+		&i.UpdatesCheckedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getArticleByNormalizedDOI = `-- name: GetArticleByNormalizedDOI :one
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles WHERE doi_normalized = ? ORDER BY id LIMIT 1
+`
+
+func (q *Queries) GetArticleByNormalizedDOI(ctx context.Context, doiNormalized string) (Article, error) {
+	row := q.db.QueryRowContext(ctx, getArticleByNormalizedDOI, doiNormalized)
+	var i Article
+	err := row.Scan(
+		&i.ID,
+		&i.Doi,
+		&i.DoiNormalized,
 		&i.Title,
 		&i.Abstract,
 		&i.Url,
 		&i.PublicationYear,
 		&i.JournalName,
+		&i.Flag,
+		&i.UpdatesCheckedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getArticleRedirect = `-- name: GetArticleRedirect :one
+SELECT new_id FROM article_redirects WHERE old_id = ?
+`
+
+func (q *Queries) GetArticleRedirect(ctx context.Context, oldID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getArticleRedirect, oldID)
+	var new_id int64
+	err := row.Scan(&new_id)
+	return new_id, err
+}
+
+const getArticleRedirectByDOI = `-- name: GetArticleRedirectByDOI :one
+SELECT new_id FROM article_redirects WHERE old_doi = ? ORDER BY merged_at DESC LIMIT 1
+`
+
+func (q *Queries) GetArticleRedirectByDOI(ctx context.Context, oldDoi string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getArticleRedirectByDOI, oldDoi)
+	var new_id int64
+	err := row.Scan(&new_id)
+	return new_id, err
+}
+
+const getAttachment = `-- name: GetAttachment :one
+SELECT
+    at.id, at.owner_id, at.sha256, at.filename, at.content_type, at.size, at.article_id, at.library_article_id, at.created_at, at.updated_at,
+    b.extraction_status,
+    b.extraction_error,
+    b.page_count,
+    l.owner_id AS library_owner_id,
+    l.isPublic AS library_is_public
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+         LEFT JOIN library_articles la ON at.library_article_id = la.id
+         LEFT JOIN library l ON la.library_id = l.id
+WHERE at.id = ? LIMIT 1
+`
+
+type GetAttachmentRow struct {
+	Attachment       Attachment
+	ExtractionStatus int8
+	ExtractionError  sql.NullString
+	PageCount        sql.NullInt32
+	LibraryOwnerID   sql.NullInt64
+	LibraryIsPublic  sql.NullBool
+}
+
+func (q *Queries) GetAttachment(ctx context.Context, id int64) (GetAttachmentRow, error) {
+	row := q.db.QueryRowContext(ctx, getAttachment, id)
+	var i GetAttachmentRow
+	err := row.Scan(
+		&i.Attachment.ID,
+		&i.Attachment.OwnerID,
+		&i.Attachment.Sha256,
+		&i.Attachment.Filename,
+		&i.Attachment.ContentType,
+		&i.Attachment.Size,
+		&i.Attachment.ArticleID,
+		&i.Attachment.LibraryArticleID,
+		&i.Attachment.CreatedAt,
+		&i.Attachment.UpdatedAt,
+		&i.ExtractionStatus,
+		&i.ExtractionError,
+		&i.PageCount,
+		&i.LibraryOwnerID,
+		&i.LibraryIsPublic,
+	)
+	return i, err
+}
+
 const getAuthor = `-- name: GetAuthor :one
 
 SELECT id, name, profile_id, created_at, updated_at FROM authors WHERE id = ? LIMIT 1
@@ -298,6 +1170,28 @@ func (q *Queries) GetAuthorByProfileID(ctx context.Context, profileID sql.NullIn
 	return i, err
 }
 
+const getGoal = `-- name: GetGoal :one
+SELECT id, profile_id, name, goal_type, target_count, period, library_id, due_date, created_at, updated_at FROM reading_goals WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetGoal(ctx context.Context, id int64) (ReadingGoal, error) {
+	row := q.db.QueryRowContext(ctx, getGoal, id)
+	var i ReadingGoal
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Name,
+		&i.GoalType,
+		&i.TargetCount,
+		&i.Period,
+		&i.LibraryID,
+		&i.DueDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getLibrary = `-- name: GetLibrary :one
 
 SELECT id, owner_id, name, description, ispublic, isdefault, created_at, updated_at FROM library WHERE id = ? LIMIT 1
@@ -350,6 +1244,64 @@ func (q *Queries) GetLibraryArticle(ctx context.Context, arg GetLibraryArticlePa
 	return i, err
 }
 
+const getLibraryArticleDetails = `-- name: GetLibraryArticleDetails :one
+SELECT
+    la.id,
+    la.library_id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title AS article_title,
+    a.doi,
+    a.publication_year,
+    l.owner_id
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+         JOIN library l ON la.library_id = l.id
+WHERE la.id = ? LIMIT 1
+`
+
+type GetLibraryArticleDetailsRow struct {
+	ID              int64
+	LibraryID       int64
+	ArticleID       int64
+	ReadingStatus   sql.NullInt16
+	ReadingProgress sql.NullInt32
+	Dateadded       sql.NullTime
+	Datecompleted   sql.NullTime
+	Notes           sql.NullString
+	Isfavorite      sql.NullBool
+	ArticleTitle    string
+	Doi             string
+	PublicationYear sql.NullInt32
+	OwnerID         int64
+}
+
+func (q *Queries) GetLibraryArticleDetails(ctx context.Context, id int64) (GetLibraryArticleDetailsRow, error) {
+	row := q.db.QueryRowContext(ctx, getLibraryArticleDetails, id)
+	var i GetLibraryArticleDetailsRow
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ArticleID,
+		&i.ReadingStatus,
+		&i.ReadingProgress,
+		&i.Dateadded,
+		&i.Datecompleted,
+		&i.Notes,
+		&i.Isfavorite,
+		&i.ArticleTitle,
+		&i.Doi,
+		&i.PublicationYear,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
 const getLibraryByID = `-- name: GetLibraryByID :one
 
 SELECT id, owner_id, name, description, ispublic, isdefault, created_at, updated_at FROM library WHERE id = ? LIMIT 1
@@ -404,7 +1356,7 @@ func (q *Queries) GetLibraryWithArticles(ctx context.Context, id int64) (Library
 
 const getProfile = `-- name: GetProfile :one
 
-SELECT id, user_id, name, bio, institution, created_at, updated_at FROM profiles WHERE user_id = ? LIMIT 1
+SELECT id, user_id, name, bio, institution, admin_confirmed_at, created_at, updated_at FROM profiles WHERE user_id = ? LIMIT 1
 `
 
 // Profiles of users/researchers
@@ -417,6 +1369,7 @@ func (q *Queries) GetProfile(ctx context.Context, userID string) (Profile, error
 		&i.Name,
 		&i.Bio,
 		&i.Institution,
+		&i.AdminConfirmedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -424,7 +1377,7 @@ func (q *Queries) GetProfile(ctx context.Context, userID string) (Profile, error
 }
 
 const getProfileByUserID = `-- name: GetProfileByUserID :one
-SELECT id, user_id, name, bio, institution, created_at, updated_at FROM profiles WHERE user_id = ? LIMIT 1
+SELECT id, user_id, name, bio, institution, admin_confirmed_at, created_at, updated_at FROM profiles WHERE user_id = ? LIMIT 1
 `
 
 func (q *Queries) GetProfileByUserID(ctx context.Context, userID string) (Profile, error) {
@@ -436,35 +1389,387 @@ func (q *Queries) GetProfileByUserID(ctx context.Context, userID string) (Profil
 		&i.Name,
 		&i.Bio,
 		&i.Institution,
+		&i.AdminConfirmedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const listArticleAuthorsByArticleID = `-- name: ListArticleAuthorsByArticleID :many
-SELECT
-    aa.author_id,
-    aa.author_order,
-    a.name AS author_name,
-    a.profile_id
-FROM article_authors aa
-         JOIN authors a ON aa.author_id = a.id
-WHERE aa.article_id = ?
-ORDER BY aa.author_order
+const getReviewSchedule = `-- name: GetReviewSchedule :one
+SELECT library_article_id, ease_factor, interval_days, repetitions, due_date, last_reviewed_at, last_rating, created_at, updated_at FROM review_schedules WHERE library_article_id = ? LIMIT 1
 `
 
-type ListArticleAuthorsByArticleIDRow struct {
-	AuthorID    int64
-	AuthorOrder sql.NullInt32
-	AuthorName  string
-	ProfileID   sql.NullInt64
+func (q *Queries) GetReviewSchedule(ctx context.Context, libraryArticleID int64) (ReviewSchedule, error) {
+	row := q.db.QueryRowContext(ctx, getReviewSchedule, libraryArticleID)
+	var i ReviewSchedule
+	err := row.Scan(
+		&i.LibraryArticleID,
+		&i.EaseFactor,
+		&i.IntervalDays,
+		&i.Repetitions,
+		&i.DueDate,
+		&i.LastReviewedAt,
+		&i.LastRating,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) ListArticleAuthorsByArticleID(ctx context.Context, articleID int64) ([]ListArticleAuthorsByArticleIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listArticleAuthorsByArticleID, articleID)
-	if err != nil {
-		return nil, err
+const hasRecentRecommendations = `-- name: HasRecentRecommendations :one
+SELECT EXISTS (SELECT 1
+               FROM article_recommendations
+               WHERE computed_at > NOW() - INTERVAL CAST(? AS SIGNED) SECOND) AS recent
+`
+
+// HasRecentRecommendations reports whether the recommendations were
+// computed within the last max_age_seconds, by any instance.
+func (q *Queries) HasRecentRecommendations(ctx context.Context, maxAgeSeconds int64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasRecentRecommendations, maxAgeSeconds)
+	var recent bool
+	err := row.Scan(&recent)
+	return recent, err
+}
+
+const listAccessTokensByProfileID = `-- name: ListAccessTokensByProfileID :many
+SELECT id, profile_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at FROM access_tokens WHERE profile_id = ? ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListAccessTokensByProfileID(ctx context.Context, profileID int64) ([]AccessToken, error) {
+	rows, err := q.db.QueryContext(ctx, listAccessTokensByProfileID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccessToken
+	for rows.Next() {
+		var i AccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Name,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllArticleAuthors = `-- name: ListAllArticleAuthors :many
+SELECT article_id, author_id FROM article_authors
+`
+
+type ListAllArticleAuthorsRow struct {
+	ArticleID int64
+	AuthorID  int64
+}
+
+func (q *Queries) ListAllArticleAuthors(ctx context.Context) ([]ListAllArticleAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAllArticleAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAllArticleAuthorsRow
+	for rows.Next() {
+		var i ListAllArticleAuthorsRow
+		if err := rows.Scan(&i.ArticleID, &i.AuthorID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllArticleTags = `-- name: ListAllArticleTags :many
+SELECT article_id, tag_id FROM article_tags
+`
+
+type ListAllArticleTagsRow struct {
+	ArticleID int64
+	TagID     int64
+}
+
+func (q *Queries) ListAllArticleTags(ctx context.Context) ([]ListAllArticleTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAllArticleTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAllArticleTagsRow
+	for rows.Next() {
+		var i ListAllArticleTagsRow
+		if err := rows.Scan(&i.ArticleID, &i.TagID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnnotationTags = `-- name: ListAnnotationTags :many
+SELECT ant.annotation_id, t.name
+FROM annotation_tags ant
+         JOIN tags t ON ant.tag_id = t.id
+WHERE ant.annotation_id IN (/*SLICE:annotation_ids*/?)
+ORDER BY t.name
+`
+
+type ListAnnotationTagsRow struct {
+	AnnotationID int64
+	Name         string
+}
+
+func (q *Queries) ListAnnotationTags(ctx context.Context, annotationIds []int64) ([]ListAnnotationTagsRow, error) {
+	query := listAnnotationTags
+	var queryParams []interface{}
+	if len(annotationIds) > 0 {
+		for _, v := range annotationIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:annotation_ids*/?", strings.Repeat(",?", len(annotationIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:annotation_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAnnotationTagsRow
+	for rows.Next() {
+		var i ListAnnotationTagsRow
+		if err := rows.Scan(&i.AnnotationID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnnotationsForExport = `-- name: ListAnnotationsForExport :many
+SELECT
+    an.id, an.library_article_id, an.author_id, an.quote, an.comment, an.page, an.location, an.color, an.visibility, an.created_at, an.updated_at,
+    la.library_id,
+    la.article_id,
+    l.name AS library_name,
+    a.title AS article_title,
+    a.doi
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE an.author_id = ?
+  AND (? IS NULL OR la.library_id = ?)
+ORDER BY la.library_id, a.title, la.id, an.page IS NULL, an.page, an.id
+`
+
+type ListAnnotationsForExportParams struct {
+	AuthorID  int64
+	LibraryID sql.NullInt64
+}
+
+type ListAnnotationsForExportRow struct {
+	Annotation   Annotation
+	LibraryID    int64
+	ArticleID    int64
+	LibraryName  sql.NullString
+	ArticleTitle string
+	Doi          string
+}
+
+func (q *Queries) ListAnnotationsForExport(ctx context.Context, arg ListAnnotationsForExportParams) ([]ListAnnotationsForExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAnnotationsForExport, arg.AuthorID, arg.LibraryID, arg.LibraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAnnotationsForExportRow
+	for rows.Next() {
+		var i ListAnnotationsForExportRow
+		if err := rows.Scan(
+			&i.Annotation.ID,
+			&i.Annotation.LibraryArticleID,
+			&i.Annotation.AuthorID,
+			&i.Annotation.Quote,
+			&i.Annotation.Comment,
+			&i.Annotation.Page,
+			&i.Annotation.Location,
+			&i.Annotation.Color,
+			&i.Annotation.Visibility,
+			&i.Annotation.CreatedAt,
+			&i.Annotation.UpdatedAt,
+			&i.LibraryID,
+			&i.ArticleID,
+			&i.LibraryName,
+			&i.ArticleTitle,
+			&i.Doi,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnnotationsForLibraryArticle = `-- name: ListAnnotationsForLibraryArticle :many
+SELECT
+    an.id, an.library_article_id, an.author_id, an.quote, an.comment, an.page, an.location, an.color, an.visibility, an.created_at, an.updated_at,
+    la.library_id,
+    la.article_id
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE an.library_article_id = ?
+  AND (an.author_id = ?
+    OR (an.visibility = 2 AND (l.owner_id = ? OR l.isPublic)))
+ORDER BY an.page IS NULL, an.page, an.id
+`
+
+type ListAnnotationsForLibraryArticleParams struct {
+	LibraryArticleID int64
+	ViewerID         int64
+}
+
+type ListAnnotationsForLibraryArticleRow struct {
+	Annotation Annotation
+	LibraryID  int64
+	ArticleID  int64
+}
+
+func (q *Queries) ListAnnotationsForLibraryArticle(ctx context.Context, arg ListAnnotationsForLibraryArticleParams) ([]ListAnnotationsForLibraryArticleRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAnnotationsForLibraryArticle, arg.LibraryArticleID, arg.ViewerID, arg.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAnnotationsForLibraryArticleRow
+	for rows.Next() {
+		var i ListAnnotationsForLibraryArticleRow
+		if err := rows.Scan(
+			&i.Annotation.ID,
+			&i.Annotation.LibraryArticleID,
+			&i.Annotation.AuthorID,
+			&i.Annotation.Quote,
+			&i.Annotation.Comment,
+			&i.Annotation.Page,
+			&i.Annotation.Location,
+			&i.Annotation.Color,
+			&i.Annotation.Visibility,
+			&i.Annotation.CreatedAt,
+			&i.Annotation.UpdatedAt,
+			&i.LibraryID,
+			&i.ArticleID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAbstracts = `-- name: ListArticleAbstracts :many
+
+SELECT id, abstract FROM articles WHERE abstract IS NOT NULL AND abstract <> ''
+`
+
+type ListArticleAbstractsRow struct {
+	ID       int64
+	Abstract sql.NullString
+}
+
+// Recommendation inputs
+func (q *Queries) ListArticleAbstracts(ctx context.Context) ([]ListArticleAbstractsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleAbstracts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAbstractsRow
+	for rows.Next() {
+		var i ListArticleAbstractsRow
+		if err := rows.Scan(&i.ID, &i.Abstract); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAuthorsByArticleID = `-- name: ListArticleAuthorsByArticleID :many
+SELECT
+    aa.author_id,
+    aa.author_order,
+    a.name AS author_name,
+    a.profile_id
+FROM article_authors aa
+         JOIN authors a ON aa.author_id = a.id
+WHERE aa.article_id = ?
+ORDER BY aa.author_order
+`
+
+type ListArticleAuthorsByArticleIDRow struct {
+	AuthorID    int64
+	AuthorOrder sql.NullInt32
+	AuthorName  string
+	ProfileID   sql.NullInt64
+}
+
+func (q *Queries) ListArticleAuthorsByArticleID(ctx context.Context, articleID int64) ([]ListArticleAuthorsByArticleIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleAuthorsByArticleID, articleID)
+	if err != nil {
+		return nil, err
 	}
 	defer rows.Close()
 	var items []ListArticleAuthorsByArticleIDRow
@@ -489,39 +1794,2163 @@ func (q *Queries) ListArticleAuthorsByArticleID(ctx context.Context, articleID i
 	return items, nil
 }
 
-const listArticleAuthorsByAuthorID = `-- name: ListArticleAuthorsByAuthorID :many
+const listArticleAuthorsByArticleIDs = `-- name: ListArticleAuthorsByArticleIDs :many
 SELECT
     aa.article_id,
+    aa.author_id,
     aa.author_order,
-    ar.title AS article_title,
-    ar.doi
+    a.name AS author_name,
+    a.profile_id
 FROM article_authors aa
-         JOIN articles ar ON aa.article_id = ar.id
-WHERE aa.author_id = ?
-ORDER BY ar.publication_year DESC, ar.title
+         JOIN authors a ON aa.author_id = a.id
+WHERE aa.article_id IN (/*SLICE:article_ids*/?)
+ORDER BY aa.article_id, aa.author_order
 `
 
-type ListArticleAuthorsByAuthorIDRow struct {
-	ArticleID    int64
-	AuthorOrder  sql.NullInt32
-	ArticleTitle string
-	Doi          string
+type ListArticleAuthorsByArticleIDsRow struct {
+	ArticleID   int64
+	AuthorID    int64
+	AuthorOrder sql.NullInt32
+	AuthorName  string
+	ProfileID   sql.NullInt64
 }
 
-func (q *Queries) ListArticleAuthorsByAuthorID(ctx context.Context, authorID int64) ([]ListArticleAuthorsByAuthorIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listArticleAuthorsByAuthorID, authorID)
+func (q *Queries) ListArticleAuthorsByArticleIDs(ctx context.Context, articleIds []int64) ([]ListArticleAuthorsByArticleIDsRow, error) {
+	query := listArticleAuthorsByArticleIDs
+	var queryParams []interface{}
+	if len(articleIds) > 0 {
+		for _, v := range articleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", strings.Repeat(",?", len(articleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAuthorsByArticleIDsRow
+	for rows.Next() {
+		var i ListArticleAuthorsByArticleIDsRow
+		if err := rows.Scan(
+			&i.ArticleID,
+			&i.AuthorID,
+			&i.AuthorOrder,
+			&i.AuthorName,
+			&i.ProfileID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAuthorsByAuthorID = `-- name: ListArticleAuthorsByAuthorID :many
+SELECT
+    aa.article_id,
+    aa.author_order,
+    ar.title AS article_title,
+    ar.doi
+FROM article_authors aa
+         JOIN articles ar ON aa.article_id = ar.id
+WHERE aa.author_id = ?
+ORDER BY ar.publication_year DESC, ar.title
+`
+
+type ListArticleAuthorsByAuthorIDRow struct {
+	ArticleID    int64
+	AuthorOrder  sql.NullInt32
+	ArticleTitle string
+	Doi          string
+}
+
+func (q *Queries) ListArticleAuthorsByAuthorID(ctx context.Context, authorID int64) ([]ListArticleAuthorsByAuthorIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleAuthorsByAuthorID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAuthorsByAuthorIDRow
+	for rows.Next() {
+		var i ListArticleAuthorsByAuthorIDRow
+		if err := rows.Scan(
+			&i.ArticleID,
+			&i.AuthorOrder,
+			&i.ArticleTitle,
+			&i.Doi,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleAuthorsForLibrary = `-- name: ListArticleAuthorsForLibrary :many
+SELECT aa.article_id, au.name
+FROM article_authors aa
+         JOIN authors au ON aa.author_id = au.id
+         JOIN library_articles la ON la.article_id = aa.article_id
+WHERE la.library_id = ?
+ORDER BY aa.article_id, aa.author_order
+`
+
+type ListArticleAuthorsForLibraryRow struct {
+	ArticleID int64
+	Name      string
+}
+
+func (q *Queries) ListArticleAuthorsForLibrary(ctx context.Context, libraryID int64) ([]ListArticleAuthorsForLibraryRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleAuthorsForLibrary, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleAuthorsForLibraryRow
+	for rows.Next() {
+		var i ListArticleAuthorsForLibraryRow
+		if err := rows.Scan(&i.ArticleID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleDOIs = `-- name: ListArticleDOIs :many
+
+SELECT id, doi FROM articles ORDER BY id
+`
+
+type ListArticleDOIsRow struct {
+	ID  int64
+	Doi string
+}
+
+// Duplicate detection and merging
+// ListArticleDOIs leaves out doi_normalized, which is NULL until
+// MigrateNormalizedDOIs has filled it in.
+func (q *Queries) ListArticleDOIs(ctx context.Context) ([]ListArticleDOIsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleDOIs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleDOIsRow
+	for rows.Next() {
+		var i ListArticleDOIsRow
+		if err := rows.Scan(&i.ID, &i.Doi); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleReferences = `-- name: ListArticleReferences :many
+SELECT
+    r.position,
+    r.cited_doi,
+    r.cited_article_id,
+    COALESCE(a.title, r.title, '') AS title,
+    r.author,
+    COALESCE(a.publication_year, r.publication_year) AS publication_year,
+    COALESCE(a.journal_name, r.journal_name) AS journal_name,
+    r.unstructured
+FROM article_references r
+         LEFT JOIN articles a ON a.id = r.cited_article_id
+WHERE r.citing_article_id = ?
+ORDER BY r.position
+`
+
+type ListArticleReferencesRow struct {
+	Position        int32
+	CitedDoi        sql.NullString
+	CitedArticleID  sql.NullInt64
+	Title           string
+	Author          sql.NullString
+	PublicationYear sql.NullInt32
+	JournalName     sql.NullString
+	Unstructured    sql.NullString
+}
+
+func (q *Queries) ListArticleReferences(ctx context.Context, citingArticleID int64) ([]ListArticleReferencesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleReferences, citingArticleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleReferencesRow
+	for rows.Next() {
+		var i ListArticleReferencesRow
+		if err := rows.Scan(
+			&i.Position,
+			&i.CitedDoi,
+			&i.CitedArticleID,
+			&i.Title,
+			&i.Author,
+			&i.PublicationYear,
+			&i.JournalName,
+			&i.Unstructured,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleTagsForLibrary = `-- name: ListArticleTagsForLibrary :many
+SELECT at.article_id, t.name
+FROM article_tags at
+         JOIN tags t ON at.tag_id = t.id
+         JOIN library_articles la ON la.article_id = at.article_id
+WHERE la.library_id = ?
+ORDER BY at.article_id, t.name
+`
+
+type ListArticleTagsForLibraryRow struct {
+	ArticleID int64
+	Name      string
+}
+
+func (q *Queries) ListArticleTagsForLibrary(ctx context.Context, libraryID int64) ([]ListArticleTagsForLibraryRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticleTagsForLibrary, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticleTagsForLibraryRow
+	for rows.Next() {
+		var i ListArticleTagsForLibraryRow
+		if err := rows.Scan(&i.ArticleID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticleUpdates = `-- name: ListArticleUpdates :many
+SELECT id, article_id, update_type, flag, notice_doi, label, updated_on, created_at FROM article_updates
+WHERE article_id IN (/*SLICE:article_ids*/?)
+ORDER BY article_id, updated_on DESC
+`
+
+func (q *Queries) ListArticleUpdates(ctx context.Context, articleIds []int64) ([]ArticleUpdate, error) {
+	query := listArticleUpdates
+	var queryParams []interface{}
+	if len(articleIds) > 0 {
+		for _, v := range articleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", strings.Repeat(",?", len(articleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArticleUpdate
+	for rows.Next() {
+		var i ArticleUpdate
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArticleID,
+			&i.UpdateType,
+			&i.Flag,
+			&i.NoticeDoi,
+			&i.Label,
+			&i.UpdatedOn,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticles = `-- name: ListArticles :many
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles ORDER BY title
+`
+
+func (q *Queries) ListArticles(ctx context.Context) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, listArticles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Article
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.Doi,
+			&i.DoiNormalized,
+			&i.Title,
+			&i.Abstract,
+			&i.Url,
+			&i.PublicationYear,
+			&i.JournalName,
+			&i.Flag,
+			&i.UpdatesCheckedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticlesByIDs = `-- name: ListArticlesByIDs :many
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListArticlesByIDs(ctx context.Context, ids []int64) ([]Article, error) {
+	query := listArticlesByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Article
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.Doi,
+			&i.DoiNormalized,
+			&i.Title,
+			&i.Abstract,
+			&i.Url,
+			&i.PublicationYear,
+			&i.JournalName,
+			&i.Flag,
+			&i.UpdatesCheckedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticlesByTitleMatch = `-- name: ListArticlesByTitleMatch :many
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles
+WHERE MATCH(title) AGAINST (?) AND id <> ?
+LIMIT ?
+`
+
+type ListArticlesByTitleMatchParams struct {
+	Title     string
+	ExcludeID int64
+	Limit     int32
+}
+
+// ListArticlesByTitleMatch relies on MATCH in WHERE returning rows by
+// relevance.
+func (q *Queries) ListArticlesByTitleMatch(ctx context.Context, arg ListArticlesByTitleMatchParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, listArticlesByTitleMatch, arg.Title, arg.ExcludeID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Article
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.Doi,
+			&i.DoiNormalized,
+			&i.Title,
+			&i.Abstract,
+			&i.Url,
+			&i.PublicationYear,
+			&i.JournalName,
+			&i.Flag,
+			&i.UpdatesCheckedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticlesDueForUpdateCheck = `-- name: ListArticlesDueForUpdateCheck :many
+SELECT id, doi_normalized
+FROM articles
+WHERE updates_checked_at IS NULL OR updates_checked_at < ?
+ORDER BY updates_checked_at
+LIMIT ?
+`
+
+type ListArticlesDueForUpdateCheckParams struct {
+	CheckedBefore sql.NullTime
+	Limit         int32
+}
+
+type ListArticlesDueForUpdateCheckRow struct {
+	ID            int64
+	DoiNormalized string
+}
+
+func (q *Queries) ListArticlesDueForUpdateCheck(ctx context.Context, arg ListArticlesDueForUpdateCheckParams) ([]ListArticlesDueForUpdateCheckRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticlesDueForUpdateCheck, arg.CheckedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticlesDueForUpdateCheckRow
+	for rows.Next() {
+		var i ListArticlesDueForUpdateCheckRow
+		if err := rows.Scan(&i.ID, &i.DoiNormalized); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArticlesWithAuthors = `-- name: ListArticlesWithAuthors :many
+SELECT
+    a.id, a.doi, a.doi_normalized, a.title, a.abstract, a.url, a.publication_year, a.journal_name, a.flag, a.updates_checked_at, a.created_at, a.updated_at,
+    au.id, au.name, au.profile_id, au.created_at, au.updated_at
+FROM articles a
+LEFT JOIN article_authors aa ON a.id = aa.article_id
+LEFT JOIN authors au ON aa.author_id = au.id
+ORDER BY a.title, aa.author_order
+`
+
+type ListArticlesWithAuthorsRow struct {
+	Article Article
+	Author  Author
+}
+
+func (q *Queries) ListArticlesWithAuthors(ctx context.Context) ([]ListArticlesWithAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArticlesWithAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArticlesWithAuthorsRow
+	for rows.Next() {
+		var i ListArticlesWithAuthorsRow
+		if err := rows.Scan(
+			&i.Article.ID,
+			&i.Article.Doi,
+			&i.Article.DoiNormalized,
+			&i.Article.Title,
+			&i.Article.Abstract,
+			&i.Article.Url,
+			&i.Article.PublicationYear,
+			&i.Article.JournalName,
+			&i.Article.Flag,
+			&i.Article.UpdatesCheckedAt,
+			&i.Article.CreatedAt,
+			&i.Article.UpdatedAt,
+			&i.Author.ID,
+			&i.Author.Name,
+			&i.Author.ProfileID,
+			&i.Author.CreatedAt,
+			&i.Author.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAttachmentsForArticle = `-- name: ListAttachmentsForArticle :many
+SELECT at.id, at.owner_id, at.sha256, at.filename, at.content_type, at.size, at.article_id, at.library_article_id, at.created_at, at.updated_at, b.extraction_status, b.extraction_error, b.page_count
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+WHERE at.article_id = ?
+ORDER BY at.id
+`
+
+type ListAttachmentsForArticleRow struct {
+	Attachment       Attachment
+	ExtractionStatus int8
+	ExtractionError  sql.NullString
+	PageCount        sql.NullInt32
+}
+
+func (q *Queries) ListAttachmentsForArticle(ctx context.Context, articleID sql.NullInt64) ([]ListAttachmentsForArticleRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAttachmentsForArticle, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAttachmentsForArticleRow
+	for rows.Next() {
+		var i ListAttachmentsForArticleRow
+		if err := rows.Scan(
+			&i.Attachment.ID,
+			&i.Attachment.OwnerID,
+			&i.Attachment.Sha256,
+			&i.Attachment.Filename,
+			&i.Attachment.ContentType,
+			&i.Attachment.Size,
+			&i.Attachment.ArticleID,
+			&i.Attachment.LibraryArticleID,
+			&i.Attachment.CreatedAt,
+			&i.Attachment.UpdatedAt,
+			&i.ExtractionStatus,
+			&i.ExtractionError,
+			&i.PageCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAttachmentsForLibraryArticle = `-- name: ListAttachmentsForLibraryArticle :many
+SELECT at.id, at.owner_id, at.sha256, at.filename, at.content_type, at.size, at.article_id, at.library_article_id, at.created_at, at.updated_at, b.extraction_status, b.extraction_error, b.page_count
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+WHERE at.library_article_id = ?
+ORDER BY at.id
+`
+
+type ListAttachmentsForLibraryArticleRow struct {
+	Attachment       Attachment
+	ExtractionStatus int8
+	ExtractionError  sql.NullString
+	PageCount        sql.NullInt32
+}
+
+func (q *Queries) ListAttachmentsForLibraryArticle(ctx context.Context, libraryArticleID sql.NullInt64) ([]ListAttachmentsForLibraryArticleRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAttachmentsForLibraryArticle, libraryArticleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAttachmentsForLibraryArticleRow
+	for rows.Next() {
+		var i ListAttachmentsForLibraryArticleRow
+		if err := rows.Scan(
+			&i.Attachment.ID,
+			&i.Attachment.OwnerID,
+			&i.Attachment.Sha256,
+			&i.Attachment.Filename,
+			&i.Attachment.ContentType,
+			&i.Attachment.Size,
+			&i.Attachment.ArticleID,
+			&i.Attachment.LibraryArticleID,
+			&i.Attachment.CreatedAt,
+			&i.Attachment.UpdatedAt,
+			&i.ExtractionStatus,
+			&i.ExtractionError,
+			&i.PageCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, profile_id, created_at, updated_at FROM authors ORDER BY name
+`
+
+func (q *Queries) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBlobsPendingExtraction = `-- name: ListBlobsPendingExtraction :many
+
+SELECT sha256, size FROM blobs WHERE extraction_status = 1 ORDER BY created_at LIMIT ?
+`
+
+type ListBlobsPendingExtractionRow struct {
+	Sha256 string
+	Size   int64
+}
+
+// Full-text extraction (blobs, blob_pages)
+func (q *Queries) ListBlobsPendingExtraction(ctx context.Context, limit int32) ([]ListBlobsPendingExtractionRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBlobsPendingExtraction, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBlobsPendingExtractionRow
+	for rows.Next() {
+		var i ListBlobsPendingExtractionRow
+		if err := rows.Scan(&i.Sha256, &i.Size); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCitingArticles = `-- name: ListCitingArticles :many
+SELECT DISTINCT a.id, a.doi, a.doi_normalized, a.title, a.abstract, a.url, a.publication_year, a.journal_name, a.flag, a.updates_checked_at, a.created_at, a.updated_at
+FROM article_references r
+         JOIN articles a ON a.id = r.citing_article_id
+WHERE r.cited_article_id = ?
+ORDER BY a.publication_year DESC, a.title
+`
+
+func (q *Queries) ListCitingArticles(ctx context.Context, citedArticleID sql.NullInt64) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, listCitingArticles, citedArticleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Article
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.Doi,
+			&i.DoiNormalized,
+			&i.Title,
+			&i.Abstract,
+			&i.Url,
+			&i.PublicationYear,
+			&i.JournalName,
+			&i.Flag,
+			&i.UpdatesCheckedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueReviews = `-- name: ListDueReviews :many
+SELECT
+    rs.library_article_id, rs.ease_factor, rs.interval_days, rs.repetitions, rs.due_date, rs.last_reviewed_at, rs.last_rating, rs.created_at, rs.updated_at,
+    la.library_id,
+    la.article_id,
+    la.notes,
+    a.title AS article_title,
+    a.doi
+FROM review_schedules rs
+         JOIN library_articles la ON rs.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE l.owner_id = ? AND rs.due_date <= ?
+ORDER BY rs.due_date, rs.library_article_id
+LIMIT ?
+`
+
+type ListDueReviewsParams struct {
+	OwnerID int64
+	DueDate time.Time
+	Limit   int32
+}
+
+type ListDueReviewsRow struct {
+	ReviewSchedule ReviewSchedule
+	LibraryID      int64
+	ArticleID      int64
+	Notes          sql.NullString
+	ArticleTitle   string
+	Doi            string
+}
+
+func (q *Queries) ListDueReviews(ctx context.Context, arg ListDueReviewsParams) ([]ListDueReviewsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDueReviews, arg.OwnerID, arg.DueDate, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDueReviewsRow
+	for rows.Next() {
+		var i ListDueReviewsRow
+		if err := rows.Scan(
+			&i.ReviewSchedule.LibraryArticleID,
+			&i.ReviewSchedule.EaseFactor,
+			&i.ReviewSchedule.IntervalDays,
+			&i.ReviewSchedule.Repetitions,
+			&i.ReviewSchedule.DueDate,
+			&i.ReviewSchedule.LastReviewedAt,
+			&i.ReviewSchedule.LastRating,
+			&i.ReviewSchedule.CreatedAt,
+			&i.ReviewSchedule.UpdatedAt,
+			&i.LibraryID,
+			&i.ArticleID,
+			&i.Notes,
+			&i.ArticleTitle,
+			&i.Doi,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFlaggedLibraryArticlesForOwner = `-- name: ListFlaggedLibraryArticlesForOwner :many
+SELECT
+    la.id AS library_article_id,
+    la.library_id,
+    l.name AS library_name,
+    a.id AS article_id,
+    a.doi,
+    a.title,
+    a.flag
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE l.owner_id = ? AND a.flag > 0
+ORDER BY a.flag DESC, a.title, la.library_id
+`
+
+type ListFlaggedLibraryArticlesForOwnerRow struct {
+	LibraryArticleID int64
+	LibraryID        int64
+	LibraryName      sql.NullString
+	ArticleID        int64
+	Doi              string
+	Title            string
+	Flag             int8
+}
+
+func (q *Queries) ListFlaggedLibraryArticlesForOwner(ctx context.Context, ownerID int64) ([]ListFlaggedLibraryArticlesForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFlaggedLibraryArticlesForOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFlaggedLibraryArticlesForOwnerRow
+	for rows.Next() {
+		var i ListFlaggedLibraryArticlesForOwnerRow
+		if err := rows.Scan(
+			&i.LibraryArticleID,
+			&i.LibraryID,
+			&i.LibraryName,
+			&i.ArticleID,
+			&i.Doi,
+			&i.Title,
+			&i.Flag,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGoalsByProfileID = `-- name: ListGoalsByProfileID :many
+SELECT id, profile_id, name, goal_type, target_count, period, library_id, due_date, created_at, updated_at FROM reading_goals WHERE profile_id = ? ORDER BY created_at
+`
+
+func (q *Queries) ListGoalsByProfileID(ctx context.Context, profileID int64) ([]ReadingGoal, error) {
+	rows, err := q.db.QueryContext(ctx, listGoalsByProfileID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadingGoal
+	for rows.Next() {
+		var i ReadingGoal
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Name,
+			&i.GoalType,
+			&i.TargetCount,
+			&i.Period,
+			&i.LibraryID,
+			&i.DueDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibrariesByUserID = `-- name: ListLibrariesByUserID :many
+SELECT id, owner_id, name, description, ispublic, isdefault, created_at, updated_at FROM library WHERE owner_id = ? ORDER BY created_at
+`
+
+func (q *Queries) ListLibrariesByUserID(ctx context.Context, ownerID int64) ([]Library, error) {
+	rows, err := q.db.QueryContext(ctx, listLibrariesByUserID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Library
+	for rows.Next() {
+		var i Library
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.Description,
+			&i.Ispublic,
+			&i.Isdefault,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibraryArticlesByArticleID = `-- name: ListLibraryArticlesByArticleID :many
+SELECT id, library_id, article_id, reading_status, reading_progress, dateadded, datecompleted, notes, isfavorite, created_at, updated_at FROM library_articles WHERE article_id = ?
+`
+
+func (q *Queries) ListLibraryArticlesByArticleID(ctx context.Context, articleID int64) ([]LibraryArticle, error) {
+	rows, err := q.db.QueryContext(ctx, listLibraryArticlesByArticleID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LibraryArticle
+	for rows.Next() {
+		var i LibraryArticle
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.ArticleID,
+			&i.ReadingStatus,
+			&i.ReadingProgress,
+			&i.Dateadded,
+			&i.Datecompleted,
+			&i.Notes,
+			&i.Isfavorite,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibraryArticlesByLibraryID = `-- name: ListLibraryArticlesByLibraryID :many
+SELECT
+    la.id,
+    la.article_id,
+    la.reading_status,
+    la.dateAdded,
+    la.notes,
+    a.title AS article_title,
+    a.doi,
+    a.publication_year
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = ?
+ORDER BY la.dateAdded DESC
+`
+
+type ListLibraryArticlesByLibraryIDRow struct {
+	ID              int64
+	ArticleID       int64
+	ReadingStatus   sql.NullInt16
+	Dateadded       sql.NullTime
+	Notes           sql.NullString
+	ArticleTitle    string
+	Doi             string
+	PublicationYear sql.NullInt32
+}
+
+func (q *Queries) ListLibraryArticlesByLibraryID(ctx context.Context, libraryID int64) ([]ListLibraryArticlesByLibraryIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLibraryArticlesByLibraryID, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLibraryArticlesByLibraryIDRow
+	for rows.Next() {
+		var i ListLibraryArticlesByLibraryIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArticleID,
+			&i.ReadingStatus,
+			&i.Dateadded,
+			&i.Notes,
+			&i.ArticleTitle,
+			&i.Doi,
+			&i.PublicationYear,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibraryArticlesForNotesExport = `-- name: ListLibraryArticlesForNotesExport :many
+SELECT
+    la.id,
+    la.article_id,
+    la.reading_status,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title,
+    a.doi,
+    a.publication_year,
+    a.journal_name
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = ?
+ORDER BY a.title, la.id
+`
+
+type ListLibraryArticlesForNotesExportRow struct {
+	ID              int64
+	ArticleID       int64
+	ReadingStatus   sql.NullInt16
+	Dateadded       sql.NullTime
+	Datecompleted   sql.NullTime
+	Notes           sql.NullString
+	Isfavorite      sql.NullBool
+	Title           string
+	Doi             string
+	PublicationYear sql.NullInt32
+	JournalName     sql.NullString
+}
+
+func (q *Queries) ListLibraryArticlesForNotesExport(ctx context.Context, libraryID int64) ([]ListLibraryArticlesForNotesExportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLibraryArticlesForNotesExport, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLibraryArticlesForNotesExportRow
+	for rows.Next() {
+		var i ListLibraryArticlesForNotesExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArticleID,
+			&i.ReadingStatus,
+			&i.Dateadded,
+			&i.Datecompleted,
+			&i.Notes,
+			&i.Isfavorite,
+			&i.Title,
+			&i.Doi,
+			&i.PublicationYear,
+			&i.JournalName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibraryArticlesForOwner = `-- name: ListLibraryArticlesForOwner :many
+
+SELECT
+    la.id,
+    la.library_id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ?
+`
+
+type ListLibraryArticlesForOwnerRow struct {
+	ID              int64
+	LibraryID       int64
+	ArticleID       int64
+	ReadingStatus   sql.NullInt16
+	ReadingProgress sql.NullInt32
+	Dateadded       sql.NullTime
+	Datecompleted   sql.NullTime
+}
+
+// Reading statistics
+func (q *Queries) ListLibraryArticlesForOwner(ctx context.Context, ownerID int64) ([]ListLibraryArticlesForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLibraryArticlesForOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLibraryArticlesForOwnerRow
+	for rows.Next() {
+		var i ListLibraryArticlesForOwnerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.ArticleID,
+			&i.ReadingStatus,
+			&i.ReadingProgress,
+			&i.Dateadded,
+			&i.Datecompleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLibraryArticlesPage = `-- name: ListLibraryArticlesPage :many
+SELECT
+    la.id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title AS article_title,
+    a.doi,
+    a.publication_year
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = ?
+  AND (? IS NULL OR la.reading_status = ?)
+  AND (? IS NULL OR la.isFavorite = ?)
+  AND (? IS NULL OR a.title LIKE ? OR a.doi LIKE ?)
+ORDER BY
+    CASE WHEN ? = 'date_added_asc' THEN la.dateAdded END ASC,
+    CASE WHEN ? = 'date_added_desc' THEN la.dateAdded END DESC,
+    CASE WHEN ? = 'title_asc' THEN a.title END ASC,
+    CASE WHEN ? = 'title_desc' THEN a.title END DESC,
+    CASE WHEN ? = 'year_asc' THEN a.publication_year END ASC,
+    CASE WHEN ? = 'year_desc' THEN a.publication_year END DESC,
+    CASE WHEN ? = 'progress_asc' THEN la.reading_progress END ASC,
+    CASE WHEN ? = 'progress_desc' THEN la.reading_progress END DESC,
+    la.id
+LIMIT ? OFFSET ?
+`
+
+type ListLibraryArticlesPageParams struct {
+	LibraryID     int64
+	ReadingStatus sql.NullInt16
+	IsFavorite    sql.NullBool
+	Query         sql.NullString
+	SortOrder     interface{}
+	Limit         int32
+	Offset        int32
+}
+
+type ListLibraryArticlesPageRow struct {
+	ID              int64
+	ArticleID       int64
+	ReadingStatus   sql.NullInt16
+	ReadingProgress sql.NullInt32
+	Dateadded       sql.NullTime
+	Datecompleted   sql.NullTime
+	Notes           sql.NullString
+	Isfavorite      sql.NullBool
+	ArticleTitle    string
+	Doi             string
+	PublicationYear sql.NullInt32
+}
+
+func (q *Queries) ListLibraryArticlesPage(ctx context.Context, arg ListLibraryArticlesPageParams) ([]ListLibraryArticlesPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLibraryArticlesPage,
+		arg.LibraryID,
+		arg.ReadingStatus,
+		arg.ReadingStatus,
+		arg.IsFavorite,
+		arg.IsFavorite,
+		arg.Query,
+		arg.Query,
+		arg.Query,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.SortOrder,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLibraryArticlesPageRow
+	for rows.Next() {
+		var i ListLibraryArticlesPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArticleID,
+			&i.ReadingStatus,
+			&i.ReadingProgress,
+			&i.Dateadded,
+			&i.Datecompleted,
+			&i.Notes,
+			&i.Isfavorite,
+			&i.ArticleTitle,
+			&i.Doi,
+			&i.PublicationYear,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfiles = `-- name: ListProfiles :many
+SELECT id, user_id, name, bio, institution, admin_confirmed_at, created_at, updated_at FROM profiles ORDER BY name
+`
+
+func (q *Queries) ListProfiles(ctx context.Context) ([]Profile, error) {
+	rows, err := q.db.QueryContext(ctx, listProfiles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Profile
+	for rows.Next() {
+		var i Profile
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Bio,
+			&i.Institution,
+			&i.AdminConfirmedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPublicLibraryArticles = `-- name: ListPublicLibraryArticles :many
+SELECT la.library_id, la.article_id
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.isPublic = TRUE
+`
+
+type ListPublicLibraryArticlesRow struct {
+	LibraryID int64
+	ArticleID int64
+}
+
+func (q *Queries) ListPublicLibraryArticles(ctx context.Context) ([]ListPublicLibraryArticlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPublicLibraryArticles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPublicLibraryArticlesRow
+	for rows.Next() {
+		var i ListPublicLibraryArticlesRow
+		if err := rows.Scan(&i.LibraryID, &i.ArticleID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReadingEventTimesForOwner = `-- name: ListReadingEventTimesForOwner :many
+SELECT
+    library_article_id,
+    event_type,
+    to_status,
+    occurred_at
+FROM reading_events
+WHERE profile_id = ?
+ORDER BY occurred_at
+`
+
+type ListReadingEventTimesForOwnerRow struct {
+	LibraryArticleID int64
+	EventType        int8
+	ToStatus         sql.NullInt16
+	OccurredAt       sql.NullTime
+}
+
+func (q *Queries) ListReadingEventTimesForOwner(ctx context.Context, profileID int64) ([]ListReadingEventTimesForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReadingEventTimesForOwner, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReadingEventTimesForOwnerRow
+	for rows.Next() {
+		var i ListReadingEventTimesForOwnerRow
+		if err := rows.Scan(
+			&i.LibraryArticleID,
+			&i.EventType,
+			&i.ToStatus,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReadingEvents = `-- name: ListReadingEvents :many
+SELECT
+    e.id,
+    e.library_id,
+    e.article_id,
+    e.library_article_id,
+    e.event_type,
+    e.from_status,
+    e.to_status,
+    e.progress,
+    e.occurred_at,
+    a.title AS article_title
+FROM reading_events e
+         JOIN articles a ON e.article_id = a.id
+WHERE e.profile_id = ?
+  AND (? IS NULL OR e.library_id = ?)
+  AND (? IS NULL OR e.occurred_at >= ?)
+  AND (? IS NULL OR e.occurred_at < ?)
+  AND (? IS NULL OR e.id < ?)
+  AND e.event_type IN (/*SLICE:event_types*/?)
+ORDER BY e.id DESC
+LIMIT ?
+`
+
+type ListReadingEventsParams struct {
+	ProfileID  int64
+	LibraryID  sql.NullInt64
+	StartTime  sql.NullTime
+	EndTime    sql.NullTime
+	BeforeID   sql.NullInt64
+	EventTypes []int8
+	Limit      int32
+}
+
+type ListReadingEventsRow struct {
+	ID               int64
+	LibraryID        int64
+	ArticleID        int64
+	LibraryArticleID int64
+	EventType        int8
+	FromStatus       sql.NullInt16
+	ToStatus         sql.NullInt16
+	Progress         sql.NullInt32
+	OccurredAt       sql.NullTime
+	ArticleTitle     string
+}
+
+func (q *Queries) ListReadingEvents(ctx context.Context, arg ListReadingEventsParams) ([]ListReadingEventsRow, error) {
+	query := listReadingEvents
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ProfileID)
+	queryParams = append(queryParams, arg.LibraryID)
+	queryParams = append(queryParams, arg.LibraryID)
+	queryParams = append(queryParams, arg.StartTime)
+	queryParams = append(queryParams, arg.StartTime)
+	queryParams = append(queryParams, arg.EndTime)
+	queryParams = append(queryParams, arg.EndTime)
+	queryParams = append(queryParams, arg.BeforeID)
+	queryParams = append(queryParams, arg.BeforeID)
+	if len(arg.EventTypes) > 0 {
+		for _, v := range arg.EventTypes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:event_types*/?", strings.Repeat(",?", len(arg.EventTypes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:event_types*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReadingEventsRow
+	for rows.Next() {
+		var i ListReadingEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.ArticleID,
+			&i.LibraryArticleID,
+			&i.EventType,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Progress,
+			&i.OccurredAt,
+			&i.ArticleTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecommendationsForOwner = `-- name: ListRecommendationsForOwner :many
+SELECT r.article_id, r.recommended_article_id, r.score, r.shared_authors, r.shared_tags, r.co_citations, r.co_occurrences, r.abstract_similarity, r.computed_at, s.title AS seed_title
+FROM article_recommendations r
+         JOIN articles s ON s.id = r.article_id
+WHERE r.article_id IN (SELECT la.article_id
+                       FROM library_articles la
+                                JOIN library l ON la.library_id = l.id
+                       WHERE l.owner_id = ?)
+  AND r.recommended_article_id NOT IN (SELECT la.article_id
+                                       FROM library_articles la
+                                                JOIN library l ON la.library_id = l.id
+                                       WHERE l.owner_id = ?)
+ORDER BY r.score DESC
+LIMIT ?
+`
+
+type ListRecommendationsForOwnerParams struct {
+	OwnerID int64
+	Limit   int32
+}
+
+type ListRecommendationsForOwnerRow struct {
+	ArticleRecommendation ArticleRecommendation
+	SeedTitle             string
+}
+
+// ListRecommendationsForOwner returns the recommendations of every article
+// in the owner's libraries that point outside of them.
+func (q *Queries) ListRecommendationsForOwner(ctx context.Context, arg ListRecommendationsForOwnerParams) ([]ListRecommendationsForOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecommendationsForOwner, arg.OwnerID, arg.OwnerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecommendationsForOwnerRow
+	for rows.Next() {
+		var i ListRecommendationsForOwnerRow
+		if err := rows.Scan(
+			&i.ArticleRecommendation.ArticleID,
+			&i.ArticleRecommendation.RecommendedArticleID,
+			&i.ArticleRecommendation.Score,
+			&i.ArticleRecommendation.SharedAuthors,
+			&i.ArticleRecommendation.SharedTags,
+			&i.ArticleRecommendation.CoCitations,
+			&i.ArticleRecommendation.CoOccurrences,
+			&i.ArticleRecommendation.AbstractSimilarity,
+			&i.ArticleRecommendation.ComputedAt,
+			&i.SeedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReferenceEdgesFrom = `-- name: ListReferenceEdgesFrom :many
+SELECT
+    r.id,
+    r.citing_article_id,
+    r.cited_article_id,
+    r.cited_doi,
+    COALESCE(a.title, r.title, r.unstructured, '') AS cited_title
+FROM article_references r
+         LEFT JOIN articles a ON a.id = r.cited_article_id
+WHERE r.citing_article_id IN (/*SLICE:article_ids*/?)
+ORDER BY r.citing_article_id, r.position
+`
+
+type ListReferenceEdgesFromRow struct {
+	ID              int64
+	CitingArticleID int64
+	CitedArticleID  sql.NullInt64
+	CitedDoi        sql.NullString
+	CitedTitle      string
+}
+
+func (q *Queries) ListReferenceEdgesFrom(ctx context.Context, articleIds []int64) ([]ListReferenceEdgesFromRow, error) {
+	query := listReferenceEdgesFrom
+	var queryParams []interface{}
+	if len(articleIds) > 0 {
+		for _, v := range articleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", strings.Repeat(",?", len(articleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReferenceEdgesFromRow
+	for rows.Next() {
+		var i ListReferenceEdgesFromRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CitingArticleID,
+			&i.CitedArticleID,
+			&i.CitedDoi,
+			&i.CitedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReferenceEdgesTo = `-- name: ListReferenceEdgesTo :many
+SELECT
+    r.citing_article_id,
+    r.cited_article_id,
+    a.doi AS citing_doi,
+    a.title AS citing_title
+FROM article_references r
+         JOIN articles a ON a.id = r.citing_article_id
+WHERE r.cited_article_id IN (/*SLICE:article_ids*/?)
+ORDER BY r.cited_article_id, a.publication_year DESC
+`
+
+type ListReferenceEdgesToRow struct {
+	CitingArticleID int64
+	CitedArticleID  sql.NullInt64
+	CitingDoi       string
+	CitingTitle     string
+}
+
+func (q *Queries) ListReferenceEdgesTo(ctx context.Context, articleIds []sql.NullInt64) ([]ListReferenceEdgesToRow, error) {
+	query := listReferenceEdgesTo
+	var queryParams []interface{}
+	if len(articleIds) > 0 {
+		for _, v := range articleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", strings.Repeat(",?", len(articleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:article_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReferenceEdgesToRow
+	for rows.Next() {
+		var i ListReferenceEdgesToRow
+		if err := rows.Scan(
+			&i.CitingArticleID,
+			&i.CitedArticleID,
+			&i.CitingDoi,
+			&i.CitingTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResolvedReferences = `-- name: ListResolvedReferences :many
+SELECT citing_article_id, CAST(cited_article_id AS SIGNED) AS cited_article_id
+FROM article_references
+WHERE cited_article_id IS NOT NULL
+`
+
+type ListResolvedReferencesRow struct {
+	CitingArticleID int64
+	CitedArticleID  int64
+}
+
+func (q *Queries) ListResolvedReferences(ctx context.Context) ([]ListResolvedReferencesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listResolvedReferences)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListResolvedReferencesRow
+	for rows.Next() {
+		var i ListResolvedReferencesRow
+		if err := rows.Scan(&i.CitingArticleID, &i.CitedArticleID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSimilarArticles = `-- name: ListSimilarArticles :many
+SELECT r.article_id, r.recommended_article_id, r.score, r.shared_authors, r.shared_tags, r.co_citations, r.co_occurrences, r.abstract_similarity, r.computed_at, a.id, a.doi, a.doi_normalized, a.title, a.abstract, a.url, a.publication_year, a.journal_name, a.flag, a.updates_checked_at, a.created_at, a.updated_at
+FROM article_recommendations r
+         JOIN articles a ON a.id = r.recommended_article_id
+WHERE r.article_id = ?
+  AND (? IS NULL OR NOT EXISTS (
+    SELECT 1
+    FROM library_articles la
+             JOIN library l ON la.library_id = l.id
+    WHERE la.article_id = r.recommended_article_id AND l.owner_id = ?))
+ORDER BY r.score DESC
+LIMIT ?
+`
+
+type ListSimilarArticlesParams struct {
+	ArticleID      int64
+	ExcludeOwnerID sql.NullInt64
+	Limit          int32
+}
+
+type ListSimilarArticlesRow struct {
+	ArticleRecommendation ArticleRecommendation
+	Article               Article
+}
+
+// ListSimilarArticles optionally leaves out articles already in any of the
+// given owner's libraries.
+func (q *Queries) ListSimilarArticles(ctx context.Context, arg ListSimilarArticlesParams) ([]ListSimilarArticlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSimilarArticles,
+		arg.ArticleID,
+		arg.ExcludeOwnerID,
+		arg.ExcludeOwnerID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSimilarArticlesRow
+	for rows.Next() {
+		var i ListSimilarArticlesRow
+		if err := rows.Scan(
+			&i.ArticleRecommendation.ArticleID,
+			&i.ArticleRecommendation.RecommendedArticleID,
+			&i.ArticleRecommendation.Score,
+			&i.ArticleRecommendation.SharedAuthors,
+			&i.ArticleRecommendation.SharedTags,
+			&i.ArticleRecommendation.CoCitations,
+			&i.ArticleRecommendation.CoOccurrences,
+			&i.ArticleRecommendation.AbstractSimilarity,
+			&i.ArticleRecommendation.ComputedAt,
+			&i.Article.ID,
+			&i.Article.Doi,
+			&i.Article.DoiNormalized,
+			&i.Article.Title,
+			&i.Article.Abstract,
+			&i.Article.Url,
+			&i.Article.PublicationYear,
+			&i.Article.JournalName,
+			&i.Article.Flag,
+			&i.Article.UpdatesCheckedAt,
+			&i.Article.CreatedAt,
+			&i.Article.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockBlob = `-- name: LockBlob :one
+SELECT sha256 FROM blobs WHERE sha256 = ? FOR UPDATE
+`
+
+func (q *Queries) LockBlob(ctx context.Context, sha256 string) (string, error) {
+	row := q.db.QueryRowContext(ctx, lockBlob, sha256)
+	err := row.Scan(&sha256)
+	return sha256, err
+}
+
+const lockProfile = `-- name: LockProfile :one
+SELECT id FROM profiles WHERE id = ? FOR UPDATE
+`
+
+// Serializes uploads of one owner, so concurrent uploads can't overrun the
+// quota together.
+func (q *Queries) LockProfile(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, lockProfile, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const markArticleUpdatesChecked = `-- name: MarkArticleUpdatesChecked :exec
+UPDATE articles SET updates_checked_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+func (q *Queries) MarkArticleUpdatesChecked(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, markArticleUpdatesChecked, id)
+	return err
+}
+
+const moveAnnotationsToLibraryArticle = `-- name: MoveAnnotationsToLibraryArticle :exec
+UPDATE annotations SET library_article_id = ? WHERE library_article_id = ?
+`
+
+type MoveAnnotationsToLibraryArticleParams struct {
+	ToID   int64
+	FromID int64
+}
+
+func (q *Queries) MoveAnnotationsToLibraryArticle(ctx context.Context, arg MoveAnnotationsToLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveAnnotationsToLibraryArticle, arg.ToID, arg.FromID)
+	return err
+}
+
+const moveArticleAttachments = `-- name: MoveArticleAttachments :exec
+UPDATE attachments SET article_id = ? WHERE article_id = ?
+`
+
+type MoveArticleAttachmentsParams struct {
+	SurvivorID  sql.NullInt64
+	DuplicateID sql.NullInt64
+}
+
+func (q *Queries) MoveArticleAttachments(ctx context.Context, arg MoveArticleAttachmentsParams) error {
+	_, err := q.db.ExecContext(ctx, moveArticleAttachments, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const moveArticleReferences = `-- name: MoveArticleReferences :exec
+UPDATE article_references SET citing_article_id = ? WHERE citing_article_id = ?
+`
+
+type MoveArticleReferencesParams struct {
+	SurvivorID  int64
+	DuplicateID int64
+}
+
+func (q *Queries) MoveArticleReferences(ctx context.Context, arg MoveArticleReferencesParams) error {
+	_, err := q.db.ExecContext(ctx, moveArticleReferences, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const moveAttachmentsToLibraryArticle = `-- name: MoveAttachmentsToLibraryArticle :exec
+UPDATE attachments SET library_article_id = ? WHERE library_article_id = ?
+`
+
+type MoveAttachmentsToLibraryArticleParams struct {
+	ToID   sql.NullInt64
+	FromID sql.NullInt64
+}
+
+func (q *Queries) MoveAttachmentsToLibraryArticle(ctx context.Context, arg MoveAttachmentsToLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveAttachmentsToLibraryArticle, arg.ToID, arg.FromID)
+	return err
+}
+
+const moveLibraryArticle = `-- name: MoveLibraryArticle :exec
+UPDATE library_articles SET article_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+type MoveLibraryArticleParams struct {
+	ArticleID int64
+	ID        int64
+}
+
+func (q *Queries) MoveLibraryArticle(ctx context.Context, arg MoveLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveLibraryArticle, arg.ArticleID, arg.ID)
+	return err
+}
+
+const moveReadingEventsToArticle = `-- name: MoveReadingEventsToArticle :exec
+UPDATE reading_events SET article_id = ? WHERE article_id = ?
+`
+
+type MoveReadingEventsToArticleParams struct {
+	SurvivorID  int64
+	DuplicateID int64
+}
+
+func (q *Queries) MoveReadingEventsToArticle(ctx context.Context, arg MoveReadingEventsToArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveReadingEventsToArticle, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const moveReadingEventsToLibraryArticle = `-- name: MoveReadingEventsToLibraryArticle :exec
+UPDATE reading_events
+SET library_article_id = ?, article_id = ?
+WHERE library_article_id = ?
+`
+
+type MoveReadingEventsToLibraryArticleParams struct {
+	ToID      int64
+	ArticleID int64
+	FromID    int64
+}
+
+func (q *Queries) MoveReadingEventsToLibraryArticle(ctx context.Context, arg MoveReadingEventsToLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveReadingEventsToLibraryArticle, arg.ToID, arg.ArticleID, arg.FromID)
+	return err
+}
+
+const moveReviewScheduleToLibraryArticle = `-- name: MoveReviewScheduleToLibraryArticle :exec
+UPDATE IGNORE review_schedules SET library_article_id = ? WHERE library_article_id = ?
+`
+
+type MoveReviewScheduleToLibraryArticleParams struct {
+	ToID   int64
+	FromID int64
+}
+
+// MoveReviewScheduleToLibraryArticle keeps the target's schedule if it
+// already has one; the source schedule is then removed with its row.
+func (q *Queries) MoveReviewScheduleToLibraryArticle(ctx context.Context, arg MoveReviewScheduleToLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, moveReviewScheduleToLibraryArticle, arg.ToID, arg.FromID)
+	return err
+}
+
+const refreshArticleFlag = `-- name: RefreshArticleFlag :exec
+UPDATE articles
+SET flag = (SELECT COALESCE(MAX(u.flag), 0) FROM article_updates u WHERE u.article_id = ?)
+WHERE articles.id = ?
+`
+
+type RefreshArticleFlagParams struct {
+	ArticleID int64
+}
+
+func (q *Queries) RefreshArticleFlag(ctx context.Context, arg RefreshArticleFlagParams) error {
+	_, err := q.db.ExecContext(ctx, refreshArticleFlag, arg.ArticleID, arg.ArticleID)
+	return err
+}
+
+const releaseExpiredExtractions = `-- name: ReleaseExpiredExtractions :execrows
+UPDATE blobs SET extraction_status = 1, extraction_lease_until = NULL
+WHERE extraction_status = 2 AND extraction_lease_until < ?
+`
+
+// Extractions whose worker stopped before its lease ran out are picked up
+// again.
+func (q *Queries) ReleaseExpiredExtractions(ctx context.Context, extractionLeaseUntil sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, releaseExpiredExtractions, extractionLeaseUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const repointArticleRedirects = `-- name: RepointArticleRedirects :exec
+UPDATE article_redirects SET new_id = ? WHERE new_id = ?
+`
+
+type RepointArticleRedirectsParams struct {
+	SurvivorID  int64
+	DuplicateID int64
+}
+
+func (q *Queries) RepointArticleRedirects(ctx context.Context, arg RepointArticleRedirectsParams) error {
+	_, err := q.db.ExecContext(ctx, repointArticleRedirects, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const repointCitedReferences = `-- name: RepointCitedReferences :exec
+UPDATE article_references SET cited_article_id = ? WHERE cited_article_id = ?
+`
+
+type RepointCitedReferencesParams struct {
+	SurvivorID  sql.NullInt64
+	DuplicateID sql.NullInt64
+}
+
+func (q *Queries) RepointCitedReferences(ctx context.Context, arg RepointCitedReferencesParams) error {
+	_, err := q.db.ExecContext(ctx, repointCitedReferences, arg.SurvivorID, arg.DuplicateID)
+	return err
+}
+
+const resolveReferencesFromArticle = `-- name: ResolveReferencesFromArticle :exec
+UPDATE article_references r
+    JOIN articles a ON a.doi_normalized = r.cited_doi
+SET r.cited_article_id = a.id
+WHERE r.citing_article_id = ?
+`
+
+// ResolveReferencesFromArticle links the references of a newly stored
+// article to the local articles they cite.
+func (q *Queries) ResolveReferencesFromArticle(ctx context.Context, citingArticleID int64) error {
+	_, err := q.db.ExecContext(ctx, resolveReferencesFromArticle, citingArticleID)
+	return err
+}
+
+const resolveReferencesToArticle = `-- name: ResolveReferencesToArticle :exec
+UPDATE article_references
+SET cited_article_id = ?
+WHERE cited_doi = ? AND cited_article_id IS NULL
+`
+
+type ResolveReferencesToArticleParams struct {
+	ArticleID sql.NullInt64
+	Doi       sql.NullString
+}
+
+// ResolveReferencesToArticle links dangling references that cite a newly
+// stored article.
+func (q *Queries) ResolveReferencesToArticle(ctx context.Context, arg ResolveReferencesToArticleParams) error {
+	_, err := q.db.ExecContext(ctx, resolveReferencesToArticle, arg.ArticleID, arg.Doi)
+	return err
+}
+
+const retryBlobExtraction = `-- name: RetryBlobExtraction :execrows
+UPDATE blobs SET extraction_status = 1, extraction_error = NULL WHERE sha256 = ? AND extraction_status = 4
+`
+
+func (q *Queries) RetryBlobExtraction(ctx context.Context, sha256 string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryBlobExtraction, sha256)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeAccessToken = `-- name: RevokeAccessToken :execrows
+UPDATE access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE id = ? AND profile_id = ? AND revoked_at IS NULL
+`
+
+type RevokeAccessTokenParams struct {
+	ID        int64
+	ProfileID int64
+}
+
+func (q *Queries) RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeAccessToken, arg.ID, arg.ProfileID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const searchAnnotations = `-- name: SearchAnnotations :many
+SELECT
+    an.id, an.library_article_id, an.author_id, an.quote, an.comment, an.page, an.location, an.color, an.visibility, an.created_at, an.updated_at,
+    la.library_id,
+    la.article_id
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+WHERE an.author_id = ?
+  AND (? IS NULL OR la.library_id = ?)
+  AND (? IS NULL OR an.color = ?)
+  AND (? IS NULL OR an.quote LIKE ? OR an.comment LIKE ?)
+  AND (? IS NULL OR EXISTS (
+    SELECT 1 FROM annotation_tags ant JOIN tags t ON ant.tag_id = t.id
+    WHERE ant.annotation_id = an.id AND t.name = ?))
+  AND (? IS NULL OR an.id < ?)
+ORDER BY an.id DESC
+LIMIT ?
+`
+
+type SearchAnnotationsParams struct {
+	AuthorID  int64
+	LibraryID sql.NullInt64
+	Color     sql.NullInt16
+	Query     sql.NullString
+	Tag       sql.NullString
+	BeforeID  sql.NullInt64
+	Limit     int32
+}
+
+type SearchAnnotationsRow struct {
+	Annotation Annotation
+	LibraryID  int64
+	ArticleID  int64
+}
+
+func (q *Queries) SearchAnnotations(ctx context.Context, arg SearchAnnotationsParams) ([]SearchAnnotationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchAnnotations,
+		arg.AuthorID,
+		arg.LibraryID,
+		arg.LibraryID,
+		arg.Color,
+		arg.Color,
+		arg.Query,
+		arg.Query,
+		arg.Query,
+		arg.Tag,
+		arg.Tag,
+		arg.BeforeID,
+		arg.BeforeID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListArticleAuthorsByAuthorIDRow
+	var items []SearchAnnotationsRow
 	for rows.Next() {
-		var i ListArticleAuthorsByAuthorIDRow
+		var i SearchAnnotationsRow
 		if err := rows.Scan(
+			&i.Annotation.ID,
+			&i.Annotation.LibraryArticleID,
+			&i.Annotation.AuthorID,
+			&i.Annotation.Quote,
+			&i.Annotation.Comment,
+			&i.Annotation.Page,
+			&i.Annotation.Location,
+			&i.Annotation.Color,
+			&i.Annotation.Visibility,
+			&i.Annotation.CreatedAt,
+			&i.Annotation.UpdatedAt,
+			&i.LibraryID,
 			&i.ArticleID,
-			&i.AuthorOrder,
-			&i.ArticleTitle,
-			&i.Doi,
 		); err != nil {
 			return nil, err
 		}
@@ -536,12 +3965,27 @@ func (q *Queries) ListArticleAuthorsByAuthorID(ctx context.Context, authorID int
 	return items, nil
 }
 
-const listArticles = `-- name: ListArticles :many
-SELECT id, doi, title, abstract, url, publication_year, journal_name, created_at, updated_at FROM articles ORDER BY title
+const searchArticlesByMetadata = `-- name: SearchArticlesByMetadata :many
+SELECT id, doi, doi_normalized, title, abstract, url, publication_year, journal_name, flag, updates_checked_at, created_at, updated_at FROM articles
+WHERE title LIKE ? OR abstract LIKE ? OR doi = ?
+ORDER BY title
+LIMIT ?
 `
 
-func (q *Queries) ListArticles(ctx context.Context) ([]Article, error) {
-	rows, err := q.db.QueryContext(ctx, listArticles)
+type SearchArticlesByMetadataParams struct {
+	TitlePattern    string
+	AbstractPattern sql.NullString
+	Doi             string
+	Limit           int32
+}
+
+func (q *Queries) SearchArticlesByMetadata(ctx context.Context, arg SearchArticlesByMetadataParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, searchArticlesByMetadata,
+		arg.TitlePattern,
+		arg.AbstractPattern,
+		arg.Doi,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -552,11 +3996,14 @@ func (q *Queries) ListArticles(ctx context.Context) ([]Article, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.Doi,
+			&i.DoiNormalized,
 			&i.Title,
 			&i.Abstract,
 			&i.Url,
 			&i.PublicationYear,
 			&i.JournalName,
+			&i.Flag,
+			&i.UpdatesCheckedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -573,45 +4020,52 @@ func (q *Queries) ListArticles(ctx context.Context) ([]Article, error) {
 	return items, nil
 }
 
-const listArticlesWithAuthors = `-- name: ListArticlesWithAuthors :many
+const searchAttachmentPages = `-- name: SearchAttachmentPages :many
 SELECT
-    a.id, a.doi, a.title, a.abstract, a.url, a.publication_year, a.journal_name, a.created_at, a.updated_at,
-    au.id, au.name, au.profile_id, au.created_at, au.updated_at
-FROM articles a
-LEFT JOIN article_authors aa ON a.id = aa.article_id
-LEFT JOIN authors au ON aa.author_id = au.id
-ORDER BY a.title, aa.author_order
+    at.id AS attachment_id,
+    CAST(COALESCE(at.article_id, la.article_id) AS SIGNED) AS article_id,
+    p.page_number,
+    p.text
+FROM blob_pages p
+         JOIN attachments at ON at.sha256 = p.sha256
+         LEFT JOIN library_articles la ON at.library_article_id = la.id
+         LEFT JOIN library l ON la.library_id = l.id
+WHERE MATCH(p.text) AGAINST (?)
+  AND (at.article_id IS NOT NULL OR l.owner_id = ?)
+LIMIT ?
 `
 
-type ListArticlesWithAuthorsRow struct {
-	Article Article
-	Author  Author
+type SearchAttachmentPagesParams struct {
+	Query    string
+	ViewerID int64
+	Limit    int32
 }
 
-func (q *Queries) ListArticlesWithAuthors(ctx context.Context) ([]ListArticlesWithAuthorsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listArticlesWithAuthors)
+type SearchAttachmentPagesRow struct {
+	AttachmentID int64
+	ArticleID    int64
+	PageNumber   int32
+	Text         string
+}
+
+// Pages matching a full-text query in attachments the viewer can see:
+// everything attached to articles, and their own library attachments.
+// Without an ORDER BY, MATCH in the WHERE clause returns the most relevant
+// pages first.
+func (q *Queries) SearchAttachmentPages(ctx context.Context, arg SearchAttachmentPagesParams) ([]SearchAttachmentPagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchAttachmentPages, arg.Query, arg.ViewerID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListArticlesWithAuthorsRow
+	var items []SearchAttachmentPagesRow
 	for rows.Next() {
-		var i ListArticlesWithAuthorsRow
+		var i SearchAttachmentPagesRow
 		if err := rows.Scan(
-			&i.Article.ID,
-			&i.Article.Doi,
-			&i.Article.Title,
-			&i.Article.Abstract,
-			&i.Article.Url,
-			&i.Article.PublicationYear,
-			&i.Article.JournalName,
-			&i.Article.CreatedAt,
-			&i.Article.UpdatedAt,
-			&i.Author.ID,
-			&i.Author.Name,
-			&i.Author.ProfileID,
-			&i.Author.CreatedAt,
-			&i.Author.UpdatedAt,
+			&i.AttachmentID,
+			&i.ArticleID,
+			&i.PageNumber,
+			&i.Text,
 		); err != nil {
 			return nil, err
 		}
@@ -626,175 +4080,83 @@ func (q *Queries) ListArticlesWithAuthors(ctx context.Context) ([]ListArticlesWi
 	return items, nil
 }
 
-const listAuthors = `-- name: ListAuthors :many
-SELECT id, name, profile_id, created_at, updated_at FROM authors ORDER BY name
+const setArticleDOINormalized = `-- name: SetArticleDOINormalized :exec
+UPDATE articles SET doi_normalized = ? WHERE id = ?
 `
 
-func (q *Queries) ListAuthors(ctx context.Context) ([]Author, error) {
-	rows, err := q.db.QueryContext(ctx, listAuthors)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Author
-	for rows.Next() {
-		var i Author
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.ProfileID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type SetArticleDOINormalizedParams struct {
+	DoiNormalized string
+	ID            int64
 }
 
-const listLibrariesByUserID = `-- name: ListLibrariesByUserID :many
-SELECT id, owner_id, name, description, ispublic, isdefault, created_at, updated_at FROM library WHERE owner_id = ? ORDER BY created_at
+func (q *Queries) SetArticleDOINormalized(ctx context.Context, arg SetArticleDOINormalizedParams) error {
+	_, err := q.db.ExecContext(ctx, setArticleDOINormalized, arg.DoiNormalized, arg.ID)
+	return err
+}
+
+const sumAttachmentSizeForOwner = `-- name: SumAttachmentSizeForOwner :one
+SELECT CAST(COALESCE(SUM(size), 0) AS SIGNED) AS used_bytes FROM attachments WHERE owner_id = ?
 `
 
-func (q *Queries) ListLibrariesByUserID(ctx context.Context, ownerID int64) ([]Library, error) {
-	rows, err := q.db.QueryContext(ctx, listLibrariesByUserID, ownerID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Library
-	for rows.Next() {
-		var i Library
-		if err := rows.Scan(
-			&i.ID,
-			&i.OwnerID,
-			&i.Name,
-			&i.Description,
-			&i.Ispublic,
-			&i.Isdefault,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) SumAttachmentSizeForOwner(ctx context.Context, ownerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumAttachmentSizeForOwner, ownerID)
+	var used_bytes int64
+	err := row.Scan(&used_bytes)
+	return used_bytes, err
 }
 
-const listLibraryArticlesByLibraryID = `-- name: ListLibraryArticlesByLibraryID :many
-SELECT
-    la.id,
-    la.article_id,
-    la.reading_status,
-    la.dateAdded,
-    la.notes,
-    a.title AS article_title,
-    a.doi,
-    a.publication_year
-FROM library_articles la
-         JOIN articles a ON la.article_id = a.id
-WHERE la.library_id = ?
-ORDER BY la.dateAdded DESC
+const touchAccessToken = `-- name: TouchAccessToken :exec
+UPDATE access_tokens SET last_used_at = ?
+WHERE id = ? AND (last_used_at IS NULL OR last_used_at < ?)
 `
 
-type ListLibraryArticlesByLibraryIDRow struct {
-	ID              int64
-	ArticleID       int64
-	ReadingStatus   sql.NullInt16
-	Dateadded       sql.NullTime
-	Notes           sql.NullString
-	ArticleTitle    string
-	Doi             string
-	PublicationYear sql.NullInt32
+type TouchAccessTokenParams struct {
+	UsedAt      sql.NullTime
+	ID          int64
+	StaleBefore sql.NullTime
 }
 
-func (q *Queries) ListLibraryArticlesByLibraryID(ctx context.Context, libraryID int64) ([]ListLibraryArticlesByLibraryIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listLibraryArticlesByLibraryID, libraryID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []ListLibraryArticlesByLibraryIDRow
-	for rows.Next() {
-		var i ListLibraryArticlesByLibraryIDRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.ArticleID,
-			&i.ReadingStatus,
-			&i.Dateadded,
-			&i.Notes,
-			&i.ArticleTitle,
-			&i.Doi,
-			&i.PublicationYear,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) TouchAccessToken(ctx context.Context, arg TouchAccessTokenParams) error {
+	_, err := q.db.ExecContext(ctx, touchAccessToken, arg.UsedAt, arg.ID, arg.StaleBefore)
+	return err
 }
 
-const listProfiles = `-- name: ListProfiles :many
-SELECT id, user_id, name, bio, institution, created_at, updated_at FROM profiles ORDER BY name
+const updateAnnotation = `-- name: UpdateAnnotation :exec
+UPDATE annotations
+SET quote = ?, comment = ?, page = ?, location = ?, color = ?, visibility = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
 `
 
-func (q *Queries) ListProfiles(ctx context.Context) ([]Profile, error) {
-	rows, err := q.db.QueryContext(ctx, listProfiles)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Profile
-	for rows.Next() {
-		var i Profile
-		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.Name,
-			&i.Bio,
-			&i.Institution,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type UpdateAnnotationParams struct {
+	Quote      sql.NullString
+	Comment    sql.NullString
+	Page       sql.NullInt32
+	Location   sql.NullString
+	Color      int8
+	Visibility int8
+	ID         int64
+}
+
+func (q *Queries) UpdateAnnotation(ctx context.Context, arg UpdateAnnotationParams) error {
+	_, err := q.db.ExecContext(ctx, updateAnnotation,
+		arg.Quote,
+		arg.Comment,
+		arg.Page,
+		arg.Location,
+		arg.Color,
+		arg.Visibility,
+		arg.ID,
+	)
+	return err
 }
 
 const updateArticle = `-- name: UpdateArticle :exec
-UPDATE articles SET doi = ?, title = ?, abstract = ?, url = ?, publication_year = ?, journal_name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+UPDATE articles SET doi = ?, doi_normalized = ?, title = ?, abstract = ?, url = ?, publication_year = ?, journal_name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
 `
 
 type UpdateArticleParams struct {
 	Doi             string
+	DoiNormalized   string
 	Title           string
 	Abstract        sql.NullString
 	Url             sql.NullString
@@ -806,6 +4168,7 @@ type UpdateArticleParams struct {
 func (q *Queries) UpdateArticle(ctx context.Context, arg UpdateArticleParams) error {
 	_, err := q.db.ExecContext(ctx, updateArticle,
 		arg.Doi,
+		arg.DoiNormalized,
 		arg.Title,
 		arg.Abstract,
 		arg.Url,
@@ -831,6 +4194,29 @@ func (q *Queries) UpdateAuthor(ctx context.Context, arg UpdateAuthorParams) erro
 	return err
 }
 
+const updateGoal = `-- name: UpdateGoal :exec
+UPDATE reading_goals SET name = ?, target_count = ?, period = ?, due_date = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+type UpdateGoalParams struct {
+	Name        string
+	TargetCount sql.NullInt32
+	Period      sql.NullInt16
+	DueDate     sql.NullTime
+	ID          int64
+}
+
+func (q *Queries) UpdateGoal(ctx context.Context, arg UpdateGoalParams) error {
+	_, err := q.db.ExecContext(ctx, updateGoal,
+		arg.Name,
+		arg.TargetCount,
+		arg.Period,
+		arg.DueDate,
+		arg.ID,
+	)
+	return err
+}
+
 const updateLibrary = `-- name: UpdateLibrary :exec
 UPDATE library SET name = ?, description = ?, isPublic = ?, isDefault = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
 `
@@ -854,6 +4240,33 @@ func (q *Queries) UpdateLibrary(ctx context.Context, arg UpdateLibraryParams) er
 	return err
 }
 
+const updateLibraryArticle = `-- name: UpdateLibraryArticle :exec
+UPDATE library_articles
+SET reading_status = ?, reading_progress = ?, dateCompleted = ?, notes = ?, isFavorite = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateLibraryArticleParams struct {
+	ReadingStatus   sql.NullInt16
+	ReadingProgress sql.NullInt32
+	Datecompleted   sql.NullTime
+	Notes           sql.NullString
+	Isfavorite      sql.NullBool
+	ID              int64
+}
+
+func (q *Queries) UpdateLibraryArticle(ctx context.Context, arg UpdateLibraryArticleParams) error {
+	_, err := q.db.ExecContext(ctx, updateLibraryArticle,
+		arg.ReadingStatus,
+		arg.ReadingProgress,
+		arg.Datecompleted,
+		arg.Notes,
+		arg.Isfavorite,
+		arg.ID,
+	)
+	return err
+}
+
 const updateLibraryArticleNotes = `-- name: UpdateLibraryArticleNotes :exec
 UPDATE library_articles SET notes = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
 `
@@ -945,6 +4358,35 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) er
 	return err
 }
 
+const updateReviewSchedule = `-- name: UpdateReviewSchedule :exec
+UPDATE review_schedules
+SET ease_factor = ?, interval_days = ?, repetitions = ?, due_date = ?, last_reviewed_at = ?, last_rating = ?, updated_at = CURRENT_TIMESTAMP
+WHERE library_article_id = ?
+`
+
+type UpdateReviewScheduleParams struct {
+	EaseFactor       float64
+	IntervalDays     int32
+	Repetitions      int32
+	DueDate          time.Time
+	LastReviewedAt   sql.NullTime
+	LastRating       sql.NullInt16
+	LibraryArticleID int64
+}
+
+func (q *Queries) UpdateReviewSchedule(ctx context.Context, arg UpdateReviewScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, updateReviewSchedule,
+		arg.EaseFactor,
+		arg.IntervalDays,
+		arg.Repetitions,
+		arg.DueDate,
+		arg.LastReviewedAt,
+		arg.LastRating,
+		arg.LibraryArticleID,
+	)
+	return err
+}
+
 const updateSavedArticle = `-- name: UpdateSavedArticle :exec
 UPDATE library_articles SET reading_status = ?, notes = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
 `
@@ -959,3 +4401,44 @@ func (q *Queries) UpdateSavedArticle(ctx context.Context, arg UpdateSavedArticle
 	_, err := q.db.ExecContext(ctx, updateSavedArticle, arg.ReadingStatus, arg.Notes, arg.ID)
 	return err
 }
+
+const upsertArticleUpdate = `-- name: UpsertArticleUpdate :exec
+
+INSERT INTO article_updates (article_id, update_type, flag, notice_doi, label, updated_on)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE flag = VALUES(flag), label = VALUES(label), updated_on = VALUES(updated_on)
+`
+
+type UpsertArticleUpdateParams struct {
+	ArticleID  int64
+	UpdateType string
+	Flag       int8
+	NoticeDoi  string
+	Label      sql.NullString
+	UpdatedOn  sql.NullTime
+}
+
+// Correction and retraction notices
+func (q *Queries) UpsertArticleUpdate(ctx context.Context, arg UpsertArticleUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertArticleUpdate,
+		arg.ArticleID,
+		arg.UpdateType,
+		arg.Flag,
+		arg.NoticeDoi,
+		arg.Label,
+		arg.UpdatedOn,
+	)
+	return err
+}
+
+const upsertTag = `-- name: UpsertTag :execlastid
+INSERT INTO tags (name) VALUES (?) ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id)
+`
+
+func (q *Queries) UpsertTag(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertTag, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// This file is not generated by sqlc.
+
+const tracerName = "github.com/chiquitav2/journalful/internal/db"
+
+// NewTraced returns Queries that record a span for every query, named after
+// the query in query.sql. Use it with the pool or with a transaction.
+func NewTraced(conn DBTX) *Queries {
+	return New(tracedDB{conn: conn})
+}
+
+// tracedDB wraps a DBTX with a span per call. Spans cover running the
+// statement; rows are read after they end.
+type tracedDB struct {
+	conn DBTX
+}
+
+func (t tracedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startSpan(ctx, query)
+	defer span.End()
+	result, err := t.conn.ExecContext(ctx, query, args...)
+	recordError(span, err)
+	return result, err
+}
+
+func (t tracedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	ctx, span := startSpan(ctx, query)
+	defer span.End()
+	stmt, err := t.conn.PrepareContext(ctx, query)
+	recordError(span, err)
+	return stmt, err
+}
+
+func (t tracedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startSpan(ctx, query)
+	defer span.End()
+	rows, err := t.conn.QueryContext(ctx, query, args...)
+	recordError(span, err)
+	return rows, err
+}
+
+func (t tracedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := startSpan(ctx, query)
+	defer span.End()
+	row := t.conn.QueryRowContext(ctx, query, args...)
+	// sql.ErrNoRows only surfaces on Scan, so it is not recorded as a failure.
+	recordError(span, row.Err())
+	return row
+}
+
+func startSpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	name, text := splitQuery(query)
+	return otel.Tracer(tracerName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameMySQL,
+			semconv.DBQuerySummary(name),
+			semconv.DBQueryText(text),
+		),
+	)
+}
+
+// splitQuery takes the query name from the "-- name: GetArticle :one" line
+// sqlc puts in front of every query, and returns the statement without it.
+func splitQuery(query string) (string, string) {
+	header, text, ok := strings.Cut(query, "\n")
+	fields := strings.Fields(strings.TrimPrefix(header, "-- name:"))
+	if !ok || !strings.HasPrefix(header, "-- name:") || len(fields) == 0 {
+		return "query", query
+	}
+	return fields[0], strings.TrimSpace(text)
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
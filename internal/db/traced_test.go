@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// failingDB fails every statement.
+type failingDB struct {
+	DBTX
+}
+
+func (failingDB) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestSplitQuery(t *testing.T) {
+	name, text := splitQuery(deleteArticle)
+	assert.Equal(t, "DeleteArticle", name)
+	assert.Equal(t, "DELETE FROM articles WHERE id = ?", text)
+
+	name, text = splitQuery("SELECT 1")
+	assert.Equal(t, "query", name)
+	assert.Equal(t, "SELECT 1", text)
+}
+
+func TestTracedQueriesRecordSpans(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(provider) })
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	err := NewTraced(failingDB{}).DeleteArticle(context.Background(), 1)
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "DeleteArticle", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
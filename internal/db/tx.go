@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+)
+
+// This file is not generated by sqlc.
+
+// WithTx runs fn inside a transaction with traced Queries, committing when fn
+// succeeds and rolling back when it fails.
+func WithTx(ctx context.Context, conn *sql.DB, fn func(q *Queries) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(NewTraced(tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			slog.Error("failed to roll back transaction", "error", rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,76 @@
+// Package extraction pulls the text out of uploaded PDFs so it can be
+// searched page by page.
+package extraction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// extractPages returns the plain text of every page, in page order. Pages
+// without text, such as scans, come back empty. It stops between pages once
+// ctx is done.
+func extractPages(ctx context.Context, r io.ReaderAt, size int64) (pages []string, err error) {
+	// The PDF reader panics on some malformed files.
+	defer func() {
+		if p := recover(); p != nil {
+			pages, err = nil, fmt.Errorf("malformed pdf: %v", p)
+		}
+	}()
+
+	reader, err := pdf.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	fonts := make(map[string]*pdf.Font)
+	count := reader.NumPage()
+	pages = make([]string, count)
+	for i := 1; i <= count; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		for _, name := range page.Fonts() {
+			if _, ok := fonts[name]; !ok {
+				font := page.Font(name)
+				fonts[name] = &font
+			}
+		}
+		text, err := page.GetPlainText(fonts)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", i, err)
+		}
+		pages[i-1] = cleanText(text)
+	}
+	return pages, nil
+}
+
+// cleanText collapses runs of whitespace and drops control characters, which
+// PDFs use liberally for layout.
+func cleanText(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+		case unicode.IsControl(r) || r == unicode.ReplacementChar:
+		default:
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildPDF writes a minimal PDF with one page per text, using a standard
+// font so no font program needs to be embedded.
+func buildPDF(texts ...string) []byte {
+	var b bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	b.WriteString("%PDF-1.4\n")
+	kids := ""
+	for i := range texts {
+		kids += fmt.Sprintf("%d 0 R ", 4+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", kids, len(texts)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	for i, text := range texts {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i))
+		stream := fmt.Sprintf("BT /F1 12 Tf 72 720 Td (%s) Tj ET", text)
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream))
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return b.Bytes()
+}
+
+func TestExtractPages(t *testing.T) {
+	content := buildPDF("Attention is all you need", "Scaled dot-product attention")
+
+	pages, err := extractPages(context.Background(), bytes.NewReader(content), int64(len(content)))
+
+	assert.NoError(t, err)
+	assert.Len(t, pages, 2)
+	assert.Contains(t, pages[0], "Attention is all you need")
+	assert.Contains(t, pages[1], "Scaled dot-product attention")
+}
+
+func TestExtractPagesRejectsMalformedFiles(t *testing.T) {
+	content := []byte("%PDF-1.4\nthis is not really a pdf")
+	_, err := extractPages(context.Background(), bytes.NewReader(content), int64(len(content)))
+	assert.Error(t, err)
+}
+
+func TestExtractPagesStopsWhenCancelled(t *testing.T) {
+	content := buildPDF("Attention is all you need")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := extractPages(ctx, bytes.NewReader(content), int64(len(content)))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCleanText(t *testing.T) {
+	assert.Equal(t, "a b c", cleanText("  a\n\n b\t\x00c \r\n"))
+}
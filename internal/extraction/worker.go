@@ -0,0 +1,190 @@
+package extraction
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/storage"
+)
+
+const (
+	pollInterval   = 10 * time.Second
+	batchSize      = 5
+	maxErrorLength = 1024
+	// leaseDuration bounds how long a worker owns a claimed blob. Extraction
+	// is cancelled when the lease runs out, and the blob is released for
+	// another worker.
+	leaseDuration = 15 * time.Minute
+)
+
+// Worker extracts the text of pending PDF blobs in the background. Blobs are
+// claimed one at a time with a lease, so several servers can run workers
+// side by side and the blobs of a server that stopped are picked up again.
+type Worker struct {
+	conn    *sql.DB
+	queries *db.Queries
+	store   storage.BlobStore
+	now     func() time.Time
+}
+
+func NewWorker(conn *sql.DB, store storage.BlobStore) *Worker {
+	return &Worker{
+		conn:    conn,
+		queries: db.NewTraced(conn),
+		store:   store,
+		now:     time.Now,
+	}
+}
+
+// Run processes pending blobs until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		w.releaseExpired(ctx)
+		w.processPending(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// releaseExpired makes blobs claimed by workers that never finished pending
+// again. Blobs still within their lease are left to their worker.
+func (w *Worker) releaseExpired(ctx context.Context) {
+	released, err := w.queries.ReleaseExpiredExtractions(ctx, sql.NullTime{Time: w.now(), Valid: true})
+	if err != nil {
+		slog.Error("failed to release expired extractions", "error", err)
+		return
+	}
+	if released > 0 {
+		slog.Warn("released expired extractions", "blobs", released)
+	}
+}
+
+func (w *Worker) processPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		blobs, err := w.queries.ListBlobsPendingExtraction(ctx, batchSize)
+		if err != nil {
+			slog.Error("failed to list blobs pending extraction", "error", err)
+			return
+		}
+		if len(blobs) == 0 {
+			return
+		}
+		for _, blob := range blobs {
+			leaseUntil := w.now().Add(leaseDuration)
+			claimed, err := w.queries.ClaimBlobForExtraction(ctx, db.ClaimBlobForExtractionParams{
+				ExtractionLeaseUntil: sql.NullTime{Time: leaseUntil, Valid: true},
+				Sha256:               blob.Sha256,
+			})
+			if err != nil {
+				slog.Error("failed to claim blob for extraction", "sha256", blob.Sha256, "error", err)
+				return
+			}
+			if claimed == 0 {
+				continue // another worker got there first
+			}
+			w.processLeased(ctx, blob.Sha256, leaseUntil)
+		}
+	}
+}
+
+// processLeased extracts a claimed blob, giving up when the lease runs out.
+// A timeout is recorded as a failure rather than retried, since the same
+// file would run out of time again.
+func (w *Worker) processLeased(ctx context.Context, hash string, leaseUntil time.Time) {
+	extractCtx, cancel := context.WithDeadline(ctx, leaseUntil)
+	defer cancel()
+	pages, err := w.extract(extractCtx, hash)
+	w.record(ctx, hash, pages, err)
+}
+
+// record stores the extracted pages, or the reason extraction failed.
+func (w *Worker) record(ctx context.Context, hash string, pages []string, err error) {
+	if err != nil {
+		slog.Warn("text extraction failed", "sha256", hash, "error", err)
+		if err := w.queries.FailBlobExtraction(ctx, db.FailBlobExtractionParams{
+			ExtractionError: sql.NullString{String: truncate(err.Error(), maxErrorLength), Valid: true},
+			Sha256:          hash,
+		}); err != nil {
+			slog.Error("failed to record extraction failure", "sha256", hash, "error", err)
+		}
+		return
+	}
+
+	if err := w.savePages(ctx, hash, pages); err != nil {
+		slog.Error("failed to save extracted text", "sha256", hash, "error", err)
+		if err := w.queries.FailBlobExtraction(ctx, db.FailBlobExtractionParams{
+			ExtractionError: sql.NullString{String: "failed to save extracted text", Valid: true},
+			Sha256:          hash,
+		}); err != nil {
+			slog.Error("failed to record extraction failure", "sha256", hash, "error", err)
+		}
+		return
+	}
+	slog.Info("extracted text", "sha256", hash, "pages", len(pages))
+}
+
+// extract copies the blob to a temporary file, since the PDF reader needs
+// random access and the blob store may be remote.
+func (w *Worker) extract(ctx context.Context, hash string) ([]string, error) {
+	r, err := w.store.Open(ctx, storage.ContentKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	tmp, err := os.CreateTemp("", "journalful-extract-*.pdf")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return nil, err
+	}
+	return extractPages(ctx, tmp, size)
+}
+
+func (w *Worker) savePages(ctx context.Context, hash string, pages []string) error {
+	return db.WithTx(ctx, w.conn, func(q *db.Queries) error {
+		if err := q.DeleteBlobPages(ctx, hash); err != nil {
+			return err
+		}
+		for i, text := range pages {
+			if text == "" {
+				continue
+			}
+			if err := q.CreateBlobPage(ctx, db.CreateBlobPageParams{Sha256: hash, PageNumber: int32(i + 1), Text: text}); err != nil {
+				return err
+			}
+		}
+		return q.CompleteBlobExtraction(ctx, db.CompleteBlobExtractionParams{
+			PageCount: sql.NullInt32{Int32: int32(len(pages)), Valid: true},
+			Sha256:    hash,
+		})
+	})
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 sequence.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
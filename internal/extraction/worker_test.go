@@ -0,0 +1,79 @@
+package extraction
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateKeepsRunesWhole(t *testing.T) {
+	assert.Equal(t, "short", truncate("short", 10))
+	assert.Equal(t, "ab", truncate("abc", 2))
+
+	// "é" takes two bytes, so cutting after three bytes would split it.
+	truncated := truncate("abé", 3)
+	assert.Equal(t, "ab", truncated)
+
+	long := strings.Repeat("ü", maxErrorLength)
+	truncated = truncate(long, maxErrorLength+1)
+	assert.True(t, utf8.ValidString(truncated))
+	assert.Len(t, truncated, maxErrorLength)
+}
+
+func TestWorkerReleasesOnlyExpiredLeases(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	w := NewWorker(conn, nil)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	w.releaseExpired(context.Background())
+
+	calls := fake.Calls("ReleaseExpiredExtractions")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, dbtest.Values(sql.NullTime{Time: now, Valid: true}), calls[0].Args)
+	}
+}
+
+// missingStore has no blobs.
+type missingStore struct {
+	storage.BlobStore
+}
+
+func (missingStore) Open(context.Context, string) (io.ReadCloser, error) {
+	return nil, storage.ErrNotFound
+}
+
+func TestWorkerClaimsWithLease(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	w := NewWorker(conn, missingStore{})
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	hash := strings.Repeat("ab", 32)
+	fake.ReturnOnce("ListBlobsPendingExtraction", db.ListBlobsPendingExtractionRow{Sha256: hash})
+
+	w.processPending(context.Background())
+
+	calls := fake.Calls("ClaimBlobForExtraction")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, dbtest.Values(db.ClaimBlobForExtractionParams{
+			ExtractionLeaseUntil: sql.NullTime{Time: now.Add(leaseDuration), Valid: true},
+			Sha256:               hash,
+		}), calls[0].Args)
+	}
+	failed := fake.Calls("FailBlobExtraction")
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, dbtest.Values(db.FailBlobExtractionParams{
+			ExtractionError: sql.NullString{String: storage.ErrNotFound.Error(), Valid: true},
+			Sha256:          hash,
+		}), failed[0].Args)
+	}
+}
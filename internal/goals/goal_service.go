@@ -0,0 +1,252 @@
+package goals
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	internalstats "github.com/chiquitav2/journalful/internal/stats"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GoalServiceInterface interface {
+	CreateGoal(ctx context.Context, request *goals.CreateGoalRequest) (*goals.CreateGoalResponse, error)
+	GetGoal(ctx context.Context, request *goals.GetGoalRequest) (*goals.GetGoalResponse, error)
+	ListGoals(ctx context.Context, request *goals.ListGoalsRequest) (*goals.ListGoalsResponse, error)
+	UpdateGoal(ctx context.Context, request *goals.UpdateGoalRequest) (*goals.UpdateGoalResponse, error)
+	DeleteGoal(ctx context.Context, request *goals.DeleteGoalRequest) (*goals.DeleteGoalResponse, error)
+	GetGoalProgress(ctx context.Context, request *goals.GetGoalProgressRequest) (*goals.GetGoalProgressResponse, error)
+}
+
+type GoalService struct {
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewGoalService(conn *sql.DB) *GoalService {
+	return &GoalService{
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+func (s *GoalService) CreateGoal(ctx context.Context, request *goals.CreateGoalRequest) (*goals.CreateGoalResponse, error) {
+	params := db.CreateGoalParams{
+		ProfileID: request.UserId,
+		Name:      request.Name,
+		GoalType:  int8(request.GoalType),
+	}
+	switch request.GoalType {
+	case goals.GoalType_GOAL_TYPE_READ_COUNT:
+		if err := validateReadCount(request.TargetCount, request.Period); err != nil {
+			return nil, err
+		}
+		params.TargetCount = sql.NullInt32{Int32: request.TargetCount, Valid: true}
+		params.Period = sql.NullInt16{Int16: int16(request.Period), Valid: true}
+	case goals.GoalType_GOAL_TYPE_FINISH_LIBRARY:
+		if request.LibraryId == nil || request.DueDate == nil {
+			return nil, status.Error(codes.InvalidArgument, "library goals need a library and a due date")
+		}
+		lib, err := s.queries.GetLibrary(ctx, *request.LibraryId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, status.Error(codes.NotFound, "library not found")
+			}
+			slog.Error("failed to get library", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get library")
+		}
+		if lib.OwnerID != request.UserId {
+			return nil, status.Error(codes.InvalidArgument, "library goals can only track your own libraries")
+		}
+		params.LibraryID = sql.NullInt64{Int64: lib.ID, Valid: true}
+		params.DueDate = sql.NullTime{Time: request.DueDate.AsTime(), Valid: true}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown goal type")
+	}
+
+	result, err := s.queries.CreateGoal(ctx, params)
+	if err != nil {
+		slog.Error("failed to create goal", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create goal")
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		slog.Error("failed to get last insert ID", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get last insert ID")
+	}
+
+	return &goals.CreateGoalResponse{Id: id}, nil
+}
+
+// getGoal loads one of the caller's goals. Other users' goals are not found,
+// except by admins.
+func (s *GoalService) getGoal(ctx context.Context, id int64) (db.ReadingGoal, error) {
+	goal, err := s.queries.GetGoal(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return goal, status.Error(codes.NotFound, "goal not found")
+		}
+		slog.Error("failed to get goal", "error", err)
+		return goal, status.Error(codes.Internal, "failed to get goal")
+	}
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if profileID, _ := auth.ProfileID(ctx); goal.ProfileID != profileID && !principal.IsAdmin() {
+		return goal, status.Error(codes.NotFound, "goal not found")
+	}
+	return goal, nil
+}
+
+func (s *GoalService) GetGoal(ctx context.Context, request *goals.GetGoalRequest) (*goals.GetGoalResponse, error) {
+	goal, err := s.getGoal(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &goals.GetGoalResponse{Goal: dbToGrpcGoal(goal)}, nil
+}
+
+func (s *GoalService) ListGoals(ctx context.Context, request *goals.ListGoalsRequest) (*goals.ListGoalsResponse, error) {
+	rows, err := s.queries.ListGoalsByProfileID(ctx, request.UserId)
+	if err != nil {
+		slog.Error("failed to list goals", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list goals")
+	}
+	grpcGoals := make([]*goals.Goal, len(rows))
+	for i, row := range rows {
+		grpcGoals[i] = dbToGrpcGoal(row)
+	}
+	return &goals.ListGoalsResponse{Goals: grpcGoals}, nil
+}
+
+func (s *GoalService) UpdateGoal(ctx context.Context, request *goals.UpdateGoalRequest) (*goals.UpdateGoalResponse, error) {
+	goal, err := s.getGoal(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateGoalParams{
+		ID:          goal.ID,
+		Name:        goal.Name,
+		TargetCount: goal.TargetCount,
+		Period:      goal.Period,
+		DueDate:     goal.DueDate,
+	}
+	if request.Name != nil {
+		params.Name = *request.Name
+	}
+	switch goals.GoalType(goal.GoalType) {
+	case goals.GoalType_GOAL_TYPE_READ_COUNT:
+		if request.TargetCount != nil {
+			params.TargetCount = sql.NullInt32{Int32: *request.TargetCount, Valid: true}
+		}
+		if request.Period != goals.GoalPeriod_GOAL_PERIOD_UNSPECIFIED {
+			params.Period = sql.NullInt16{Int16: int16(request.Period), Valid: true}
+		}
+		if err := validateReadCount(params.TargetCount.Int32, goals.GoalPeriod(params.Period.Int16)); err != nil {
+			return nil, err
+		}
+	case goals.GoalType_GOAL_TYPE_FINISH_LIBRARY:
+		if request.DueDate != nil {
+			params.DueDate = sql.NullTime{Time: request.DueDate.AsTime(), Valid: true}
+		}
+	}
+
+	if err := s.queries.UpdateGoal(ctx, params); err != nil {
+		slog.Error("failed to update goal", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update goal")
+	}
+
+	goal, err = s.getGoal(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &goals.UpdateGoalResponse{Goal: dbToGrpcGoal(goal)}, nil
+}
+
+func (s *GoalService) DeleteGoal(ctx context.Context, request *goals.DeleteGoalRequest) (*goals.DeleteGoalResponse, error) {
+	goal, err := s.getGoal(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queries.DeleteGoal(ctx, goal.ID); err != nil {
+		slog.Error("failed to delete goal", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete goal")
+	}
+	return &goals.DeleteGoalResponse{Success: true}, nil
+}
+
+func (s *GoalService) GetGoalProgress(ctx context.Context, request *goals.GetGoalProgressRequest) (*goals.GetGoalProgressResponse, error) {
+	loc, err := internalstats.LoadLocation(request.TimeZone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", request.TimeZone)
+	}
+
+	goal, err := s.getGoal(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.queries.ListLibraryArticlesForOwner(ctx, goal.ProfileID)
+	if err != nil {
+		slog.Error("failed to list library articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list library articles")
+	}
+
+	events, err := s.queries.ListReadingEventTimesForOwner(ctx, goal.ProfileID)
+	if err != nil {
+		slog.Error("failed to list reading events", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reading events")
+	}
+	dates := internalstats.NewEntryDates(events, loc)
+
+	today := internalstats.Today(s.now(), loc)
+	grpcGoal := dbToGrpcGoal(goal)
+
+	var progress *goals.GoalProgress
+	switch grpcGoal.GoalType {
+	case goals.GoalType_GOAL_TYPE_READ_COUNT:
+		progress = evaluateReadCount(grpcGoal, articles, dates, today)
+	case goals.GoalType_GOAL_TYPE_FINISH_LIBRARY:
+		progress = evaluateFinishLibrary(grpcGoal, articles, dates, today)
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "goal has an unknown type")
+	}
+
+	return &goals.GetGoalProgressResponse{Progress: progress}, nil
+}
+
+func validateReadCount(targetCount int32, period goals.GoalPeriod) error {
+	if targetCount <= 0 {
+		return status.Error(codes.InvalidArgument, "target count must be positive")
+	}
+	if _, ok := goals.GoalPeriod_name[int32(period)]; !ok || period == goals.GoalPeriod_GOAL_PERIOD_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "a goal period is required")
+	}
+	return nil
+}
+
+func dbToGrpcGoal(goal db.ReadingGoal) *goals.Goal {
+	grpcGoal := &goals.Goal{
+		Id:          goal.ID,
+		UserId:      goal.ProfileID,
+		Name:        goal.Name,
+		GoalType:    goals.GoalType(goal.GoalType),
+		TargetCount: goal.TargetCount.Int32,
+		Period:      goals.GoalPeriod(goal.Period.Int16),
+		CreatedAt:   timestamppb.New(goal.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(goal.UpdatedAt.Time),
+	}
+	if goal.LibraryID.Valid {
+		grpcGoal.LibraryId = &goal.LibraryID.Int64
+	}
+	if goal.DueDate.Valid {
+		grpcGoal.DueDate = timestamppb.New(goal.DueDate.Time)
+	}
+	return grpcGoal
+}
@@ -0,0 +1,37 @@
+package goals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoalsOfOtherUsersAreNotFound(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := NewGoalService(conn)
+	fake.Return("GetGoal", db.ReadingGoal{ID: 1, ProfileID: 7, Name: "Read more", GoalType: int8(goals.GoalType_GOAL_TYPE_READ_COUNT)})
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "u", ProfileID: 8})
+
+	_, err := s.GetGoal(ctx, &goals.GetGoalRequest{Id: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.UpdateGoal(ctx, &goals.UpdateGoalRequest{Id: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.GetGoalProgress(ctx, &goals.GetGoalProgressRequest{Id: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteGoal(ctx, &goals.DeleteGoalRequest{Id: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, fake.Calls("UpdateGoal"))
+	assert.Empty(t, fake.Calls("DeleteGoal"))
+
+	owner := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "o", ProfileID: 7})
+	_, err = s.DeleteGoal(owner, &goals.DeleteGoalRequest{Id: 1})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("DeleteGoal"), 1)
+}
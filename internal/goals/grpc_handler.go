@@ -0,0 +1,43 @@
+package goals
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+)
+
+type GrpcHandler struct {
+	goals.UnimplementedGoalServiceServer
+	service GoalServiceInterface
+}
+
+func NewGoalGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewGoalService(conn),
+	}
+}
+
+func (h *GrpcHandler) CreateGoal(ctx context.Context, request *goals.CreateGoalRequest) (*goals.CreateGoalResponse, error) {
+	return h.service.CreateGoal(ctx, request)
+}
+
+func (h *GrpcHandler) GetGoal(ctx context.Context, request *goals.GetGoalRequest) (*goals.GetGoalResponse, error) {
+	return h.service.GetGoal(ctx, request)
+}
+
+func (h *GrpcHandler) ListGoals(ctx context.Context, request *goals.ListGoalsRequest) (*goals.ListGoalsResponse, error) {
+	return h.service.ListGoals(ctx, request)
+}
+
+func (h *GrpcHandler) UpdateGoal(ctx context.Context, request *goals.UpdateGoalRequest) (*goals.UpdateGoalResponse, error) {
+	return h.service.UpdateGoal(ctx, request)
+}
+
+func (h *GrpcHandler) DeleteGoal(ctx context.Context, request *goals.DeleteGoalRequest) (*goals.DeleteGoalResponse, error) {
+	return h.service.DeleteGoal(ctx, request)
+}
+
+func (h *GrpcHandler) GetGoalProgress(ctx context.Context, request *goals.GetGoalProgressRequest) (*goals.GetGoalProgressResponse, error) {
+	return h.service.GetGoalProgress(ctx, request)
+}
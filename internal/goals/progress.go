@@ -0,0 +1,206 @@
+package goals
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	internalstats "github.com/chiquitav2/journalful/internal/stats"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// periodStart returns the first day of the period containing day.
+func periodStart(period goals.GoalPeriod, day time.Time) time.Time {
+	switch period {
+	case goals.GoalPeriod_GOAL_PERIOD_WEEK:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case goals.GoalPeriod_GOAL_PERIOD_MONTH:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	case goals.GoalPeriod_GOAL_PERIOD_YEAR:
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+	default:
+		return day
+	}
+}
+
+// periodEnd returns the first day after the period starting at start.
+func periodEnd(period goals.GoalPeriod, start time.Time) time.Time {
+	switch period {
+	case goals.GoalPeriod_GOAL_PERIOD_WEEK:
+		return start.AddDate(0, 0, 7)
+	case goals.GoalPeriod_GOAL_PERIOD_MONTH:
+		return start.AddDate(0, 1, 0)
+	case goals.GoalPeriod_GOAL_PERIOD_YEAR:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// periodStreaks counts runs of consecutive periods with at least target
+// completions. counts is keyed by period start. The current streak ends with
+// the period starting at current, or the one before if it isn't met yet.
+func periodStreaks(counts map[time.Time]int, target int, period goals.GoalPeriod, current time.Time) (currentStreak, longestStreak int) {
+	var met []time.Time
+	for start, count := range counts {
+		if count >= target {
+			met = append(met, start)
+		}
+	}
+	sort.Slice(met, func(i, j int) bool { return met[i].Before(met[j]) })
+
+	run := 0
+	for i, start := range met {
+		if i > 0 && periodEnd(period, met[i-1]).Equal(start) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longestStreak {
+			longestStreak = run
+		}
+	}
+
+	previous := func(start time.Time) time.Time {
+		return periodStart(period, start.AddDate(0, 0, -1))
+	}
+	p := current
+	if counts[p] < target {
+		p = previous(p)
+	}
+	for counts[p] >= target {
+		currentStreak++
+		p = previous(p)
+	}
+	return currentStreak, longestStreak
+}
+
+// evaluateReadCount measures a "read N articles per period" goal against the
+// period containing today.
+func evaluateReadCount(goal *goals.Goal, articles []db.ListLibraryArticlesForOwnerRow, dates internalstats.EntryDates, today time.Time) *goals.GoalProgress {
+	counts := make(map[time.Time]int)
+	for _, a := range articles {
+		if completed, ok := dates.Completed(a); ok {
+			counts[periodStart(goal.Period, completed)]++
+		}
+	}
+
+	start := periodStart(goal.Period, today)
+	end := periodEnd(goal.Period, start)
+	completed := counts[start]
+	target := int(goal.TargetCount)
+
+	fraction := float64(internalstats.DaysBetween(start, today)+1) / float64(internalstats.DaysBetween(start, end))
+	expected := float64(target) * fraction
+	projected := float64(completed) / fraction
+
+	progressStatus := goals.GoalStatus_GOAL_STATUS_BEHIND
+	switch {
+	case completed >= target:
+		progressStatus = goals.GoalStatus_GOAL_STATUS_ACHIEVED
+	case projected >= float64(target):
+		progressStatus = goals.GoalStatus_GOAL_STATUS_ON_TRACK
+	}
+
+	current, longest := periodStreaks(counts, target, goal.Period, start)
+
+	return &goals.GoalProgress{
+		Goal:           goal,
+		Status:         progressStatus,
+		CompletedCount: int32(completed),
+		TargetCount:    int32(target),
+		ExpectedCount:  expected,
+		ProjectedCount: projected,
+		PeriodStart:    timestamppb.New(start),
+		PeriodEnd:      timestamppb.New(end),
+		DaysRemaining:  int32(internalstats.DaysBetween(today, end)),
+		CurrentStreak:  int32(current),
+		LongestStreak:  int32(longest),
+	}
+}
+
+// evaluateFinishLibrary measures a "finish library X by date D" goal over the
+// time between creating the goal and its due date. Abandoned articles don't
+// count towards the target.
+func evaluateFinishLibrary(goal *goals.Goal, articles []db.ListLibraryArticlesForOwnerRow, dates internalstats.EntryDates, today time.Time) *goals.GoalProgress {
+	loc := today.Location()
+	start := internalstats.DateIn(goal.CreatedAt.AsTime().In(loc), loc)
+	end := internalstats.DateIn(goal.DueDate.AsTime(), loc).AddDate(0, 0, 1)
+
+	var target, completed, completedSinceStart int
+	counts := make(map[time.Time]int)
+	for _, a := range articles {
+		if a.LibraryID != goal.GetLibraryId() {
+			continue
+		}
+		switch library.ReadingStatus(a.ReadingStatus.Int16) {
+		case library.ReadingStatus_READING_STATUS_ABANDONED:
+			continue
+		case library.ReadingStatus_READING_STATUS_READ:
+			completed++
+			if day, ok := dates.Completed(a); ok {
+				counts[day]++
+				if !day.Before(start) {
+					completedSinceStart++
+				}
+			}
+		}
+		target++
+	}
+
+	totalDays := internalstats.DaysBetween(start, end)
+	if totalDays < 1 {
+		totalDays = 1
+	}
+	elapsedDays := internalstats.DaysBetween(start, today) + 1
+	if elapsedDays > totalDays {
+		elapsedDays = totalDays
+	}
+	if elapsedDays < 1 {
+		elapsedDays = 1
+	}
+	expected := float64(target) * float64(elapsedDays) / float64(totalDays)
+	rate := float64(completedSinceStart) / float64(elapsedDays)
+	projected := float64(completed) + rate*float64(totalDays-elapsedDays)
+
+	daysRemaining := internalstats.DaysBetween(today, end)
+	if daysRemaining < 0 {
+		daysRemaining = 0
+	}
+
+	progressStatus := goals.GoalStatus_GOAL_STATUS_BEHIND
+	switch {
+	case target > 0 && completed >= target:
+		progressStatus = goals.GoalStatus_GOAL_STATUS_ACHIEVED
+	case !today.Before(end):
+		progressStatus = goals.GoalStatus_GOAL_STATUS_MISSED
+	case projected >= float64(target):
+		progressStatus = goals.GoalStatus_GOAL_STATUS_ON_TRACK
+	}
+
+	current, longest := internalstats.Streaks(keys(counts), today)
+
+	return &goals.GoalProgress{
+		Goal:           goal,
+		Status:         progressStatus,
+		CompletedCount: int32(completed),
+		TargetCount:    int32(target),
+		ExpectedCount:  expected,
+		ProjectedCount: projected,
+		PeriodStart:    timestamppb.New(start),
+		PeriodEnd:      timestamppb.New(end),
+		DaysRemaining:  int32(daysRemaining),
+		CurrentStreak:  int32(current),
+		LongestStreak:  int32(longest),
+	}
+}
+
+func keys(m map[time.Time]int) []time.Time {
+	days := make([]time.Time, 0, len(m))
+	for day := range m {
+		days = append(days, day)
+	}
+	return days
+}
@@ -0,0 +1,109 @@
+package goals
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	internalstats "github.com/chiquitav2/journalful/internal/stats"
+	"github.com/chiquitav2/journalful/pkg/goals/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// noEvents leaves the dates to the library article columns.
+var noEvents = internalstats.NewEntryDates(nil, time.UTC)
+
+func readOn(libraryID int64, completed time.Time) db.ListLibraryArticlesForOwnerRow {
+	return db.ListLibraryArticlesForOwnerRow{
+		LibraryID:     libraryID,
+		ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true},
+		Datecompleted: sql.NullTime{Time: completed, Valid: true},
+	}
+}
+
+func TestEvaluateReadCountWeekly(t *testing.T) {
+	goal := &goals.Goal{
+		GoalType:    goals.GoalType_GOAL_TYPE_READ_COUNT,
+		TargetCount: 2,
+		Period:      goals.GoalPeriod_GOAL_PERIOD_WEEK,
+	}
+	articles := []db.ListLibraryArticlesForOwnerRow{
+		// Two weeks in a row met the target before the current week.
+		readOn(1, date(2025, 2, 24)), readOn(1, date(2025, 2, 26)),
+		readOn(1, date(2025, 3, 3)), readOn(1, date(2025, 3, 7)),
+		readOn(1, date(2025, 3, 10)),
+	}
+
+	// Wednesday: one article read, three of seven days elapsed and five left
+	// counting today.
+	progress := evaluateReadCount(goal, articles, noEvents, date(2025, 3, 12))
+
+	assert.Equal(t, int32(1), progress.CompletedCount)
+	assert.Equal(t, date(2025, 3, 10), progress.PeriodStart.AsTime())
+	assert.Equal(t, int32(5), progress.DaysRemaining)
+	assert.InDelta(t, 2.0*3/7, progress.ExpectedCount, 1e-9)
+	assert.Equal(t, goals.GoalStatus_GOAL_STATUS_ON_TRACK, progress.Status)
+	assert.Equal(t, int32(2), progress.CurrentStreak)
+	assert.Equal(t, int32(2), progress.LongestStreak)
+
+	// By Sunday the pace is no longer enough.
+	progress = evaluateReadCount(goal, articles, noEvents, date(2025, 3, 16))
+	assert.Equal(t, goals.GoalStatus_GOAL_STATUS_BEHIND, progress.Status)
+}
+
+func TestEvaluateReadCountUsesReaderTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	goal := &goals.Goal{
+		GoalType:    goals.GoalType_GOAL_TYPE_READ_COUNT,
+		TargetCount: 1,
+		Period:      goals.GoalPeriod_GOAL_PERIOD_WEEK,
+	}
+	entry := readOn(1, date(2025, 3, 10))
+	entry.ID = 4
+	// Stored as Monday in UTC, but it was still Sunday evening in New York.
+	dates := internalstats.NewEntryDates([]db.ListReadingEventTimesForOwnerRow{{
+		LibraryArticleID: 4,
+		EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED),
+		ToStatus:         sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true},
+		OccurredAt:       sql.NullTime{Time: time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC), Valid: true},
+	}}, loc)
+
+	progress := evaluateReadCount(goal, []db.ListLibraryArticlesForOwnerRow{entry}, dates, time.Date(2025, 3, 12, 0, 0, 0, 0, loc))
+	assert.Equal(t, int32(0), progress.CompletedCount)
+	assert.Equal(t, int32(1), progress.CurrentStreak, "last week's target was met")
+}
+
+func TestEvaluateFinishLibrary(t *testing.T) {
+	libraryID := int64(3)
+	goal := &goals.Goal{
+		GoalType:  goals.GoalType_GOAL_TYPE_FINISH_LIBRARY,
+		LibraryId: &libraryID,
+		CreatedAt: timestamppb.New(date(2025, 3, 1)),
+		DueDate:   timestamppb.New(date(2025, 3, 10)),
+	}
+	articles := []db.ListLibraryArticlesForOwnerRow{
+		readOn(libraryID, date(2025, 3, 2)),
+		readOn(libraryID, date(2025, 3, 3)),
+		{LibraryID: libraryID, ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READING), Valid: true}},
+		{LibraryID: libraryID, ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_ABANDONED), Valid: true}},
+		readOn(99, date(2025, 3, 3)),
+	}
+
+	progress := evaluateFinishLibrary(goal, articles, noEvents, date(2025, 3, 3))
+	assert.Equal(t, int32(3), progress.TargetCount)
+	assert.Equal(t, int32(2), progress.CompletedCount)
+	assert.Equal(t, goals.GoalStatus_GOAL_STATUS_ON_TRACK, progress.Status)
+	assert.Equal(t, int32(2), progress.CurrentStreak)
+
+	progress = evaluateFinishLibrary(goal, articles, noEvents, date(2025, 3, 11))
+	assert.Equal(t, goals.GoalStatus_GOAL_STATUS_MISSED, progress.Status)
+	assert.Equal(t, int32(0), progress.DaysRemaining)
+}
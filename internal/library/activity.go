@@ -0,0 +1,99 @@
+package library
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListReadingActivity returns a user's reading events, newest first. With a
+// library id it doubles as that library's activity feed.
+func (s *LibraryService) ListReadingActivity(ctx context.Context, request *library.ListReadingActivityRequest) (*library.ListReadingActivityResponse, error) {
+	pageSize := normalizePageSize(request.PageSize)
+
+	params := db.ListReadingEventsParams{
+		ProfileID: request.UserId,
+		Limit:     pageSize + 1,
+	}
+	if request.LibraryId != nil {
+		params.LibraryID = sql.NullInt64{Int64: *request.LibraryId, Valid: true}
+	}
+	if request.StartTime != nil {
+		params.StartTime = sql.NullTime{Time: request.StartTime.AsTime(), Valid: true}
+	}
+	if request.EndTime != nil {
+		params.EndTime = sql.NullTime{Time: request.EndTime.AsTime(), Valid: true}
+	}
+	if request.PageToken != "" {
+		beforeID, err := utils.DecodeCursor(request.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		params.BeforeID = sql.NullInt64{Int64: beforeID, Valid: true}
+	}
+
+	eventTypes := request.EventTypes
+	if len(eventTypes) == 0 {
+		for value := range library.ReadingEventType_name {
+			if value != int32(library.ReadingEventType_READING_EVENT_TYPE_UNSPECIFIED) {
+				eventTypes = append(eventTypes, library.ReadingEventType(value))
+			}
+		}
+	}
+	for _, eventType := range eventTypes {
+		params.EventTypes = append(params.EventTypes, int8(eventType))
+	}
+
+	rows, err := s.repo.ListReadingEvents(ctx, params)
+	if err != nil {
+		slog.Error("failed to list reading events", "error", err)
+		return nil, err
+	}
+
+	var nextPageToken string
+	if int32(len(rows)) > pageSize {
+		rows = rows[:pageSize]
+		nextPageToken = utils.EncodeCursor(rows[len(rows)-1].ID)
+	}
+
+	events := make([]*library.ReadingEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, readingEventToGrpc(row))
+	}
+
+	return &library.ListReadingActivityResponse{
+		Events:        events,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func readingEventToGrpc(row db.ListReadingEventsRow) *library.ReadingEvent {
+	event := &library.ReadingEvent{
+		Id:               row.ID,
+		LibraryId:        row.LibraryID,
+		ArticleId:        row.ArticleID,
+		LibraryArticleId: row.LibraryArticleID,
+		EventType:        library.ReadingEventType(row.EventType),
+		OccurredAt:       timestamppb.New(row.OccurredAt.Time),
+		ArticleTitle:     row.ArticleTitle,
+	}
+	if row.FromStatus.Valid {
+		from := library.ReadingStatus(row.FromStatus.Int16)
+		event.FromStatus = &from
+	}
+	if row.ToStatus.Valid {
+		to := library.ReadingStatus(row.ToStatus.Int16)
+		event.ToStatus = &to
+	}
+	if row.Progress.Valid {
+		event.Progress = &row.Progress.Int32
+	}
+	return event
+}
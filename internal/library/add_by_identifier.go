@@ -0,0 +1,165 @@
+package library
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/apierror"
+	articleImp "github.com/chiquitav2/journalful/internal/article"
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// arXivDOIPrefix is the prefix arXiv registers DOIs for its preprints under,
+// with DataCite. The DOIs name a preprint, not one of its versions.
+const arXivDOIPrefix = "10.48550/arxiv."
+
+// arXivVersion matches the version suffix of an arXiv id, as in 2101.00001v2.
+var arXivVersion = regexp.MustCompile(`v[0-9]+$`)
+
+// identifierToDOI turns what a user pasted into a normalized DOI, or "" if
+// it is not an identifier we understand.
+func identifierToDOI(identifier string) string {
+	doi := articleImp.NormalizeDOI(identifier)
+	for _, prefix := range []string{"arxiv:", "https://arxiv.org/abs/", "http://arxiv.org/abs/", "arxiv.org/abs/"} {
+		if strings.HasPrefix(doi, prefix) {
+			id := arXivVersion.ReplaceAllString(strings.TrimSpace(strings.TrimPrefix(doi, prefix)), "")
+			if id == "" {
+				return ""
+			}
+			return arXivDOIPrefix + id
+		}
+	}
+	if !strings.HasPrefix(doi, "10.") || !strings.Contains(doi, "/") {
+		return ""
+	}
+	return doi
+}
+
+func (s *LibraryService) AddByIdentifier(ctx context.Context, request *library.AddByIdentifierRequest) (*library.AddByIdentifierResponse, error) {
+	doi := identifierToDOI(request.Identifier)
+	if doi == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier is not a DOI or arXiv id")
+	}
+
+	profileID, err := callerProfileID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lib, err := s.targetLibrary(ctx, profileID, request.LibraryId)
+	if err != nil {
+		return nil, err
+	}
+	articleID, created, err := s.findOrCreateArticle(ctx, doi, request.GetTitle())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &library.AddByIdentifierResponse{ArticleCreated: created}
+	entry, err := s.repo.GetLibraryArticle(ctx, db.GetLibraryArticleParams{LibraryID: lib.ID, ArticleID: articleID})
+	switch {
+	case err == nil:
+		response.AlreadySaved = true
+	case errors.Is(err, sql.ErrNoRows):
+		id, err := s.saveArticle(ctx, lib, articleID, request.ReadingStatus, request.Notes)
+		if apierror.IsDuplicateEntry(err) {
+			// Saved by a concurrent request since we looked.
+			entry, err = s.repo.GetLibraryArticle(ctx, db.GetLibraryArticleParams{LibraryID: lib.ID, ArticleID: articleID})
+			if err != nil {
+				slog.Error("failed to get library article", "error", err)
+				return nil, status.Error(codes.Internal, "failed to save article")
+			}
+			response.AlreadySaved = true
+		} else if err != nil {
+			slog.Error("failed to save article to library", "error", err)
+			return nil, status.Error(codes.Internal, "failed to save article")
+		} else {
+			entry.ID = id
+		}
+	default:
+		slog.Error("failed to get library article", "error", err)
+		return nil, status.Error(codes.Internal, "failed to save article")
+	}
+
+	details, err := s.getLibraryArticleDetails(ctx, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+	response.LibraryArticle = libraryArticleDetailsToGrpc(details)
+	return response, nil
+}
+
+// targetLibrary returns the requested library if the profile owns it, or
+// else the profile's default library, creating it if needed.
+func (s *LibraryService) targetLibrary(ctx context.Context, profileID int64, libraryID *int64) (db.Library, error) {
+	if libraryID != nil {
+		lib, err := s.repo.GetLibrary(ctx, *libraryID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return lib, status.Error(codes.NotFound, "library not found")
+		}
+		if err != nil {
+			slog.Error("failed to get library", "error", err)
+			return lib, status.Error(codes.Internal, "failed to get library")
+		}
+		if lib.OwnerID != profileID {
+			return lib, status.Error(codes.PermissionDenied, "library belongs to another user")
+		}
+		return lib, nil
+	}
+
+	libraries, err := s.repo.ListLibrariesByUserID(ctx, profileID)
+	if err != nil {
+		slog.Error("failed to list libraries", "error", err)
+		return db.Library{}, status.Error(codes.Internal, "failed to get default library")
+	}
+	for _, lib := range libraries {
+		if lib.Isdefault.Bool {
+			return lib, nil
+		}
+	}
+	id, err := s.createDefaultLibrary(ctx, profileID)
+	if err != nil {
+		slog.Error("error creating default library", "error", err)
+		return db.Library{}, status.Error(codes.Internal, "failed to create default library")
+	}
+	lib, err := s.repo.GetLibrary(ctx, id)
+	if err != nil {
+		slog.Error("error getting default library", "error", err)
+		return lib, status.Error(codes.Internal, "failed to get default library")
+	}
+	return lib, nil
+}
+
+// findOrCreateArticle returns the id of the article for doi, creating it
+// from the metadata service when it is new. title is used if the metadata
+// service does not know the DOI.
+func (s *LibraryService) findOrCreateArticle(ctx context.Context, doi, title string) (int64, bool, error) {
+	existing, err := s.articles.GetArticleByDOI(ctx, doi)
+	if err == nil {
+		return existing.Article.Id, false, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return 0, false, err
+	}
+
+	created, err := s.articles.CreateArticle(ctx, &article.CreateArticleRequest{Doi: doi, Title: title})
+	if status.Code(err) == codes.AlreadyExists {
+		// Created by a concurrent request since we looked.
+		existing, err := s.articles.GetArticleByDOI(ctx, doi)
+		if err != nil {
+			return 0, false, err
+		}
+		return existing.Article.Id, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return created.Id, true, nil
+}
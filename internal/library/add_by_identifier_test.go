@@ -0,0 +1,24 @@
+package library
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierToDOI(t *testing.T) {
+	cases := map[string]string{
+		"10.1000/XYZ123":                     "10.1000/xyz123",
+		"https://doi.org/10.1000/xyz123":     "10.1000/xyz123",
+		"doi:10.1000/xyz123":                 "10.1000/xyz123",
+		"arXiv:2101.00001":                   "10.48550/arxiv.2101.00001",
+		"https://arxiv.org/abs/2101.00001v2": "10.48550/arxiv.2101.00001",
+		"arXiv:hep-th/9901001v1":             "10.48550/arxiv.hep-th/9901001",
+		"arXiv:":                             "",
+		"not a doi":                          "",
+		"":                                   "",
+	}
+	for identifier, want := range cases {
+		assert.Equal(t, want, identifierToDOI(identifier), identifier)
+	}
+}
@@ -0,0 +1,180 @@
+package library
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// eventHistorySize is how many recent events, across all libraries, are
+	// kept so that reconnecting watchers can catch up.
+	eventHistorySize = 4096
+	// subscriberBuffer is how far a watcher may fall behind before it is
+	// dropped and has to resume.
+	subscriberBuffer = 64
+)
+
+var (
+	errResumeTooOld   = errors.New("resume token is too old")
+	errMalformedToken = errors.New("malformed resume token")
+)
+
+// eventBus fans out library events to the watchers of each library. It is
+// in-process only; events are numbered per process, so resume tokens carry
+// the process epoch and are rejected after a restart.
+type eventBus struct {
+	mu          sync.Mutex
+	epoch       int64
+	seq         uint64
+	history     []*library.LibraryEvent // ring buffer of the last events, oldest at start
+	start       int
+	subscribers map[int64]map[*subscription]struct{}
+	now         func() time.Time
+}
+
+type subscription struct {
+	libraryID int64
+	events    chan *library.LibraryEvent
+	// lagged is set before events is closed when the watcher fell behind.
+	lagged bool
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		epoch:       time.Now().UnixNano(),
+		subscribers: make(map[int64]map[*subscription]struct{}),
+		now:         time.Now,
+	}
+}
+
+// publish numbers the event and delivers it to the library's watchers.
+func (b *eventBus) publish(event *library.LibraryEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	if event.OccurredAt == nil {
+		event.OccurredAt = timestamppb.New(b.now())
+	}
+	event.ResumeToken = b.token(b.seq)
+
+	if len(b.history) < eventHistorySize {
+		b.history = append(b.history, event)
+	} else {
+		b.history[b.start] = event
+		b.start = (b.start + 1) % eventHistorySize
+	}
+
+	for sub := range b.subscribers[event.LibraryId] {
+		select {
+		case sub.events <- event:
+		default:
+			sub.lagged = true
+			b.remove(sub)
+		}
+	}
+}
+
+// subscribe registers a watcher for a library and returns the events it
+// missed since resumeToken. Replay and registration happen under one lock,
+// so no event falls in between.
+func (b *eventBus) subscribe(libraryID int64, resumeToken string) (*subscription, []*library.LibraryEvent, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var missed []*library.LibraryEvent
+	if resumeToken != "" {
+		after, err := b.parseToken(resumeToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		if after < b.seq-uint64(len(b.history)) {
+			return nil, nil, errResumeTooOld
+		}
+		for i := range b.history {
+			event := b.history[(b.start+i)%len(b.history)]
+			seq := b.seq - uint64(len(b.history)-1-i)
+			if seq > after && event.LibraryId == libraryID {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	sub := &subscription{
+		libraryID: libraryID,
+		events:    make(chan *library.LibraryEvent, subscriberBuffer),
+	}
+	if b.subscribers[libraryID] == nil {
+		b.subscribers[libraryID] = make(map[*subscription]struct{})
+	}
+	b.subscribers[libraryID][sub] = struct{}{}
+
+	missed = append(missed, &library.LibraryEvent{
+		Type:        library.LibraryEventType_LIBRARY_EVENT_TYPE_CAUGHT_UP,
+		LibraryId:   libraryID,
+		ResumeToken: b.token(b.seq),
+	})
+	return sub, missed, nil
+}
+
+func (b *eventBus) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+func (b *eventBus) remove(sub *subscription) {
+	subs, ok := b.subscribers[sub.libraryID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.subscribers, sub.libraryID)
+	}
+	close(sub.events)
+}
+
+// Resume tokens are opaque to clients. They carry the process epoch and the
+// sequence number of the last event seen.
+func (b *eventBus) token(seq uint64) string {
+	raw := fmt.Sprintf("%d:%d", b.epoch, seq)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func (b *eventBus) parseToken(token string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errMalformedToken
+	}
+	epochPart, seqPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return 0, errMalformedToken
+	}
+	epoch, err := strconv.ParseInt(epochPart, 10, 64)
+	if err != nil {
+		return 0, errMalformedToken
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, errMalformedToken
+	}
+	if epoch != b.epoch {
+		return 0, errResumeTooOld
+	}
+	if seq > b.seq {
+		return 0, errMalformedToken
+	}
+	return seq, nil
+}
@@ -0,0 +1,92 @@
+package library
+
+import (
+	"testing"
+
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func added(libraryID, articleID int64) *library.LibraryEvent {
+	return &library.LibraryEvent{
+		Type:      library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_ADDED,
+		LibraryId: libraryID,
+		ArticleId: articleID,
+	}
+}
+
+func TestEventBusDeliversToLibraryWatchers(t *testing.T) {
+	bus := newEventBus()
+	sub, missed, err := bus.subscribe(1, "")
+	assert.NoError(t, err)
+	assert.Len(t, missed, 1)
+	assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_CAUGHT_UP, missed[0].Type)
+
+	bus.publish(added(2, 10))
+	bus.publish(added(1, 11))
+
+	event := <-sub.events
+	assert.Equal(t, int64(11), event.ArticleId)
+	assert.NotEmpty(t, event.ResumeToken)
+	assert.NotNil(t, event.OccurredAt)
+	assert.Len(t, sub.events, 0)
+}
+
+func TestEventBusResumesAfterToken(t *testing.T) {
+	bus := newEventBus()
+	_, caughtUp, err := bus.subscribe(1, "")
+	assert.NoError(t, err)
+	token := caughtUp[0].ResumeToken
+
+	bus.publish(added(1, 10))
+	bus.publish(added(2, 11))
+	bus.publish(added(1, 12))
+
+	_, missed, err := bus.subscribe(1, token)
+	assert.NoError(t, err)
+	assert.Len(t, missed, 3)
+	assert.Equal(t, int64(10), missed[0].ArticleId)
+	assert.Equal(t, int64(12), missed[1].ArticleId)
+	assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_CAUGHT_UP, missed[2].Type)
+
+	_, missed, err = bus.subscribe(1, missed[1].ResumeToken)
+	assert.NoError(t, err)
+	assert.Len(t, missed, 1)
+}
+
+func TestEventBusRejectsUnusableTokens(t *testing.T) {
+	bus := newEventBus()
+	_, caughtUp, _ := bus.subscribe(1, "")
+	token := caughtUp[0].ResumeToken
+
+	for i := 0; i <= eventHistorySize; i++ {
+		bus.publish(added(1, int64(i)))
+	}
+	_, _, err := bus.subscribe(1, token)
+	assert.ErrorIs(t, err, errResumeTooOld)
+
+	restarted := newEventBus()
+	restarted.epoch = bus.epoch + 1
+	_, _, err = restarted.subscribe(1, token)
+	assert.ErrorIs(t, err, errResumeTooOld)
+
+	_, _, err = bus.subscribe(1, "not a token")
+	assert.ErrorIs(t, err, errMalformedToken)
+}
+
+func TestEventBusDropsLaggingWatchers(t *testing.T) {
+	bus := newEventBus()
+	sub, _, _ := bus.subscribe(1, "")
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		bus.publish(added(1, int64(i)))
+	}
+
+	received := 0
+	for range sub.events {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+	assert.True(t, sub.lagged)
+	assert.Empty(t, bus.subscribers)
+}
@@ -0,0 +1,65 @@
+package library
+
+import (
+	"context"
+	"log/slog"
+
+	articleImp "github.com/chiquitav2/journalful/internal/article"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListFlaggedArticlesInMyLibraries returns the caller's saved articles that
+// have been corrected or retracted, with the notices and where they are saved.
+func (s *LibraryService) ListFlaggedArticlesInMyLibraries(ctx context.Context, _ *library.ListFlaggedArticlesInMyLibrariesRequest) (*library.ListFlaggedArticlesInMyLibrariesResponse, error) {
+	profileID, err := callerProfileID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.repo.ListFlaggedLibraryArticlesForOwner(ctx, profileID)
+	if err != nil {
+		slog.Error("failed to list flagged library articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list flagged articles")
+	}
+	if len(rows) == 0 {
+		return &library.ListFlaggedArticlesInMyLibrariesResponse{}, nil
+	}
+
+	var flagged []*library.FlaggedArticle
+	byArticle := make(map[int64]*library.FlaggedArticle)
+	var ids []int64
+	for _, row := range rows {
+		entry, ok := byArticle[row.ArticleID]
+		if !ok {
+			entry = &library.FlaggedArticle{
+				ArticleId: row.ArticleID,
+				Doi:       row.Doi,
+				Title:     row.Title,
+				Flag:      article.ArticleFlag(row.Flag),
+			}
+			byArticle[row.ArticleID] = entry
+			flagged = append(flagged, entry)
+			ids = append(ids, row.ArticleID)
+		}
+		entry.Entries = append(entry.Entries, &library.FlaggedLibraryEntry{
+			LibraryArticleId: row.LibraryArticleID,
+			LibraryId:        row.LibraryID,
+			LibraryName:      row.LibraryName.String,
+		})
+	}
+
+	updates, err := s.repo.ListArticleUpdates(ctx, ids)
+	if err != nil {
+		slog.Error("failed to list article updates", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list flagged articles")
+	}
+	for _, u := range updates {
+		if entry, ok := byArticle[u.ArticleID]; ok {
+			entry.Updates = append(entry.Updates, articleImp.DbToGrpcArticleUpdate(u))
+		}
+	}
+
+	return &library.ListFlaggedArticlesInMyLibrariesResponse{Articles: flagged}, nil
+}
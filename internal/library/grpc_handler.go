@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/chiquitav2/journalful/internal/db"
 	"github.com/chiquitav2/journalful/pkg/library/v1"
 )
 
@@ -22,10 +23,22 @@ func (h *GrpcHandler) SaveArticleToLibrary(ctx context.Context, request *library
 	return h.service.SaveArticleToLibrary(ctx, request)
 }
 
+func (h *GrpcHandler) AddByIdentifier(ctx context.Context, request *library.AddByIdentifierRequest) (*library.AddByIdentifierResponse, error) {
+	return h.service.AddByIdentifier(ctx, request)
+}
+
 func (h *GrpcHandler) GetUserLibrary(ctx context.Context, request *library.GetUserLibraryRequest) (*library.GetUserLibraryResponse, error) {
 	return h.service.GetUserLibrary(ctx, request)
 }
 
+func (h *GrpcHandler) ListLibrarySummaries(ctx context.Context, request *library.ListLibrarySummariesRequest) (*library.ListLibrarySummariesResponse, error) {
+	return h.service.ListLibrarySummaries(ctx, request)
+}
+
+func (h *GrpcHandler) ListLibraryArticles(ctx context.Context, request *library.ListLibraryArticlesRequest) (*library.ListLibraryArticlesResponse, error) {
+	return h.service.ListLibraryArticles(ctx, request)
+}
+
 func (h *GrpcHandler) GetLibrary(ctx context.Context, request *library.GetLibraryRequest) (*library.GetLibraryResponse, error) {
 	return h.service.GetLibrary(ctx, request)
 }
@@ -41,3 +54,33 @@ func (h *GrpcHandler) UpdateLibrary(ctx context.Context, request *library.Update
 func (h *GrpcHandler) DeleteLibrary(ctx context.Context, request *library.DeleteLibraryRequest) (*library.DeleteLibraryResponse, error) {
 	return h.service.DeleteLibrary(ctx, request)
 }
+
+func (h *GrpcHandler) UpdateLibraryArticle(ctx context.Context, request *library.UpdateLibraryArticleRequest) (*library.UpdateLibraryArticleResponse, error) {
+	return h.service.UpdateLibraryArticle(ctx, request)
+}
+
+func (h *GrpcHandler) RemoveArticleFromLibrary(ctx context.Context, request *library.RemoveArticleFromLibraryRequest) (*library.RemoveArticleFromLibraryResponse, error) {
+	return h.service.RemoveArticleFromLibrary(ctx, request)
+}
+
+func (h *GrpcHandler) RecordArticleOpened(ctx context.Context, request *library.RecordArticleOpenedRequest) (*library.RecordArticleOpenedResponse, error) {
+	return h.service.RecordArticleOpened(ctx, request)
+}
+
+func (h *GrpcHandler) ListReadingActivity(ctx context.Context, request *library.ListReadingActivityRequest) (*library.ListReadingActivityResponse, error) {
+	return h.service.ListReadingActivity(ctx, request)
+}
+
+func (h *GrpcHandler) ListFlaggedArticlesInMyLibraries(ctx context.Context, request *library.ListFlaggedArticlesInMyLibrariesRequest) (*library.ListFlaggedArticlesInMyLibrariesResponse, error) {
+	return h.service.ListFlaggedArticlesInMyLibraries(ctx, request)
+}
+
+func (h *GrpcHandler) WatchLibrary(request *library.WatchLibraryRequest, stream library.LibraryService_WatchLibraryServer) error {
+	return h.service.WatchLibrary(request, stream)
+}
+
+// LibraryEntriesMerged lets the article service report library entries
+// changed by article merges to the libraries' watchers.
+func (h *GrpcHandler) LibraryEntriesMerged(ctx context.Context, updated []int64, removed []db.LibraryArticle) {
+	h.service.LibraryEntriesMerged(ctx, updated, removed)
+}
@@ -3,43 +3,66 @@ package library
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
+	"sort"
+	"strings"
+	"time"
 
+	articleImp "github.com/chiquitav2/journalful/internal/article"
+	"github.com/chiquitav2/journalful/internal/auth"
 	"github.com/chiquitav2/journalful/internal/db"
 	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LibraryServiceInterface interface {
 	SaveArticleToLibrary(ctx context.Context, request *library.SaveArticleToLibraryRequest) (*library.SaveArticleToLibraryResponse, error)
+	AddByIdentifier(ctx context.Context, request *library.AddByIdentifierRequest) (*library.AddByIdentifierResponse, error)
 	GetUserLibrary(ctx context.Context, request *library.GetUserLibraryRequest) (*library.GetUserLibraryResponse, error)
+	ListLibrarySummaries(ctx context.Context, request *library.ListLibrarySummariesRequest) (*library.ListLibrarySummariesResponse, error)
+	ListLibraryArticles(ctx context.Context, request *library.ListLibraryArticlesRequest) (*library.ListLibraryArticlesResponse, error)
 	GetLibrary(ctx context.Context, request *library.GetLibraryRequest) (*library.GetLibraryResponse, error)
 	CreateLibrary(ctx context.Context, request *library.CreateLibraryRequest) (*library.CreateLibraryResponse, error)
 	UpdateLibrary(ctx context.Context, request *library.UpdateLibraryRequest) (*library.UpdateLibraryResponse, error)
 	DeleteLibrary(ctx context.Context, request *library.DeleteLibraryRequest) (*library.DeleteLibraryResponse, error)
+	UpdateLibraryArticle(ctx context.Context, request *library.UpdateLibraryArticleRequest) (*library.UpdateLibraryArticleResponse, error)
+	RemoveArticleFromLibrary(ctx context.Context, request *library.RemoveArticleFromLibraryRequest) (*library.RemoveArticleFromLibraryResponse, error)
+	RecordArticleOpened(ctx context.Context, request *library.RecordArticleOpenedRequest) (*library.RecordArticleOpenedResponse, error)
+	ListReadingActivity(ctx context.Context, request *library.ListReadingActivityRequest) (*library.ListReadingActivityResponse, error)
+	ListFlaggedArticlesInMyLibraries(ctx context.Context, request *library.ListFlaggedArticlesInMyLibrariesRequest) (*library.ListFlaggedArticlesInMyLibrariesResponse, error)
+	WatchLibrary(request *library.WatchLibraryRequest, stream library.LibraryService_WatchLibraryServer) error
+	LibraryEntriesMerged(ctx context.Context, updated []int64, removed []db.LibraryArticle)
 }
 type LibraryService struct {
-	repo *db.Queries
+	conn     *sql.DB
+	repo     *db.Queries
+	articles articleImp.ArticleService
+	events   *eventBus
 }
 
 func NewLibraryService(conn *sql.DB) *LibraryService {
 	return &LibraryService{
-		repo: db.New(conn),
+		conn:     conn,
+		repo:     db.NewTraced(conn),
+		articles: articleImp.NewArticleSerivce(conn),
+		events:   newEventBus(),
 	}
 }
 
 func (l *LibraryService) SaveArticleToLibrary(ctx context.Context, request *library.SaveArticleToLibraryRequest) (*library.SaveArticleToLibraryResponse, error) {
-	result, err := l.repo.AddLibraryArticle(ctx, db.AddLibraryArticleParams{
-		LibraryID:     request.LibraryId,
-		ArticleID:     request.ArticleId,
-		ReadingStatus: sql.NullInt16{Int16: int16(request.ReadingStatus), Valid: true},
-		Notes:         sql.NullString{String: *request.Notes, Valid: request.Notes != nil},
-	})
+	lib, err := l.repo.GetLibrary(ctx, request.LibraryId)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "library not found")
+		}
 		return nil, err
 	}
 
-	id, err := result.LastInsertId()
+	id, err := l.saveArticle(ctx, lib, request.ArticleId, request.ReadingStatus, request.Notes)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +70,50 @@ func (l *LibraryService) SaveArticleToLibrary(ctx context.Context, request *libr
 	return &library.SaveArticleToLibraryResponse{Id: id}, nil
 }
 
+// saveArticle adds an article to a library and records the ADDED event.
+func (l *LibraryService) saveArticle(ctx context.Context, lib db.Library, articleID int64, readingStatus library.ReadingStatus, notes *string) (int64, error) {
+	now := time.Now()
+	params := db.AddLibraryArticleParams{
+		LibraryID:     lib.ID,
+		ArticleID:     articleID,
+		ReadingStatus: sql.NullInt16{Int16: int16(readingStatus), Valid: true},
+		Dateadded:     sql.NullTime{Time: now, Valid: true},
+		Isfavorite:    sql.NullBool{Bool: false, Valid: true},
+	}
+	if notes != nil {
+		params.Notes = sql.NullString{String: *notes, Valid: true}
+	}
+	if readingStatus == library.ReadingStatus_READING_STATUS_READ {
+		params.ReadingProgress = sql.NullInt32{Int32: 100, Valid: true}
+		params.Datecompleted = sql.NullTime{Time: now, Valid: true}
+	}
+
+	var id int64
+	err := db.WithTx(ctx, l.conn, func(q *db.Queries) error {
+		result, err := q.AddLibraryArticle(ctx, params)
+		if err != nil {
+			return err
+		}
+		id, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return q.CreateReadingEvent(ctx, db.CreateReadingEventParams{
+			ProfileID:        lib.OwnerID,
+			LibraryID:        lib.ID,
+			ArticleID:        articleID,
+			LibraryArticleID: id,
+			EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_ADDED),
+			ToStatus:         params.ReadingStatus,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	l.publishEntryEvent(ctx, library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_ADDED, id, library.ReadingStatus_READING_STATUS_UNSPECIFIED)
+	return id, nil
+}
+
 func (l *LibraryService) GetUserLibrary(ctx context.Context, request *library.GetUserLibraryRequest) (*library.GetUserLibraryResponse, error) {
 	libraries, err := l.repo.ListLibrariesByUserID(ctx, request.UserId)
 	if err != nil {
@@ -90,6 +157,166 @@ func (l *LibraryService) GetUserLibrary(ctx context.Context, request *library.Ge
 	}, nil
 }
 
+func (l *LibraryService) ListLibrarySummaries(ctx context.Context, request *library.ListLibrarySummariesRequest) (*library.ListLibrarySummariesResponse, error) {
+	libraries, err := l.repo.ListLibrariesByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count articles per status for every library in one query instead of
+	// loading each library's articles.
+	counts, err := l.repo.CountLibraryArticlesByStatusForOwner(ctx, request.UserId)
+	if err != nil {
+		slog.Error("error counting library articles", "error", err.Error())
+		return nil, err
+	}
+	countsByLibrary := make(map[int64][]db.CountLibraryArticlesByStatusForOwnerRow)
+	for _, c := range counts {
+		countsByLibrary[c.LibraryID] = append(countsByLibrary[c.LibraryID], c)
+	}
+
+	var defaultLibrary *library.LibrarySummary
+
+	var response []*library.LibrarySummary
+	for _, lib := range libraries {
+		summary := buildLibrarySummary(lib, countsByLibrary[lib.ID])
+		response = append(response, summary)
+		if lib.Isdefault.Bool {
+			defaultLibrary = summary
+		}
+	}
+	if defaultLibrary == nil {
+		id, err := l.createDefaultLibrary(ctx, request.UserId)
+		if err != nil {
+			slog.Error("error creating default library", "error", err.Error())
+			return nil, err
+		}
+		lib, err := l.repo.GetLibrary(ctx, id)
+		if err != nil {
+			slog.Error("error getting default library", "error", err)
+			return nil, err
+		}
+		defaultLibrary = buildLibrarySummary(lib, nil)
+	}
+
+	return &library.ListLibrarySummariesResponse{
+		DefaultLibrary: defaultLibrary,
+		Libraries:      response,
+	}, nil
+}
+
+// checkReadable lets only the owner and admins read a private library. It
+// is not found by anonymous callers, who can reach the read RPCs when they
+// are configured as public methods.
+func checkReadable(ctx context.Context, lib db.Library) error {
+	if lib.Ispublic.Bool {
+		return nil
+	}
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if principal.IsAnonymous() {
+		return status.Error(codes.NotFound, "library not found")
+	}
+	return checkOwner(ctx, lib.OwnerID)
+}
+
+// checkOwner lets only the owner of a library and admins act on it.
+func checkOwner(ctx context.Context, ownerID int64) error {
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if profileID, _ := auth.ProfileID(ctx); ownerID != profileID && !principal.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "library belongs to another user")
+	}
+	return nil
+}
+
+// callerProfileID returns the caller's profile id, for RPCs that act on the
+// caller's own libraries.
+func callerProfileID(ctx context.Context) (int64, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.IsAnonymous() {
+		return 0, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+	profileID, ok := auth.ProfileID(ctx)
+	if !ok {
+		return 0, status.Error(codes.FailedPrecondition, "create a profile first")
+	}
+	return profileID, nil
+}
+
+func (l *LibraryService) ListLibraryArticles(ctx context.Context, request *library.ListLibraryArticlesRequest) (*library.ListLibraryArticlesResponse, error) {
+	lib, err := l.repo.GetLibrary(ctx, request.LibraryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "library not found")
+		}
+		return nil, err
+	}
+	if err := checkReadable(ctx, lib); err != nil {
+		return nil, err
+	}
+
+	pageSize := normalizePageSize(request.PageSize)
+	offset, err := decodePageToken(request.PageToken, request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	var readingStatus sql.NullInt16
+	if request.ReadingStatus != nil {
+		readingStatus = sql.NullInt16{Int16: int16(*request.ReadingStatus), Valid: true}
+	}
+	var isFavorite sql.NullBool
+	if request.IsFavorite != nil {
+		isFavorite = sql.NullBool{Bool: *request.IsFavorite, Valid: true}
+	}
+	var query sql.NullString
+	if request.Query != nil && strings.TrimSpace(*request.Query) != "" {
+		query = sql.NullString{String: utils.LikePattern(*request.Query), Valid: true}
+	}
+
+	total, err := l.repo.CountLibraryArticlesFiltered(ctx, db.CountLibraryArticlesFilteredParams{
+		LibraryID:     request.LibraryId,
+		ReadingStatus: readingStatus,
+		IsFavorite:    isFavorite,
+		Query:         query,
+	})
+	if err != nil {
+		slog.Error("error counting library articles", "error", err.Error())
+		return nil, err
+	}
+
+	// Fetch one extra row to find out whether another page follows.
+	rows, err := l.repo.ListLibraryArticlesPage(ctx, db.ListLibraryArticlesPageParams{
+		LibraryID:     request.LibraryId,
+		ReadingStatus: readingStatus,
+		IsFavorite:    isFavorite,
+		Query:         query,
+		SortOrder:     sortOrder(request.SortBy, request.Descending),
+		Limit:         pageSize + 1,
+		Offset:        offset,
+	})
+	if err != nil {
+		slog.Error("error listing library articles", "error", err.Error())
+		return nil, err
+	}
+
+	var nextPageToken string
+	if int32(len(rows)) > pageSize {
+		rows = rows[:pageSize]
+		nextPageToken = encodePageToken(offset+pageSize, request)
+	}
+
+	articles := make([]*library.LibraryArticle, 0, len(rows))
+	for _, row := range rows {
+		articles = append(articles, libraryArticlePageRowToGrpc(row))
+	}
+
+	return &library.ListLibraryArticlesResponse{
+		Articles:      articles,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
+	}, nil
+}
+
 func (s *LibraryService) createDefaultLibrary(ctx context.Context, userID int64) (int64, error) {
 	result, err := s.repo.CreateLibrary(ctx, db.CreateLibraryParams{
 		OwnerID: userID,
@@ -152,11 +379,62 @@ func (s *LibraryService) buildLibrary(ctx context.Context, lib db.Library) (*lib
 	}, nil
 }
 
+func buildLibrarySummary(lib db.Library, counts []db.CountLibraryArticlesByStatusForOwnerRow) *library.LibrarySummary {
+	var total int64
+	statusCounts := make([]*library.ReadingStatusCount, 0, len(counts))
+	for _, c := range counts {
+		total += c.ArticleCount
+		statusCounts = append(statusCounts, &library.ReadingStatusCount{
+			ReadingStatus: library.ReadingStatus(c.ReadingStatus.Int16),
+			Count:         c.ArticleCount,
+		})
+	}
+	sort.Slice(statusCounts, func(i, j int) bool {
+		return statusCounts[i].ReadingStatus < statusCounts[j].ReadingStatus
+	})
+
+	return &library.LibrarySummary{
+		Id:           lib.ID,
+		OwnerId:      lib.OwnerID,
+		Name:         lib.Name.String,
+		Description:  &lib.Description.String,
+		IsPublic:     lib.Ispublic.Bool,
+		IsDefault:    lib.Isdefault.Bool,
+		ArticleCount: total,
+		StatusCounts: statusCounts,
+		CreatedAt:    timestamppb.New(lib.CreatedAt.Time),
+		UpdatedAt:    timestamppb.New(lib.UpdatedAt.Time),
+	}
+}
+
+func libraryArticlePageRowToGrpc(row db.ListLibraryArticlesPageRow) *library.LibraryArticle {
+	notes := row.Notes.String
+	article := &library.LibraryArticle{
+		Id:              row.ID,
+		ArticleId:       row.ArticleID,
+		ReadingStatus:   library.ReadingStatus(row.ReadingStatus.Int16),
+		ReadingProgress: row.ReadingProgress.Int32,
+		DateAdded:       timestamppb.New(row.Dateadded.Time),
+		Notes:           &notes,
+		ArticleTitle:    row.ArticleTitle,
+		Doi:             row.Doi,
+		PublicationYear: row.PublicationYear.Int32,
+		IsFavorite:      row.Isfavorite.Bool,
+	}
+	if row.Datecompleted.Valid {
+		article.DateCompleted = timestamppb.New(row.Datecompleted.Time)
+	}
+	return article
+}
+
 func (s *LibraryService) GetLibrary(ctx context.Context, request *library.GetLibraryRequest) (*library.GetLibraryResponse, error) {
 	lib, err := s.repo.GetLibrary(ctx, request.LibraryId)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkReadable(ctx, lib); err != nil {
+		return nil, err
+	}
 
 	builtLib, err := s.buildLibrary(ctx, lib)
 	if err != nil {
@@ -176,7 +454,7 @@ func (s *LibraryService) CreateLibrary(ctx context.Context, request *library.Cre
 			Valid:  true,
 		},
 		Description: sql.NullString{
-			String: *request.Description,
+			String: request.GetDescription(),
 			Valid:  request.Description != nil,
 		},
 		Ispublic: sql.NullBool{
@@ -247,13 +525,238 @@ func (s *LibraryService) UpdateLibrary(ctx context.Context, request *library.Upd
 	}, nil
 }
 
+// DeleteLibrary deletes a library with its articles, which are logged as
+// removed and reported to the library's watchers.
 func (s *LibraryService) DeleteLibrary(ctx context.Context, request *library.DeleteLibraryRequest) (*library.DeleteLibraryResponse, error) {
-	err := s.repo.DeleteLibrary(ctx, request.LibraryId)
+	lib, err := s.repo.GetLibrary(ctx, request.LibraryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "library not found")
+		}
+		return nil, err
+	}
+	if err := checkOwner(ctx, lib.OwnerID); err != nil {
+		return nil, err
+	}
+
+	var entries []db.ListLibraryArticlesByLibraryIDRow
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		entries, err = q.ListLibraryArticlesByLibraryID(ctx, lib.ID)
+		if err != nil {
+			return err
+		}
+		events := make([]db.CreateReadingEventParams, len(entries))
+		for i, entry := range entries {
+			events[i] = db.CreateReadingEventParams{
+				ProfileID:        lib.OwnerID,
+				LibraryID:        lib.ID,
+				ArticleID:        entry.ArticleID,
+				LibraryArticleID: entry.ID,
+				EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_REMOVED),
+				FromStatus:       entry.ReadingStatus,
+			}
+		}
+		if err := q.CreateReadingEvents(ctx, events); err != nil {
+			return err
+		}
+		return q.DeleteLibrary(ctx, lib.ID)
+	})
 	if err != nil {
+		slog.Error("failed to delete library", "id", lib.ID, "error", err)
 		return nil, err
 	}
+	for _, entry := range entries {
+		s.events.publish(&library.LibraryEvent{
+			Type:             library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED,
+			LibraryId:        lib.ID,
+			LibraryArticleId: entry.ID,
+			ArticleId:        entry.ArticleID,
+			FromStatus:       library.ReadingStatus(entry.ReadingStatus.Int16),
+		})
+	}
 
 	return &library.DeleteLibraryResponse{
 		Success: true,
 	}, nil
 }
+
+func (s *LibraryService) getLibraryArticleDetails(ctx context.Context, id int64) (db.GetLibraryArticleDetailsRow, error) {
+	entry, err := s.repo.GetLibraryArticleDetails(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entry, status.Error(codes.NotFound, "library article not found")
+		}
+		return entry, err
+	}
+	return entry, nil
+}
+
+func (s *LibraryService) UpdateLibraryArticle(ctx context.Context, request *library.UpdateLibraryArticleRequest) (*library.UpdateLibraryArticleResponse, error) {
+	entry, err := s.getLibraryArticleDetails(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateLibraryArticleParams{
+		ID:              entry.ID,
+		ReadingStatus:   entry.ReadingStatus,
+		ReadingProgress: entry.ReadingProgress,
+		Datecompleted:   entry.Datecompleted,
+		Notes:           entry.Notes,
+		Isfavorite:      entry.Isfavorite,
+	}
+	event := func(eventType library.ReadingEventType) db.CreateReadingEventParams {
+		return db.CreateReadingEventParams{
+			ProfileID:        entry.OwnerID,
+			LibraryID:        entry.LibraryID,
+			ArticleID:        entry.ArticleID,
+			LibraryArticleID: entry.ID,
+			EventType:        int8(eventType),
+		}
+	}
+	var events []db.CreateReadingEventParams
+
+	if request.ReadingStatus != nil && int16(*request.ReadingStatus) != entry.ReadingStatus.Int16 {
+		ev := event(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED)
+		ev.FromStatus = entry.ReadingStatus
+		ev.ToStatus = sql.NullInt16{Int16: int16(*request.ReadingStatus), Valid: true}
+		events = append(events, ev)
+
+		params.ReadingStatus = ev.ToStatus
+		if *request.ReadingStatus == library.ReadingStatus_READING_STATUS_READ {
+			params.Datecompleted = sql.NullTime{Time: time.Now(), Valid: true}
+		} else {
+			params.Datecompleted = sql.NullTime{}
+		}
+	}
+
+	if request.ReadingProgress != nil && *request.ReadingProgress != entry.ReadingProgress.Int32 {
+		ev := event(library.ReadingEventType_READING_EVENT_TYPE_PROGRESS_UPDATED)
+		ev.Progress = sql.NullInt32{Int32: *request.ReadingProgress, Valid: true}
+		events = append(events, ev)
+
+		params.ReadingProgress = ev.Progress
+	}
+
+	if request.Notes != nil && *request.Notes != entry.Notes.String {
+		events = append(events, event(library.ReadingEventType_READING_EVENT_TYPE_NOTE_EDITED))
+		params.Notes = sql.NullString{String: *request.Notes, Valid: true}
+	}
+
+	if request.IsFavorite != nil && *request.IsFavorite != entry.Isfavorite.Bool {
+		events = append(events, event(library.ReadingEventType_READING_EVENT_TYPE_FAVORITE_CHANGED))
+		params.Isfavorite = sql.NullBool{Bool: *request.IsFavorite, Valid: true}
+	}
+
+	if len(events) > 0 {
+		err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+			if err := q.UpdateLibraryArticle(ctx, params); err != nil {
+				return err
+			}
+			for _, ev := range events {
+				if err := q.CreateReadingEvent(ctx, ev); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			slog.Error("failed to update library article", "id", entry.ID, "error", err)
+			return nil, err
+		}
+
+		fromStatus := library.ReadingStatus(entry.ReadingStatus.Int16)
+		entry, err = s.getLibraryArticleDetails(ctx, request.Id)
+		if err != nil {
+			return nil, err
+		}
+		if entry.ReadingStatus.Int16 != int16(fromStatus) {
+			s.publishEntry(library.LibraryEventType_LIBRARY_EVENT_TYPE_STATUS_CHANGED, entry, fromStatus)
+		} else {
+			s.publishEntry(library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_UPDATED, entry, library.ReadingStatus_READING_STATUS_UNSPECIFIED)
+		}
+	}
+
+	return &library.UpdateLibraryArticleResponse{
+		LibraryArticle: libraryArticleDetailsToGrpc(entry),
+	}, nil
+}
+
+func (s *LibraryService) RemoveArticleFromLibrary(ctx context.Context, request *library.RemoveArticleFromLibraryRequest) (*library.RemoveArticleFromLibraryResponse, error) {
+	entry, err := s.getLibraryArticleDetails(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		if err := q.DeleteLibraryArticle(ctx, entry.ID); err != nil {
+			return err
+		}
+		return q.CreateReadingEvent(ctx, db.CreateReadingEventParams{
+			ProfileID:        entry.OwnerID,
+			LibraryID:        entry.LibraryID,
+			ArticleID:        entry.ArticleID,
+			LibraryArticleID: entry.ID,
+			EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_REMOVED),
+			FromStatus:       entry.ReadingStatus,
+		})
+	})
+	if err != nil {
+		slog.Error("failed to remove library article", "id", entry.ID, "error", err)
+		return nil, err
+	}
+	s.events.publish(&library.LibraryEvent{
+		Type:             library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED,
+		LibraryId:        entry.LibraryID,
+		LibraryArticleId: entry.ID,
+		ArticleId:        entry.ArticleID,
+		FromStatus:       library.ReadingStatus(entry.ReadingStatus.Int16),
+	})
+
+	return &library.RemoveArticleFromLibraryResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *LibraryService) RecordArticleOpened(ctx context.Context, request *library.RecordArticleOpenedRequest) (*library.RecordArticleOpenedResponse, error) {
+	entry, err := s.getLibraryArticleDetails(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOwner(ctx, entry.OwnerID); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateReadingEvent(ctx, db.CreateReadingEventParams{
+		ProfileID:        entry.OwnerID,
+		LibraryID:        entry.LibraryID,
+		ArticleID:        entry.ArticleID,
+		LibraryArticleID: entry.ID,
+		EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_OPENED),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.RecordArticleOpenedResponse{}, nil
+}
+
+func libraryArticleDetailsToGrpc(row db.GetLibraryArticleDetailsRow) *library.LibraryArticle {
+	notes := row.Notes.String
+	article := &library.LibraryArticle{
+		Id:              row.ID,
+		ArticleId:       row.ArticleID,
+		ReadingStatus:   library.ReadingStatus(row.ReadingStatus.Int16),
+		ReadingProgress: row.ReadingProgress.Int32,
+		DateAdded:       timestamppb.New(row.Dateadded.Time),
+		Notes:           &notes,
+		ArticleTitle:    row.ArticleTitle,
+		Doi:             row.Doi,
+		PublicationYear: row.PublicationYear.Int32,
+		IsFavorite:      row.Isfavorite.Bool,
+	}
+	if row.Datecompleted.Valid {
+		article.DateCompleted = timestamppb.New(row.Datecompleted.Time)
+	}
+	return article
+}
@@ -0,0 +1,405 @@
+package library
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckReadable(t *testing.T) {
+	public := db.Library{ID: 1, Ispublic: sql.NullBool{Bool: true, Valid: true}}
+	private := db.Library{ID: 2, OwnerID: 7}
+	anonymous := auth.WithPrincipal(context.Background(), auth.Anonymous())
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin", ProfileID: 1, Roles: []string{auth.RoleAdmin}})
+
+	assert.NoError(t, checkReadable(anonymous, public))
+	assert.NoError(t, checkReadable(ownerContext(8), public))
+	assert.Equal(t, codes.NotFound, status.Code(checkReadable(anonymous, private)))
+	assert.NoError(t, checkReadable(ownerContext(7), private))
+	assert.Equal(t, codes.PermissionDenied, status.Code(checkReadable(ownerContext(8), private)))
+	assert.NoError(t, checkReadable(admin, private))
+}
+
+func newTestService(t *testing.T) (*LibraryService, *dbtest.Fake) {
+	conn, fake := dbtest.Open(t)
+	return NewLibraryService(conn), fake
+}
+
+func ownerContext(profileID int64) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-1", ProfileID: profileID})
+}
+
+func status16(s library.ReadingStatus) sql.NullInt16 {
+	return sql.NullInt16{Int16: int16(s), Valid: true}
+}
+
+// readingEntry is library article 5: article 3 in library 1 of profile 7.
+func readingEntry(s library.ReadingStatus) db.GetLibraryArticleDetailsRow {
+	return db.GetLibraryArticleDetailsRow{ID: 5, LibraryID: 1, ArticleID: 3, ReadingStatus: status16(s), OwnerID: 7}
+}
+
+func readingEvent(eventType library.ReadingEventType) db.CreateReadingEventParams {
+	return db.CreateReadingEventParams{ProfileID: 7, LibraryID: 1, ArticleID: 3, LibraryArticleID: 5, EventType: int8(eventType)}
+}
+
+func assertReadingEvents(t *testing.T, fake *dbtest.Fake, want ...db.CreateReadingEventParams) {
+	t.Helper()
+	calls := fake.Calls("CreateReadingEvent")
+	if assert.Len(t, calls, len(want)) {
+		for i, ev := range want {
+			assert.Equal(t, dbtest.Values(ev), calls[i].Args)
+		}
+	}
+}
+
+func TestSaveArticleRecordsAddedEvent(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+
+	_, err := s.SaveArticleToLibrary(ownerContext(7), &library.SaveArticleToLibraryRequest{
+		LibraryId:     1,
+		ArticleId:     3,
+		ReadingStatus: library.ReadingStatus_READING_STATUS_READING,
+	})
+	assert.NoError(t, err)
+
+	// The fake hands out insert id 1 to the new library article.
+	want := readingEvent(library.ReadingEventType_READING_EVENT_TYPE_ADDED)
+	want.LibraryArticleID = 1
+	want.ToStatus = status16(library.ReadingStatus_READING_STATUS_READING)
+	assertReadingEvents(t, fake, want)
+}
+
+func TestUpdateStatusRecordsStatusChange(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_TO_READ))
+
+	read := library.ReadingStatus_READING_STATUS_READ
+	_, err := s.UpdateLibraryArticle(ownerContext(7), &library.UpdateLibraryArticleRequest{Id: 5, ReadingStatus: &read})
+	assert.NoError(t, err)
+
+	want := readingEvent(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED)
+	want.FromStatus = status16(library.ReadingStatus_READING_STATUS_TO_READ)
+	want.ToStatus = status16(read)
+	assertReadingEvents(t, fake, want)
+}
+
+func TestUpdateToSameStatusRecordsNothing(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+
+	reading := library.ReadingStatus_READING_STATUS_READING
+	_, err := s.UpdateLibraryArticle(ownerContext(7), &library.UpdateLibraryArticleRequest{Id: 5, ReadingStatus: &reading})
+	assert.NoError(t, err)
+	assertReadingEvents(t, fake)
+	assert.Empty(t, fake.Calls("UpdateLibraryArticle"))
+}
+
+func TestRemoveRecordsRemovedEvent(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+
+	_, err := s.RemoveArticleFromLibrary(ownerContext(7), &library.RemoveArticleFromLibraryRequest{Id: 5})
+	assert.NoError(t, err)
+
+	want := readingEvent(library.ReadingEventType_READING_EVENT_TYPE_REMOVED)
+	want.FromStatus = status16(library.ReadingStatus_READING_STATUS_READING)
+	assertReadingEvents(t, fake, want)
+	assert.Len(t, fake.Calls("DeleteLibraryArticle"), 1)
+}
+
+func TestRecordOpenedRecordsOpenedEvent(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+
+	_, err := s.RecordArticleOpened(ownerContext(7), &library.RecordArticleOpenedRequest{Id: 5})
+	assert.NoError(t, err)
+	assertReadingEvents(t, fake, readingEvent(library.ReadingEventType_READING_EVENT_TYPE_OPENED))
+}
+
+func TestRecordOpenedRequiresOwner(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+
+	_, err := s.RecordArticleOpened(ownerContext(8), &library.RecordArticleOpenedRequest{Id: 5})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assertReadingEvents(t, fake)
+}
+
+func TestDeleteLibraryRecordsRemovedEvents(t *testing.T) {
+	s, fake := newTestService(t)
+	sub, _, err := s.events.subscribe(1, "")
+	assert.NoError(t, err)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+	fake.Return("ListLibraryArticlesByLibraryID",
+		db.ListLibraryArticlesByLibraryIDRow{ID: 5, ArticleID: 3, ReadingStatus: status16(library.ReadingStatus_READING_STATUS_READ)},
+		db.ListLibraryArticlesByLibraryIDRow{ID: 6, ArticleID: 4},
+	)
+
+	_, err = s.DeleteLibrary(ownerContext(8), &library.DeleteLibraryRequest{LibraryId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, fake.Calls("DeleteLibrary"))
+
+	_, err = s.DeleteLibrary(ownerContext(7), &library.DeleteLibraryRequest{LibraryId: 1})
+	assert.NoError(t, err)
+	if calls := fake.Calls("CreateReadingEvents"); assert.Len(t, calls, 1) {
+		removed := int8(library.ReadingEventType_READING_EVENT_TYPE_REMOVED)
+		assert.Equal(t, append(
+			dbtest.Values(db.CreateReadingEventParams{ProfileID: 7, LibraryID: 1, ArticleID: 3, LibraryArticleID: 5, EventType: removed, FromStatus: status16(library.ReadingStatus_READING_STATUS_READ)}),
+			dbtest.Values(db.CreateReadingEventParams{ProfileID: 7, LibraryID: 1, ArticleID: 4, LibraryArticleID: 6, EventType: removed})...,
+		), calls[0].Args)
+	}
+	assert.Len(t, fake.Calls("DeleteLibrary"), 1)
+	assert.Len(t, fake.Calls("COMMIT"), 1)
+
+	for _, id := range []int64{5, 6} {
+		event := <-sub.events
+		assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED, event.Type)
+		assert.Equal(t, id, event.LibraryArticleId)
+	}
+}
+
+func TestFailedEventRollsBackRemoval(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+	fake.Fail("CreateReadingEvent", errors.New("connection reset"))
+
+	_, err := s.RemoveArticleFromLibrary(ownerContext(7), &library.RemoveArticleFromLibraryRequest{Id: 5})
+	assert.Error(t, err)
+	assert.Len(t, fake.Calls("ROLLBACK"), 1)
+	assert.Empty(t, fake.Calls("COMMIT"))
+}
+
+// pageArgs picks the filters out of a ListLibraryArticlesPage call, whose
+// arguments repeat each filter.
+type pageArgs struct {
+	libraryID, readingStatus, isFavorite, query, sortOrder, limit, offset driver.Value
+}
+
+func listedPage(t *testing.T, fake *dbtest.Fake) pageArgs {
+	t.Helper()
+	calls := fake.Calls("ListLibraryArticlesPage")
+	if !assert.NotEmpty(t, calls) {
+		return pageArgs{}
+	}
+	args := calls[len(calls)-1].Args
+	return pageArgs{args[0], args[1], args[3], args[5], args[8], args[16], args[17]}
+}
+
+func pageRows(n int) []any {
+	rows := make([]any, n)
+	for i := range rows {
+		rows[i] = db.ListLibraryArticlesPageRow{ID: int64(i + 1), ArticleID: int64(100 + i), ArticleTitle: "Article"}
+	}
+	return rows
+}
+
+func TestLibraryEntriesMergedPublishesEvents(t *testing.T) {
+	s, fake := newTestService(t)
+	sub, _, err := s.events.subscribe(1, "")
+	assert.NoError(t, err)
+	fake.Return("GetLibraryArticleDetails", readingEntry(library.ReadingStatus_READING_STATUS_READING))
+
+	s.LibraryEntriesMerged(context.Background(), []int64{5}, []db.LibraryArticle{
+		{ID: 6, LibraryID: 1, ArticleID: 8, ReadingStatus: status16(library.ReadingStatus_READING_STATUS_TO_READ)},
+	})
+
+	removed := <-sub.events
+	assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED, removed.Type)
+	assert.Equal(t, int64(6), removed.LibraryArticleId)
+	assert.Equal(t, library.ReadingStatus_READING_STATUS_TO_READ, removed.FromStatus)
+	updated := <-sub.events
+	assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_UPDATED, updated.Type)
+	assert.Equal(t, int64(5), updated.LibraryArticleId)
+	assert.Equal(t, int64(3), updated.ArticleId)
+}
+
+func TestListLibraryArticlesFilters(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+	fake.Return("CountLibraryArticlesFiltered", int64(1))
+	fake.Return("ListLibraryArticlesPage", pageRows(1)...)
+
+	read := library.ReadingStatus_READING_STATUS_READ
+	favorite := true
+	query := "  50%_done "
+	response, err := s.ListLibraryArticles(ownerContext(7), &library.ListLibraryArticlesRequest{
+		LibraryId:     1,
+		SortBy:        library.LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_TITLE,
+		Descending:    true,
+		ReadingStatus: &read,
+		IsFavorite:    &favorite,
+		Query:         &query,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.Articles, 1)
+	assert.Equal(t, int64(1), response.TotalCount)
+	assert.Empty(t, response.NextPageToken)
+
+	assert.Equal(t, pageArgs{
+		libraryID:     int64(1),
+		readingStatus: int64(read),
+		isFavorite:    true,
+		query:         `%50\%\_done%`,
+		sortOrder:     "title_desc",
+		limit:         int64(defaultPageSize + 1),
+		offset:        int64(0),
+	}, listedPage(t, fake))
+
+	count := fake.Calls("CountLibraryArticlesFiltered")
+	if assert.Len(t, count, 1) {
+		assert.Equal(t, []driver.Value{int64(1), int64(read), int64(read), true, true, `%50\%\_done%`, `%50\%\_done%`, `%50\%\_done%`}, count[0].Args)
+	}
+}
+
+func TestListLibraryArticlesWithoutFilters(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+	fake.Return("CountLibraryArticlesFiltered", int64(0))
+
+	blank := "   "
+	_, err := s.ListLibraryArticles(ownerContext(7), &library.ListLibraryArticlesRequest{LibraryId: 1, Query: &blank, PageSize: 500})
+	assert.NoError(t, err)
+
+	page := listedPage(t, fake)
+	assert.Nil(t, page.readingStatus)
+	assert.Nil(t, page.isFavorite)
+	assert.Nil(t, page.query, "a blank query doesn't filter")
+	assert.Equal(t, "date_added_asc", page.sortOrder)
+	assert.Equal(t, int64(maxPageSize+1), page.limit)
+}
+
+func TestListLibraryArticlesPagination(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+	fake.Return("CountLibraryArticlesFiltered", int64(5))
+	fake.Return("ListLibraryArticlesPage", pageRows(3)...)
+
+	request := &library.ListLibraryArticlesRequest{LibraryId: 1, PageSize: 2}
+	first, err := s.ListLibraryArticles(ownerContext(7), request)
+	assert.NoError(t, err)
+	assert.Len(t, first.Articles, 2, "the extra row only signals another page")
+	assert.NotEmpty(t, first.NextPageToken)
+	assert.Equal(t, int64(3), listedPage(t, fake).limit)
+
+	request.PageToken = first.NextPageToken
+	fake.Return("ListLibraryArticlesPage", pageRows(2)...)
+	second, err := s.ListLibraryArticles(ownerContext(7), request)
+	assert.NoError(t, err)
+	assert.Len(t, second.Articles, 2)
+	assert.Empty(t, second.NextPageToken)
+	assert.Equal(t, int64(2), listedPage(t, fake).offset)
+
+	// The token is bound to the sort and filters it was issued for.
+	request.SortBy = library.LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_TITLE
+	_, err = s.ListLibraryArticles(ownerContext(7), request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListLibraryArticlesUnknownLibrary(t *testing.T) {
+	s, _ := newTestService(t)
+
+	_, err := s.ListLibraryArticles(ownerContext(7), &library.ListLibraryArticlesRequest{LibraryId: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListFlaggedArticlesUsesCallerProfile(t *testing.T) {
+	s, fake := newTestService(t)
+
+	_, err := s.ListFlaggedArticlesInMyLibraries(ownerContext(7), &library.ListFlaggedArticlesInMyLibrariesRequest{})
+	assert.NoError(t, err)
+	if calls := fake.Calls("ListFlaggedLibraryArticlesForOwner"); assert.Len(t, calls, 1) {
+		assert.Equal(t, int64(7), calls[0].Args[0])
+	}
+
+	anonymous := auth.WithPrincipal(context.Background(), auth.Anonymous())
+	_, err = s.ListFlaggedArticlesInMyLibraries(anonymous, &library.ListFlaggedArticlesInMyLibrariesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAddByIdentifierSavesToCallerLibraries(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 8})
+	libraryID := int64(1)
+	request := &library.AddByIdentifierRequest{Identifier: "10.1000/xyz123", LibraryId: &libraryID}
+
+	_, err := s.AddByIdentifier(ownerContext(7), request)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	anonymous := auth.WithPrincipal(context.Background(), auth.Anonymous())
+	_, err = s.AddByIdentifier(anonymous, request)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// watchStream collects the events sent to a watcher, which goes away after
+// the first one.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	events []*library.LibraryEvent
+}
+
+func newWatchStream(ctx context.Context) *watchStream {
+	ctx, cancel := context.WithCancel(ctx)
+	return &watchStream{ctx: ctx, cancel: cancel}
+}
+
+func (w *watchStream) Context() context.Context { return w.ctx }
+
+func (w *watchStream) Send(event *library.LibraryEvent) error {
+	w.events = append(w.events, event)
+	w.cancel()
+	return nil
+}
+
+func TestWatchPrivateLibraryRequiresOwner(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+	request := &library.WatchLibraryRequest{LibraryId: 1}
+
+	err := s.WatchLibrary(request, newWatchStream(ownerContext(8)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	stream := newWatchStream(ownerContext(7))
+	assert.NoError(t, s.WatchLibrary(request, stream))
+	if assert.Len(t, stream.events, 1) {
+		assert.Equal(t, library.LibraryEventType_LIBRARY_EVENT_TYPE_CAUGHT_UP, stream.events[0].Type)
+	}
+}
+
+func TestPrivateLibraryIsHiddenFromOtherUsers(t *testing.T) {
+	s, fake := newTestService(t)
+	fake.Return("GetLibrary", db.Library{ID: 1, OwnerID: 7})
+
+	_, err := s.GetLibrary(ownerContext(8), &library.GetLibraryRequest{LibraryId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ListLibraryArticles(ownerContext(8), &library.ListLibraryArticlesRequest{LibraryId: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, fake.Calls("ListLibraryArticlesPage"))
+
+	fake.Return("CountLibraryArticlesFiltered", int64(0))
+	_, err = s.ListLibraryArticles(ownerContext(7), &library.ListLibraryArticlesRequest{LibraryId: 1})
+	assert.NoError(t, err)
+}
+
+func TestCreateLibraryWithoutDescription(t *testing.T) {
+	s, fake := newTestService(t)
+
+	_, err := s.CreateLibrary(ownerContext(7), &library.CreateLibraryRequest{OwnerId: 7, Name: "Reading list"})
+	assert.NoError(t, err)
+	if calls := fake.Calls("CreateLibrary"); assert.Len(t, calls, 1) {
+		assert.Nil(t, calls[0].Args[2], "the description is NULL")
+	}
+}
@@ -0,0 +1,92 @@
+package library
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+)
+
+const (
+	defaultPageSize = 25
+	maxPageSize     = 100
+)
+
+func normalizePageSize(size int32) int32 {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
+
+// sortOrder maps the requested sort field and direction to the key understood
+// by the ListLibraryArticlesPage query.
+func sortOrder(field library.LibraryArticleSortField, descending bool) string {
+	var key string
+	switch field {
+	case library.LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_TITLE:
+		key = "title"
+	case library.LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_PUBLICATION_YEAR:
+		key = "year"
+	case library.LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_READING_PROGRESS:
+		key = "progress"
+	default:
+		key = "date_added"
+	}
+	if descending {
+		return key + "_desc"
+	}
+	return key + "_asc"
+}
+
+// Page tokens are opaque to clients. They carry the offset of the next page
+// and a fingerprint of the query, so a token can't be replayed against a
+// different sort or filter.
+func encodePageToken(offset int32, request *library.ListLibraryArticlesRequest) string {
+	raw := fmt.Sprintf("%d:%d", offset, queryFingerprint(request))
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string, request *library.ListLibraryArticlesRequest) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	offsetPart, fingerprintPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return 0, fmt.Errorf("malformed page token")
+	}
+	offset, err := strconv.ParseInt(offsetPart, 10, 32)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("malformed page token offset")
+	}
+	fingerprint, err := strconv.ParseUint(fingerprintPart, 10, 64)
+	if err != nil || fingerprint != queryFingerprint(request) {
+		return 0, fmt.Errorf("page token does not match request")
+	}
+	return int32(offset), nil
+}
+
+func queryFingerprint(request *library.ListLibraryArticlesRequest) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d|%d|%t", request.LibraryId, request.SortBy, request.Descending)
+	if request.ReadingStatus != nil {
+		fmt.Fprintf(h, "|s%d", *request.ReadingStatus)
+	}
+	if request.IsFavorite != nil {
+		fmt.Fprintf(h, "|f%t", *request.IsFavorite)
+	}
+	if request.Query != nil {
+		fmt.Fprintf(h, "|q%s", strings.TrimSpace(*request.Query))
+	}
+	return h.Sum64()
+}
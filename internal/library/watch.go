@@ -0,0 +1,105 @@
+package library
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchLibrary streams the library's events until the client goes away. The
+// owner can watch any of their libraries, anyone else only public ones.
+func (s *LibraryService) WatchLibrary(request *library.WatchLibraryRequest, stream library.LibraryService_WatchLibraryServer) error {
+	ctx := stream.Context()
+
+	lib, err := s.repo.GetLibrary(ctx, request.LibraryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "library not found")
+		}
+		slog.Error("failed to get library", "error", err)
+		return status.Error(codes.Internal, "failed to get library")
+	}
+	if err := checkReadable(ctx, lib); err != nil {
+		return err
+	}
+
+	sub, missed, err := s.events.subscribe(lib.ID, request.GetResumeToken())
+	switch {
+	case errors.Is(err, errResumeTooOld):
+		return status.Error(codes.OutOfRange, "resume token is too old; reload the library and watch again")
+	case errors.Is(err, errMalformedToken):
+		return status.Error(codes.InvalidArgument, "invalid resume token")
+	case err != nil:
+		return status.Error(codes.Internal, "failed to watch library")
+	}
+	defer s.events.unsubscribe(sub)
+
+	for _, event := range missed {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.events:
+			if !ok {
+				if sub.lagged {
+					return status.Error(codes.Aborted, "watcher fell behind; reconnect with the last resume token")
+				}
+				return nil
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// LibraryEntriesMerged tells watchers about library entries an article
+// merge changed: updated entries now hold the surviving article, removed
+// ones were folded into another entry of the same library.
+func (s *LibraryService) LibraryEntriesMerged(ctx context.Context, updated []int64, removed []db.LibraryArticle) {
+	for _, entry := range removed {
+		s.events.publish(&library.LibraryEvent{
+			Type:             library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED,
+			LibraryId:        entry.LibraryID,
+			LibraryArticleId: entry.ID,
+			ArticleId:        entry.ArticleID,
+			FromStatus:       library.ReadingStatus(entry.ReadingStatus.Int16),
+		})
+	}
+	for _, id := range updated {
+		s.publishEntryEvent(ctx, library.LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_UPDATED, id, library.ReadingStatus_READING_STATUS_UNSPECIFIED)
+	}
+}
+
+// publishEntryEvent publishes an event carrying the library article as it
+// is now.
+func (s *LibraryService) publishEntryEvent(ctx context.Context, eventType library.LibraryEventType, id int64, fromStatus library.ReadingStatus) {
+	entry, err := s.getLibraryArticleDetails(ctx, id)
+	if err != nil {
+		// The change is stored; watchers only miss the notification.
+		slog.Warn("failed to load library article for event", "id", id, "error", err)
+		return
+	}
+	s.publishEntry(eventType, entry, fromStatus)
+}
+
+func (s *LibraryService) publishEntry(eventType library.LibraryEventType, entry db.GetLibraryArticleDetailsRow, fromStatus library.ReadingStatus) {
+	s.events.publish(&library.LibraryEvent{
+		Type:             eventType,
+		LibraryId:        entry.LibraryID,
+		LibraryArticleId: entry.ID,
+		ArticleId:        entry.ArticleID,
+		LibraryArticle:   libraryArticleDetailsToGrpc(entry),
+		FromStatus:       fromStatus,
+	})
+}
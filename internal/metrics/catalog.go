@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// catalogCacheTTL keeps frequent scrapes from counting the tables each
+	// time.
+	catalogCacheTTL = time.Minute
+	catalogTimeout  = 5 * time.Second
+)
+
+var (
+	articlesDesc  = prometheus.NewDesc(namespace+"_articles", "Articles in the catalog.", nil, nil)
+	librariesDesc = prometheus.NewDesc(namespace+"_libraries", "Libraries of all users.", nil, nil)
+	profilesDesc  = prometheus.NewDesc(namespace+"_profiles", "Registered profiles.", nil, nil)
+)
+
+// catalogCollector reports how many articles, libraries and profiles there
+// are, counted when scraped.
+type catalogCollector struct {
+	queries *db.Queries
+	now     func() time.Time
+
+	mu        sync.Mutex
+	counts    db.CountCatalogRow
+	countedAt time.Time
+}
+
+func newCatalogCollector(conn *sql.DB) *catalogCollector {
+	return &catalogCollector{
+		queries: db.New(conn), // not traced, or every scrape would start a trace
+		now:     time.Now,
+	}
+}
+
+func (c *catalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- articlesDesc
+	ch <- librariesDesc
+	ch <- profilesDesc
+}
+
+func (c *catalogCollector) Collect(ch chan<- prometheus.Metric) {
+	counts, ok := c.count()
+	if !ok {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(articlesDesc, prometheus.GaugeValue, float64(counts.Articles))
+	ch <- prometheus.MustNewConstMetric(librariesDesc, prometheus.GaugeValue, float64(counts.Libraries))
+	ch <- prometheus.MustNewConstMetric(profilesDesc, prometheus.GaugeValue, float64(counts.Profiles))
+}
+
+// count returns the cached counts, refreshing them when they are stale. The
+// gauges are left out of the scrape if they could never be counted.
+func (c *catalogCollector) count() (db.CountCatalogRow, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.countedAt.IsZero() && c.now().Sub(c.countedAt) < catalogCacheTTL {
+		return c.counts, true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), catalogTimeout)
+	defer cancel()
+	counts, err := c.queries.CountCatalog(ctx)
+	if err != nil {
+		slog.Warn("failed to count catalog", "error", err)
+		return c.counts, !c.countedAt.IsZero()
+	}
+	c.counts = counts
+	c.countedAt = c.now()
+	return counts, true
+}
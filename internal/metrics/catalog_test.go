@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func catalogGauges(articles, libraries, profiles string) *strings.Reader {
+	return strings.NewReader(`
+# HELP journalful_articles Articles in the catalog.
+# TYPE journalful_articles gauge
+journalful_articles ` + articles + `
+# HELP journalful_libraries Libraries of all users.
+# TYPE journalful_libraries gauge
+journalful_libraries ` + libraries + `
+# HELP journalful_profiles Registered profiles.
+# TYPE journalful_profiles gauge
+journalful_profiles ` + profiles + `
+`)
+}
+
+func TestCatalogCollectorCachesCounts(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.ReturnOnce("CountCatalog", db.CountCatalogRow{Articles: 12, Libraries: 3, Profiles: 4})
+	fake.Return("CountCatalog", db.CountCatalogRow{Articles: 13, Libraries: 3, Profiles: 5})
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	collector := newCatalogCollector(conn)
+	collector.now = func() time.Time { return now }
+
+	assert.NoError(t, testutil.CollectAndCompare(collector, catalogGauges("12", "3", "4")))
+	now = now.Add(catalogCacheTTL / 2)
+	assert.NoError(t, testutil.CollectAndCompare(collector, catalogGauges("12", "3", "4")))
+	assert.Len(t, fake.Calls("CountCatalog"), 1, "a fresh count is reused")
+
+	now = now.Add(catalogCacheTTL)
+	assert.NoError(t, testutil.CollectAndCompare(collector, catalogGauges("13", "3", "5")))
+	assert.Len(t, fake.Calls("CountCatalog"), 2)
+}
+
+func TestCatalogCollectorKeepsLastCountOnError(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Fail("CountCatalog", errors.New("connection refused"))
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	collector := newCatalogCollector(conn)
+	collector.now = func() time.Time { return now }
+
+	assert.Equal(t, 0, testutil.CollectAndCount(collector), "nothing to report before the first count")
+
+	collector.counts = db.CountCatalogRow{Articles: 12, Libraries: 3, Profiles: 4}
+	collector.countedAt = now
+	now = now.Add(2 * catalogCacheTTL)
+	assert.NoError(t, testutil.CollectAndCompare(collector, catalogGauges("12", "3", "4")))
+}
@@ -0,0 +1,101 @@
+// Package metrics holds the Prometheus metrics served on the admin port.
+package metrics
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "journalful"
+
+// Results of a call to a metadata provider.
+const (
+	ResultSuccess  = "success"
+	ResultNotFound = "not_found"
+	ResultError    = "error"
+)
+
+// Registry holds everything that is exported. It is used instead of the
+// global default registry so libraries can't add metrics behind our back.
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Completed gRPC calls by method and status code.",
+	}, []string{"service", "method", "type", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken by gRPC calls, for streams until they close.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "type"})
+
+	metadataRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "metadata",
+		Name:      "requests_total",
+		Help:      "Calls to article metadata providers by result.",
+	}, []string{"provider", "result"})
+	metadataDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "metadata",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken by calls to article metadata providers.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"provider"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcDuration,
+		metadataRequests,
+		metadataDuration,
+	)
+}
+
+// ObserveRPC records a finished call. streaming tells unary calls and
+// streams apart, as their durations are not comparable.
+func ObserveRPC(fullMethod string, streaming bool, code codes.Code, duration time.Duration) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		service, method = "unknown", fullMethod
+	}
+	callType := "unary"
+	if streaming {
+		callType = "stream"
+	}
+	rpcRequests.WithLabelValues(service, method, callType, code.String()).Inc()
+	rpcDuration.WithLabelValues(service, method, callType).Observe(duration.Seconds())
+}
+
+// ObserveMetadataRequest records a call to a metadata provider such as
+// CrossRef. result is one of ResultSuccess, ResultNotFound or ResultError.
+func ObserveMetadataRequest(provider, result string, duration time.Duration) {
+	metadataRequests.WithLabelValues(provider, result).Inc()
+	metadataDuration.WithLabelValues(provider).Observe(duration.Seconds())
+}
+
+// RegisterDB exports the connection pool statistics of conn and the catalog
+// size gauges read from it.
+func RegisterDB(conn *sql.DB) error {
+	if err := Registry.Register(collectors.NewDBStatsCollector(conn, namespace)); err != nil {
+		return fmt.Errorf("failed to register database stats: %w", err)
+	}
+	if err := Registry.Register(newCatalogCollector(conn)); err != nil {
+		return fmt.Errorf("failed to register catalog metrics: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// sample returns the value of a counter, or the number of observations of a
+// histogram, as gathered from Registry. Series that were never recorded
+// read as 0.
+func sample(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := Registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			if len(metric.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+			if histogram := metric.GetHistogram(); histogram != nil {
+				return float64(histogram.GetSampleCount())
+			}
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestObserveRPC(t *testing.T) {
+	notFound := map[string]string{"service": "test.v1.TestService", "method": "Get", "type": "unary", "code": "NotFound"}
+	ok := map[string]string{"service": "test.v1.TestService", "method": "Get", "type": "unary", "code": "OK"}
+	unary := map[string]string{"service": "test.v1.TestService", "method": "Get", "type": "unary"}
+	stream := map[string]string{"service": "test.v1.TestService", "method": "Watch", "type": "stream"}
+	before := map[string]float64{
+		"notFound": sample(t, "journalful_grpc_requests_total", notFound),
+		"ok":       sample(t, "journalful_grpc_requests_total", ok),
+		"unary":    sample(t, "journalful_grpc_request_duration_seconds", unary),
+		"stream":   sample(t, "journalful_grpc_request_duration_seconds", stream),
+	}
+
+	ObserveRPC("/test.v1.TestService/Get", false, codes.NotFound, 20*time.Millisecond)
+	ObserveRPC("/test.v1.TestService/Get", false, codes.NotFound, 30*time.Millisecond)
+	ObserveRPC("/test.v1.TestService/Get", false, codes.OK, time.Millisecond)
+	ObserveRPC("/test.v1.TestService/Watch", true, codes.OK, time.Minute)
+
+	assert.Equal(t, before["notFound"]+2, sample(t, "journalful_grpc_requests_total", notFound))
+	assert.Equal(t, before["ok"]+1, sample(t, "journalful_grpc_requests_total", ok))
+	assert.Equal(t, before["unary"]+3, sample(t, "journalful_grpc_request_duration_seconds", unary))
+	assert.Equal(t, before["stream"]+1, sample(t, "journalful_grpc_request_duration_seconds", stream))
+}
+
+func TestObserveRPCWithoutService(t *testing.T) {
+	labels := map[string]string{"service": "unknown", "method": "bogus", "type": "unary", "code": "Internal"}
+	before := sample(t, "journalful_grpc_requests_total", labels)
+
+	ObserveRPC("bogus", false, codes.Internal, time.Millisecond)
+
+	assert.Equal(t, before+1, sample(t, "journalful_grpc_requests_total", labels))
+}
+
+func TestObserveMetadataRequest(t *testing.T) {
+	notFound := map[string]string{"provider": "test", "result": ResultNotFound}
+	calls := map[string]string{"provider": "test"}
+	beforeNotFound := sample(t, "journalful_metadata_requests_total", notFound)
+	beforeCalls := sample(t, "journalful_metadata_request_duration_seconds", calls)
+
+	ObserveMetadataRequest("test", ResultNotFound, time.Second)
+	ObserveMetadataRequest("test", ResultSuccess, time.Second)
+
+	assert.Equal(t, beforeNotFound+1, sample(t, "journalful_metadata_requests_total", notFound))
+	assert.Equal(t, beforeCalls+2, sample(t, "journalful_metadata_request_duration_seconds", calls))
+}
@@ -14,7 +14,7 @@ type AuthorService struct {
 
 func NewAuthorService(conn *sql.DB) *AuthorService {
 	return &AuthorService{
-		queries: db.New(conn),
+		queries: db.NewTraced(conn),
 	}
 }
 
@@ -75,7 +75,7 @@ func (a *AuthorService) UpdateAuthor(ctx context.Context, request *profile.Updat
 	err := a.queries.UpdateAuthor(ctx, db.UpdateAuthorParams{
 		ID:        request.Id,
 		Name:      request.Name,
-		ProfileID: sql.NullInt64{Int64: *request.ProfileId, Valid: request.ProfileId != nil},
+		ProfileID: sql.NullInt64{Int64: request.GetProfileId(), Valid: request.ProfileId != nil},
 	})
 	if err != nil {
 		return nil, err
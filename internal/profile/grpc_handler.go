@@ -27,10 +27,6 @@ func (p ProfileGrpcHandler) ListProfiles(ctx context.Context, request *profile.L
 }
 
 func (p ProfileGrpcHandler) CreateProfile(ctx context.Context, request *profile.CreateProfileRequest) (*profile.CreateProfileResponse, error) {
-	if request == nil || request.Name == "" {
-		return nil, ErrInvalidRequest
-	}
-
 	result, err := p.profileService.CreateProfile(ctx, request)
 	if err != nil {
 		return nil, err
@@ -40,10 +36,6 @@ func (p ProfileGrpcHandler) CreateProfile(ctx context.Context, request *profile.
 }
 
 func (p ProfileGrpcHandler) UpdateProfile(ctx context.Context, request *profile.UpdateProfileRequest) (*profile.UpdateProfileResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
-
 	result, err := p.profileService.UpdateProfile(ctx, request)
 	if err != nil {
 		return nil, err
@@ -53,10 +45,6 @@ func (p ProfileGrpcHandler) UpdateProfile(ctx context.Context, request *profile.
 }
 
 func (p ProfileGrpcHandler) DeleteProfile(ctx context.Context, request *profile.DeleteProfileRequest) (*profile.DeleteProfileResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
-
 	result, err := p.profileService.DeleteProfile(ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -71,9 +59,6 @@ func (p ProfileGrpcHandler) mustEmbedUnimplementedProfileServiceServer() {
 }
 
 func (p ProfileGrpcHandler) GetAuthor(ctx context.Context, request *profile.GetAuthorRequest) (*profile.GetAuthorResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
 	authorData, err := p.authorService.GetAuthor(ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -82,9 +67,6 @@ func (p ProfileGrpcHandler) GetAuthor(ctx context.Context, request *profile.GetA
 }
 
 func (p ProfileGrpcHandler) GetAuthorByProfileID(ctx context.Context, request *profile.GetAuthorByProfileIDRequest) (*profile.GetAuthorByProfileIDResponse, error) {
-	if request == nil || request.ProfileId == 0 {
-		return nil, ErrInvalidRequest
-	}
 	authorData, err := p.authorService.GetAuthorByProfileID(ctx, request.ProfileId)
 	if err != nil {
 		return nil, err
@@ -97,10 +79,6 @@ func (p ProfileGrpcHandler) ListAuthors(ctx context.Context, request *profile.Li
 }
 
 func (p ProfileGrpcHandler) CreateAuthor(ctx context.Context, request *profile.CreateAuthorRequest) (*profile.CreateAuthorResponse, error) {
-	if request == nil || request.Name == "" {
-		return nil, ErrInvalidRequest
-	}
-
 	result, err := p.authorService.CreateAuthor(ctx, request)
 	if err != nil {
 		return nil, err
@@ -110,10 +88,6 @@ func (p ProfileGrpcHandler) CreateAuthor(ctx context.Context, request *profile.C
 }
 
 func (p ProfileGrpcHandler) UpdateAuthor(ctx context.Context, request *profile.UpdateAuthorRequest) (*profile.UpdateAuthorResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
-
 	result, err := p.authorService.UpdateAuthor(ctx, request)
 	if err != nil {
 		return nil, err
@@ -123,9 +97,6 @@ func (p ProfileGrpcHandler) UpdateAuthor(ctx context.Context, request *profile.U
 }
 
 func (p ProfileGrpcHandler) DeleteAuthor(ctx context.Context, request *profile.DeleteAuthorRequest) (*profile.DeleteAuthorResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
 	return p.authorService.DeleteAuthor(ctx, request.Id)
 }
 
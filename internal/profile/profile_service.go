@@ -3,20 +3,21 @@ package profile
 import (
 	"context"
 	"database/sql"
-	"errors"
-	"fmt"
+	"github.com/chiquitav2/journalful/internal/apierror"
+	"github.com/chiquitav2/journalful/internal/auth"
 	"github.com/chiquitav2/journalful/internal/db"
 	"github.com/chiquitav2/journalful/pkg/profile/v1"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
-	// ErrInvalidRequest is returned when the request is invalid
-	ErrInvalidRequest = errors.New("invalid request")
 	// ErrProfileNotFound is returned when the profile is not found
-	ErrProfileNotFound = errors.New("profile not found")
+	ErrProfileNotFound = apierror.New(codes.NotFound, "profile not found")
 	// ErrAuthorNotFound is returned when the author is not found
-	ErrAuthorNotFound = fmt.Errorf("author not found")
+	ErrAuthorNotFound = apierror.New(codes.NotFound, "author not found")
+	// ErrUnauthenticated is returned when the request carries no authenticated user
+	ErrUnauthenticated = apierror.New(codes.Unauthenticated, "authentication is required")
 )
 
 type ProfileService struct {
@@ -25,12 +26,15 @@ type ProfileService struct {
 
 func NewProfileService(conn *sql.DB) *ProfileService {
 	return &ProfileService{
-		queries: db.New(conn),
+		queries: db.NewTraced(conn),
 	}
 }
 
 func (p *ProfileService) GetProfile(ctx context.Context) (*profile.GetProfileResponse, error) {
-	userID := ctx.Value("userID").(string)
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
 
 	// Fetch the profile from the database
 	profileData, err := p.queries.GetProfileByUserID(ctx, userID)
@@ -44,13 +48,16 @@ func (p *ProfileService) GetProfile(ctx context.Context) (*profile.GetProfileRes
 }
 
 func (p *ProfileService) CreateProfile(ctx context.Context, request *profile.CreateProfileRequest) (*profile.CreateProfileResponse, error) {
-	userID := ctx.Value("userID").(string)
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
 
 	result, err := p.queries.CreateProfile(ctx, db.CreateProfileParams{
 		UserID:      userID,
 		Name:        request.Name,
-		Bio:         sql.NullString{String: *request.Bio, Valid: request.Bio != nil},
-		Institution: sql.NullString{String: *request.Institution, Valid: request.Institution != nil},
+		Bio:         sql.NullString{String: request.GetBio(), Valid: request.Bio != nil},
+		Institution: sql.NullString{String: request.GetInstitution(), Valid: request.Institution != nil},
 	})
 	if err != nil {
 		return nil, err
@@ -65,15 +72,11 @@ func (p *ProfileService) CreateProfile(ctx context.Context, request *profile.Cre
 }
 
 func (p *ProfileService) UpdateProfile(ctx context.Context, request *profile.UpdateProfileRequest) (*profile.UpdateProfileResponse, error) {
-	if request == nil || request.Id == 0 {
-		return nil, ErrInvalidRequest
-	}
-
 	err := p.queries.UpdateProfile(ctx, db.UpdateProfileParams{
 		ID:          request.Id,
 		Name:        request.Name,
-		Bio:         sql.NullString{String: *request.Bio, Valid: request.Bio != nil},
-		Institution: sql.NullString{String: *request.Institution, Valid: request.Institution != nil},
+		Bio:         sql.NullString{String: request.GetBio(), Valid: request.Bio != nil},
+		Institution: sql.NullString{String: request.GetInstitution(), Valid: request.Institution != nil},
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,37 @@
+package profile
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/profile/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileOptionalFieldsCanBeOmitted(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := NewProfileService(conn)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-1", ProfileID: 5})
+
+	_, err := s.CreateProfile(ctx, &profile.CreateProfileRequest{Name: "Ada"})
+	assert.NoError(t, err)
+	if calls := fake.Calls("CreateProfile"); assert.Len(t, calls, 1) {
+		assert.Equal(t, []driver.Value{"user-1", "Ada", nil, nil}, calls[0].Args)
+	}
+
+	_, err = s.UpdateProfile(ctx, &profile.UpdateProfileRequest{Id: 5, Name: "Ada"})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("UpdateProfile"), 1)
+}
+
+func TestUpdateAuthorWithoutProfile(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := NewAuthorService(conn)
+
+	_, err := s.UpdateAuthor(context.Background(), &profile.UpdateAuthorRequest{Id: 3, Name: "A. Lovelace"})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("UpdateAuthor"), 1)
+}
@@ -0,0 +1,97 @@
+package recommendation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+)
+
+type reasonPart struct {
+	reason recommendation.RecommendationReason
+	weight float64
+	text   string
+}
+
+// explain lists the reasons behind a recommendation, strongest first, with
+// a sentence describing them.
+func explain(row db.ArticleRecommendation) ([]recommendation.RecommendationReason, string) {
+	s := signals{
+		sharedAuthors:      int(row.SharedAuthors),
+		sharedTags:         int(row.SharedTags),
+		coCitations:        int(row.CoCitations),
+		coOccurrences:      int(row.CoOccurrences),
+		abstractSimilarity: row.AbstractSimilarity,
+	}
+	var parts []reasonPart
+	if s.sharedAuthors > 0 {
+		parts = append(parts, reasonPart{
+			recommendation.RecommendationReason_RECOMMENDATION_REASON_SHARED_AUTHORS,
+			signals{sharedAuthors: s.sharedAuthors}.score(),
+			fmt.Sprintf("shares %s", plural(s.sharedAuthors, "author")),
+		})
+	}
+	if s.sharedTags > 0 {
+		parts = append(parts, reasonPart{
+			recommendation.RecommendationReason_RECOMMENDATION_REASON_SHARED_TAGS,
+			signals{sharedTags: s.sharedTags}.score(),
+			fmt.Sprintf("shares %s", plural(s.sharedTags, "tag")),
+		})
+	}
+	if s.coCitations > 0 {
+		parts = append(parts, reasonPart{
+			recommendation.RecommendationReason_RECOMMENDATION_REASON_CO_CITATION,
+			signals{coCitations: s.coCitations}.score(),
+			fmt.Sprintf("is cited together with it by %s", plural(s.coCitations, "article")),
+		})
+	}
+	if s.coOccurrences > 0 {
+		parts = append(parts, reasonPart{
+			recommendation.RecommendationReason_RECOMMENDATION_REASON_CO_OCCURRENCE,
+			signals{coOccurrences: s.coOccurrences}.score(),
+			fmt.Sprintf("appears alongside it in %s", plural(s.coOccurrences, "public library")),
+		})
+	}
+	if s.abstractSimilarity > 0 {
+		parts = append(parts, reasonPart{
+			recommendation.RecommendationReason_RECOMMENDATION_REASON_SIMILAR_ABSTRACT,
+			signals{abstractSimilarity: s.abstractSimilarity}.score(),
+			"has a similar abstract",
+		})
+	}
+	sort.SliceStable(parts, func(i, j int) bool { return parts[i].weight > parts[j].weight })
+
+	reasons := make([]recommendation.RecommendationReason, len(parts))
+	texts := make([]string, len(parts))
+	for i, p := range parts {
+		reasons[i] = p.reason
+		texts[i] = p.text
+	}
+	return reasons, joinSentence(texts)
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	if strings.HasSuffix(noun, "y") {
+		return fmt.Sprintf("%d %sies", n, strings.TrimSuffix(noun, "y"))
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// joinSentence joins phrases as "a, b and c" and capitalises the result.
+func joinSentence(phrases []string) string {
+	var sentence string
+	switch len(phrases) {
+	case 0:
+		return ""
+	case 1:
+		sentence = phrases[0]
+	default:
+		sentence = strings.Join(phrases[:len(phrases)-1], ", ") + " and " + phrases[len(phrases)-1]
+	}
+	return strings.ToUpper(sentence[:1]) + sentence[1:]
+}
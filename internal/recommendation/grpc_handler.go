@@ -0,0 +1,27 @@
+package recommendation
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+)
+
+type GrpcHandler struct {
+	recommendation.UnimplementedRecommendationServiceServer
+	service RecommendationServiceInterface
+}
+
+func NewRecommendationGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewRecommendationService(conn),
+	}
+}
+
+func (h *GrpcHandler) GetSimilarArticles(ctx context.Context, request *recommendation.GetSimilarArticlesRequest) (*recommendation.GetSimilarArticlesResponse, error) {
+	return h.service.GetSimilarArticles(ctx, request)
+}
+
+func (h *GrpcHandler) GetReadingRecommendations(ctx context.Context, request *recommendation.GetReadingRecommendationsRequest) (*recommendation.GetReadingRecommendationsResponse, error) {
+	return h.service.GetReadingRecommendations(ctx, request)
+}
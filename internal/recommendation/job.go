@@ -0,0 +1,165 @@
+package recommendation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+)
+
+const (
+	// refreshInterval is how old recommendations get before they are
+	// recomputed.
+	refreshInterval = 6 * time.Hour
+	// checkInterval is how often each instance checks whether they are due.
+	checkInterval = 15 * time.Minute
+	// lockName is the MySQL user lock held while recomputing, so only one
+	// instance does it at a time.
+	lockName = "journalful.recommendations"
+)
+
+// Job recomputes the article_recommendations table in the background. The
+// whole table is replaced in one transaction, so readers never see a
+// half-written set.
+type Job struct {
+	conn    *sql.DB
+	queries *db.Queries
+}
+
+func NewJob(conn *sql.DB) *Job {
+	return &Job{
+		conn:    conn,
+		queries: db.NewTraced(conn),
+	}
+}
+
+// Run recomputes recommendations whenever they are older than
+// refreshInterval until ctx is cancelled. Instances share the work: the one
+// that gets the lock recomputes and the others find fresh recommendations.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		j.refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh recomputes recommendations if they are due and no other instance
+// is recomputing them.
+func (j *Job) refresh(ctx context.Context) {
+	_, err := db.WithLock(ctx, j.conn, lockName, func() error {
+		recent, err := j.queries.HasRecentRecommendations(ctx, int64(refreshInterval/time.Second))
+		if err != nil {
+			return fmt.Errorf("failed to check recommendations age: %w", err)
+		}
+		if recent {
+			return nil
+		}
+		started := time.Now()
+		n, err := j.Recompute(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("recomputed recommendations", "articles", n, "duration", time.Since(started))
+		return nil
+	})
+	if err != nil {
+		slog.Error("failed to recompute recommendations", "error", err)
+	}
+}
+
+// Recompute rebuilds all recommendations and returns the number of articles
+// that have any.
+func (j *Job) Recompute(ctx context.Context) (int, error) {
+	in, err := j.loadInputs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	candidates := compute(in)
+
+	var rows []db.CreateRecommendationParams
+	for articleID, list := range candidates {
+		for _, c := range list {
+			rows = append(rows, db.CreateRecommendationParams{
+				ArticleID:            articleID,
+				RecommendedArticleID: c.articleID,
+				Score:                c.score,
+				SharedAuthors:        int32(c.signals.sharedAuthors),
+				SharedTags:           int32(c.signals.sharedTags),
+				CoCitations:          int32(c.signals.coCitations),
+				CoOccurrences:        int32(c.signals.coOccurrences),
+				AbstractSimilarity:   c.signals.abstractSimilarity,
+			})
+		}
+	}
+
+	err = db.WithTx(ctx, j.conn, func(q *db.Queries) error {
+		if err := q.DeleteAllRecommendations(ctx); err != nil {
+			return err
+		}
+		return q.CreateRecommendations(ctx, rows)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(candidates), nil
+}
+
+func (j *Job) loadInputs(ctx context.Context) (inputs, error) {
+	in := inputs{
+		authors:   make(map[int64][]int64),
+		tags:      make(map[int64][]int64),
+		citations: make(map[int64][]int64),
+		libraries: make(map[int64][]int64),
+		abstracts: make(map[int64]string),
+	}
+
+	authors, err := j.queries.ListAllArticleAuthors(ctx)
+	if err != nil {
+		return in, fmt.Errorf("failed to list article authors: %w", err)
+	}
+	for _, row := range authors {
+		in.authors[row.AuthorID] = append(in.authors[row.AuthorID], row.ArticleID)
+	}
+
+	tags, err := j.queries.ListAllArticleTags(ctx)
+	if err != nil {
+		return in, fmt.Errorf("failed to list article tags: %w", err)
+	}
+	for _, row := range tags {
+		in.tags[row.TagID] = append(in.tags[row.TagID], row.ArticleID)
+	}
+
+	references, err := j.queries.ListResolvedReferences(ctx)
+	if err != nil {
+		return in, fmt.Errorf("failed to list references: %w", err)
+	}
+	for _, row := range references {
+		in.citations[row.CitingArticleID] = append(in.citations[row.CitingArticleID], row.CitedArticleID)
+	}
+
+	libraryArticles, err := j.queries.ListPublicLibraryArticles(ctx)
+	if err != nil {
+		return in, fmt.Errorf("failed to list public library articles: %w", err)
+	}
+	for _, row := range libraryArticles {
+		in.libraries[row.LibraryID] = append(in.libraries[row.LibraryID], row.ArticleID)
+	}
+
+	abstracts, err := j.queries.ListArticleAbstracts(ctx)
+	if err != nil {
+		return in, fmt.Errorf("failed to list abstracts: %w", err)
+	}
+	for _, row := range abstracts {
+		in.abstracts[row.ID] = row.Abstract.String
+	}
+	return in, nil
+}
@@ -0,0 +1,209 @@
+package recommendation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	article "github.com/chiquitav2/journalful/pkg/articles/v1"
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 50
+	// seedRowsPerResult is how many stored recommendations are read per
+	// requested result when combining the suggestions of many library articles.
+	seedRowsPerResult = 10
+)
+
+type RecommendationServiceInterface interface {
+	GetSimilarArticles(ctx context.Context, request *recommendation.GetSimilarArticlesRequest) (*recommendation.GetSimilarArticlesResponse, error)
+	GetReadingRecommendations(ctx context.Context, request *recommendation.GetReadingRecommendationsRequest) (*recommendation.GetReadingRecommendationsResponse, error)
+}
+
+type RecommendationService struct {
+	queries *db.Queries
+}
+
+func NewRecommendationService(conn *sql.DB) *RecommendationService {
+	return &RecommendationService{
+		queries: db.NewTraced(conn),
+	}
+}
+
+func (s *RecommendationService) GetSimilarArticles(ctx context.Context, request *recommendation.GetSimilarArticlesRequest) (*recommendation.GetSimilarArticlesResponse, error) {
+	if _, err := s.queries.GetArticle(ctx, request.ArticleId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "article not found")
+		}
+		slog.Error("failed to get article", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get article")
+	}
+
+	rows, err := s.queries.ListSimilarArticles(ctx, db.ListSimilarArticlesParams{
+		ArticleID:      request.ArticleId,
+		ExcludeOwnerID: sql.NullInt64{Int64: request.UserId, Valid: request.ExcludeSaved},
+		Limit:          pageSize(request.PageSize),
+	})
+	if err != nil {
+		slog.Error("failed to list similar articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list similar articles")
+	}
+
+	recommendations := make([]*recommendation.Recommendation, len(rows))
+	for i, row := range rows {
+		reasons, explanation := explain(row.ArticleRecommendation)
+		recommendations[i] = &recommendation.Recommendation{
+			Article:             dbToGrpcArticle(row.Article),
+			Score:               row.ArticleRecommendation.Score,
+			Reasons:             reasons,
+			Explanation:         explanation,
+			BecauseOfArticleIds: []int64{request.ArticleId},
+			ComputedAt:          timestamppb.New(row.ArticleRecommendation.ComputedAt.Time),
+		}
+	}
+	return &recommendation.GetSimilarArticlesResponse{Recommendations: recommendations}, nil
+}
+
+func (s *RecommendationService) GetReadingRecommendations(ctx context.Context, request *recommendation.GetReadingRecommendationsRequest) (*recommendation.GetReadingRecommendationsResponse, error) {
+	limit := pageSize(request.PageSize)
+
+	rows, err := s.queries.ListRecommendationsForOwner(ctx, db.ListRecommendationsForOwnerParams{
+		OwnerID: request.UserId,
+		Limit:   limit * seedRowsPerResult,
+	})
+	if err != nil {
+		slog.Error("failed to list recommendations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list recommendations")
+	}
+
+	combined := combineSeeds(rows)
+	if len(combined) > int(limit) {
+		combined = combined[:limit]
+	}
+	if len(combined) == 0 {
+		return &recommendation.GetReadingRecommendationsResponse{}, nil
+	}
+
+	ids := make([]int64, len(combined))
+	for i, c := range combined {
+		ids[i] = c.ArticleID
+	}
+	articles, err := s.queries.ListArticlesByIDs(ctx, ids)
+	if err != nil {
+		slog.Error("failed to list articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list recommendations")
+	}
+	byID := make(map[int64]db.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+
+	var recommendations []*recommendation.Recommendation
+	for _, c := range combined {
+		a, ok := byID[c.ArticleID]
+		if !ok {
+			continue // deleted since the recommendations were computed
+		}
+		c.Recommendation.Article = dbToGrpcArticle(a)
+		recommendations = append(recommendations, c.Recommendation)
+	}
+	return &recommendation.GetReadingRecommendationsResponse{Recommendations: recommendations}, nil
+}
+
+type combinedRecommendation struct {
+	ArticleID      int64
+	Recommendation *recommendation.Recommendation
+}
+
+// combineSeeds merges the recommendations of several library articles that
+// point at the same article, adding up their scores. The explanation is
+// taken from the strongest seed.
+func combineSeeds(rows []db.ListRecommendationsForOwnerRow) []combinedRecommendation {
+	var combined []combinedRecommendation
+	index := make(map[int64]int)
+	titles := make(map[int64]string)
+	for _, row := range rows {
+		r := row.ArticleRecommendation
+		i, ok := index[r.RecommendedArticleID]
+		if !ok {
+			// Rows come ordered by score, so the first seed is the strongest.
+			reasons, explanation := explain(r)
+			i = len(combined)
+			index[r.RecommendedArticleID] = i
+			titles[r.RecommendedArticleID] = row.SeedTitle
+			combined = append(combined, combinedRecommendation{
+				ArticleID: r.RecommendedArticleID,
+				Recommendation: &recommendation.Recommendation{
+					Reasons:     reasons,
+					Explanation: explanation,
+					ComputedAt:  timestamppb.New(r.ComputedAt.Time),
+				},
+			})
+		}
+		rec := combined[i].Recommendation
+		rec.Score += r.Score
+		rec.BecauseOfArticleIds = append(rec.BecauseOfArticleIds, r.ArticleID)
+	}
+
+	for _, c := range combined {
+		rec := c.Recommendation
+		seeds := fmt.Sprintf("%q", titles[c.ArticleID])
+		if extra := len(rec.BecauseOfArticleIds) - 1; extra > 0 {
+			seeds += fmt.Sprintf(" and %d more", extra)
+		}
+		rec.Explanation = fmt.Sprintf("Because you saved %s: %s", seeds, lowerFirst(rec.Explanation))
+	}
+	sort.SliceStable(combined, func(i, j int) bool {
+		return combined[i].Recommendation.Score > combined[j].Recommendation.Score
+	})
+	return combined
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func pageSize(requested int32) int32 {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+	if requested > maxPageSize {
+		return maxPageSize
+	}
+	return requested
+}
+
+func dbToGrpcArticle(a db.Article) *article.Article {
+	grpcArticle := &article.Article{
+		Id:        a.ID,
+		Doi:       a.Doi,
+		Title:     a.Title,
+		Url:       a.Url.String,
+		Flag:      article.ArticleFlag(a.Flag),
+		CreatedAt: timestamppb.New(a.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(a.UpdatedAt.Time),
+	}
+	if a.Abstract.Valid {
+		grpcArticle.Abstract = &a.Abstract.String
+	}
+	if a.PublicationYear.Valid {
+		grpcArticle.PublicationYear = &a.PublicationYear.Int32
+	}
+	if a.JournalName.Valid {
+		grpcArticle.JournalName = &a.JournalName.String
+	}
+	return grpcArticle
+}
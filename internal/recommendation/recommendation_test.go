@@ -0,0 +1,153 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/recommendation/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := tokenize("<jats:p>The Protein folding of proteins, in 3D.</jats:p>")
+	assert.Equal(t, []string{"protein", "folding", "proteins"}, tokens)
+}
+
+func TestAbstractSimilarities(t *testing.T) {
+	sims := abstractSimilarities(map[int64]string{
+		1: "protein folding dynamics simulated with molecular dynamics",
+		2: "molecular dynamics of protein folding pathways",
+		3: "galaxy rotation curves and dark matter halos",
+		4: "dark matter halos in dwarf galaxy surveys",
+		5: "unrelated essay on medieval poetry",
+	})
+
+	assert.Greater(t, sims[newPair(1, 2)], minSimilarity)
+	assert.Greater(t, sims[newPair(3, 4)], minSimilarity)
+	_, related := sims[newPair(1, 3)]
+	assert.False(t, related)
+	_, related = sims[newPair(2, 5)]
+	assert.False(t, related)
+}
+
+func TestCompute(t *testing.T) {
+	candidates := compute(inputs{
+		authors:   map[int64][]int64{100: {1, 2}, 101: {1, 2, 1}},
+		tags:      map[int64][]int64{200: {1, 3}},
+		citations: map[int64][]int64{9: {2, 3}},
+		libraries: map[int64][]int64{},
+		abstracts: map[int64]string{},
+	})
+
+	if assert.Len(t, candidates[1], 2) {
+		assert.Equal(t, int64(2), candidates[1][0].articleID)
+		assert.Equal(t, 2, candidates[1][0].signals.sharedAuthors)
+		assert.Equal(t, int64(3), candidates[1][1].articleID)
+		assert.Equal(t, 1, candidates[1][1].signals.sharedTags)
+	}
+	if assert.Len(t, candidates[3], 2) {
+		assert.Equal(t, int64(2), candidates[3][0].articleID)
+		assert.Equal(t, 1, candidates[3][0].signals.coCitations)
+	}
+}
+
+func TestCompute_SkipsLargeGroups(t *testing.T) {
+	huge := make([]int64, maxGroupSize+1)
+	for i := range huge {
+		huge[i] = int64(i + 1)
+	}
+	candidates := compute(inputs{tags: map[int64][]int64{1: huge}})
+	assert.Empty(t, candidates)
+}
+
+func TestExplain(t *testing.T) {
+	reasons, explanation := explain(db.ArticleRecommendation{
+		SharedTags:         1,
+		SharedAuthors:      2,
+		CoOccurrences:      3,
+		AbstractSimilarity: 0.2,
+	})
+
+	assert.Equal(t, []recommendation.RecommendationReason{
+		recommendation.RecommendationReason_RECOMMENDATION_REASON_SHARED_AUTHORS,
+		recommendation.RecommendationReason_RECOMMENDATION_REASON_CO_OCCURRENCE,
+		recommendation.RecommendationReason_RECOMMENDATION_REASON_SHARED_TAGS,
+		recommendation.RecommendationReason_RECOMMENDATION_REASON_SIMILAR_ABSTRACT,
+	}, reasons)
+	assert.Equal(t, "Shares 2 authors, appears alongside it in 3 public libraries, shares 1 tag and has a similar abstract", explanation)
+}
+
+func TestCombineSeeds(t *testing.T) {
+	combined := combineSeeds([]db.ListRecommendationsForOwnerRow{
+		{ArticleRecommendation: db.ArticleRecommendation{ArticleID: 1, RecommendedArticleID: 10, Score: 3, SharedAuthors: 1}, SeedTitle: "Seed A"},
+		{ArticleRecommendation: db.ArticleRecommendation{ArticleID: 2, RecommendedArticleID: 20, Score: 2.5, SharedTags: 2}, SeedTitle: "Seed B"},
+		{ArticleRecommendation: db.ArticleRecommendation{ArticleID: 2, RecommendedArticleID: 10, Score: 1, SharedTags: 1}, SeedTitle: "Seed B"},
+	})
+
+	if assert.Len(t, combined, 2) {
+		assert.Equal(t, int64(10), combined[0].ArticleID)
+		assert.Equal(t, 4.0, combined[0].Recommendation.Score)
+		assert.Equal(t, []int64{1, 2}, combined[0].Recommendation.BecauseOfArticleIds)
+		assert.Equal(t, `Because you saved "Seed A" and 1 more: shares 1 author`, combined[0].Recommendation.Explanation)
+		assert.Equal(t, int64(20), combined[1].ArticleID)
+	}
+}
+
+func TestAbstractSimilarities_CapsPostings(t *testing.T) {
+	abstracts := make(map[int64]string)
+	for i := int64(1); i <= 3*maxGroupSize; i++ {
+		// A second term shared by pairs of abstracts lowers the weight of
+		// the common one.
+		abstracts[i] = fmt.Sprintf("chromatography sample%d", i/2)
+	}
+	for i := int64(3*maxGroupSize + 1); i <= 7*maxGroupSize; i++ {
+		abstracts[i] = "unrelated filler"
+	}
+	abstracts[1] = "chromatography"
+	abstracts[2] = "chromatography"
+
+	sims := abstractSimilarities(abstracts)
+	assert.InDelta(t, 1.0, sims[newPair(1, 2)], 1e-9, "the abstracts the term weighs most in are kept")
+	assert.LessOrEqual(t, len(sims), maxGroupSize*(maxGroupSize-1)/2+3*maxGroupSize/2)
+}
+
+func TestRefreshRecomputesInBatches(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("GetLock", int64(1))
+	fake.Return("ReleaseLock", int64(1))
+	fake.Return("HasRecentRecommendations", false)
+	fake.Return("ListAllArticleAuthors",
+		db.ListAllArticleAuthorsRow{ArticleID: 1, AuthorID: 100},
+		db.ListAllArticleAuthorsRow{ArticleID: 2, AuthorID: 100})
+
+	NewJob(conn).refresh(context.Background())
+	assert.Len(t, fake.Calls("DeleteAllRecommendations"), 1)
+	if calls := fake.Calls("CreateRecommendations"); assert.Len(t, calls, 1) {
+		assert.Len(t, calls[0].Args, 2*8, "both directions go in one statement")
+	}
+	assert.Len(t, fake.Calls("COMMIT"), 1)
+	assert.Len(t, fake.Calls("ReleaseLock"), 1)
+}
+
+func TestRefreshSkipsFreshRecommendations(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("GetLock", int64(1))
+	fake.Return("ReleaseLock", int64(1))
+	fake.Return("HasRecentRecommendations", true)
+
+	NewJob(conn).refresh(context.Background())
+	assert.Empty(t, fake.Calls("ListAllArticleAuthors"))
+	assert.Empty(t, fake.Calls("DeleteAllRecommendations"))
+}
+
+func TestRefreshSkipsWhileAnotherInstanceRecomputes(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	fake.Return("GetLock", int64(0))
+
+	NewJob(conn).refresh(context.Background())
+	assert.Empty(t, fake.Calls("HasRecentRecommendations"))
+	assert.Empty(t, fake.Calls("DeleteAllRecommendations"))
+}
@@ -0,0 +1,254 @@
+package recommendation
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxGroupSize skips authors, tags, citing articles and libraries with
+	// more articles than this when pairing them up: they are too generic to
+	// say much about two articles and would make pairing quadratic. It also
+	// caps the abstracts pairing up on one term.
+	maxGroupSize = 200
+	// maxPerArticle is how many recommendations are kept per article.
+	maxPerArticle = 20
+	minScore      = 0.5
+	// minSimilarity drops abstract similarities that are mostly noise.
+	minSimilarity = 0.1
+)
+
+// Weights of the individual signals. Shared authors are the strongest hint;
+// similarity is scaled up since cosine similarity rarely exceeds 0.5.
+const (
+	authorWeight       = 3.0
+	tagWeight          = 1.0
+	coCitationWeight   = 2.0
+	coOccurrenceWeight = 1.5
+	similarityWeight   = 4.0
+)
+
+// signals are the reasons two articles are related.
+type signals struct {
+	sharedAuthors      int
+	sharedTags         int
+	coCitations        int
+	coOccurrences      int
+	abstractSimilarity float64
+}
+
+func (s signals) score() float64 {
+	return authorWeight*float64(s.sharedAuthors) +
+		tagWeight*float64(s.sharedTags) +
+		coCitationWeight*math.Log1p(float64(s.coCitations)) +
+		coOccurrenceWeight*math.Log1p(float64(s.coOccurrences)) +
+		similarityWeight*s.abstractSimilarity
+}
+
+// pair is an unordered pair of article ids with a < b.
+type pair struct{ a, b int64 }
+
+func newPair(x, y int64) pair {
+	if x > y {
+		x, y = y, x
+	}
+	return pair{x, y}
+}
+
+// inputs holds everything the recommendations are computed from. The group
+// maps go from an author, tag, citing article or public library to the
+// articles it belongs to.
+type inputs struct {
+	authors   map[int64][]int64
+	tags      map[int64][]int64
+	citations map[int64][]int64
+	libraries map[int64][]int64
+	abstracts map[int64]string
+}
+
+type candidate struct {
+	articleID int64
+	signals   signals
+	score     float64
+}
+
+// compute scores all related article pairs and returns the best candidates
+// for each article.
+func compute(in inputs) map[int64][]candidate {
+	pairs := make(map[pair]*signals)
+	get := func(p pair) *signals {
+		s, ok := pairs[p]
+		if !ok {
+			s = &signals{}
+			pairs[p] = s
+		}
+		return s
+	}
+
+	forEachPair(in.authors, func(p pair) { get(p).sharedAuthors++ })
+	forEachPair(in.tags, func(p pair) { get(p).sharedTags++ })
+	forEachPair(in.citations, func(p pair) { get(p).coCitations++ })
+	forEachPair(in.libraries, func(p pair) { get(p).coOccurrences++ })
+	for p, sim := range abstractSimilarities(in.abstracts) {
+		get(p).abstractSimilarity = sim
+	}
+
+	candidates := make(map[int64][]candidate)
+	for p, s := range pairs {
+		score := s.score()
+		if score < minScore {
+			continue
+		}
+		candidates[p.a] = append(candidates[p.a], candidate{articleID: p.b, signals: *s, score: score})
+		candidates[p.b] = append(candidates[p.b], candidate{articleID: p.a, signals: *s, score: score})
+	}
+	for id, list := range candidates {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].score != list[j].score {
+				return list[i].score > list[j].score
+			}
+			return list[i].articleID < list[j].articleID
+		})
+		if len(list) > maxPerArticle {
+			candidates[id] = list[:maxPerArticle]
+		}
+	}
+	return candidates
+}
+
+// forEachPair calls fn once per group for every pair of distinct articles in
+// that group.
+func forEachPair(groups map[int64][]int64, fn func(pair)) {
+	for _, articles := range groups {
+		articles = dedupe(articles)
+		if len(articles) > maxGroupSize {
+			continue
+		}
+		for i := range articles {
+			for j := i + 1; j < len(articles); j++ {
+				fn(newPair(articles[i], articles[j]))
+			}
+		}
+	}
+}
+
+func dedupe(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	out := ids[:0:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+var markupPattern = regexp.MustCompile(`<[^>]*>`)
+
+var stopWords = map[string]bool{
+	"and": true, "are": true, "but": true, "can": true, "for": true, "from": true,
+	"has": true, "have": true, "her": true, "his": true, "into": true, "its": true,
+	"not": true, "our": true, "that": true, "the": true, "their": true, "these": true,
+	"this": true, "those": true, "through": true, "was": true, "were": true, "which": true,
+	"while": true, "with": true, "within": true, "also": true, "been": true, "both": true,
+	"than": true, "then": true, "there": true, "they": true, "using": true, "used": true,
+	"may": true, "more": true, "most": true, "such": true, "all": true, "two": true,
+	"between": true, "here": true, "how": true, "what": true, "when": true, "where": true,
+	"who": true, "will": true, "would": true, "abstract": true, "study": true, "results": true,
+}
+
+// tokenize lower-cases an abstract and splits it into words, dropping
+// JATS markup that CrossRef abstracts carry, short words and stop words.
+func tokenize(text string) []string {
+	text = markupPattern.ReplaceAllString(text, " ")
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := words[:0]
+	for _, w := range words {
+		if len([]rune(w)) >= 3 && !stopWords[w] {
+			tokens = append(tokens, w)
+		}
+	}
+	return tokens
+}
+
+// abstractSimilarities returns the TF-IDF cosine similarity of every pair of
+// abstracts sharing a term, keeping those above minSimilarity. Terms found in
+// more than half of the abstracts are ignored, and a term only pairs up the
+// maxGroupSize abstracts it weighs most in, so similarities of pairs beyond
+// them are underestimated.
+func abstractSimilarities(abstracts map[int64]string) map[pair]float64 {
+	termCounts := make(map[int64]map[string]int, len(abstracts))
+	docFreq := make(map[string]int)
+	for id, text := range abstracts {
+		counts := make(map[string]int)
+		for _, t := range tokenize(text) {
+			counts[t]++
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		termCounts[id] = counts
+		for t := range counts {
+			docFreq[t]++
+		}
+	}
+
+	n := float64(len(termCounts))
+	type posting struct {
+		article int64
+		weight  float64
+	}
+	postings := make(map[string][]posting)
+	for id, counts := range termCounts {
+		vector := make(map[string]float64, len(counts))
+		var norm float64
+		for t, c := range counts {
+			df := docFreq[t]
+			if df < 2 || float64(df) > n/2 {
+				continue // unique to one abstract, or too common to tell them apart
+			}
+			w := float64(c) * math.Log(n/float64(df))
+			vector[t] = w
+			norm += w * w
+		}
+		if norm == 0 {
+			continue
+		}
+		norm = math.Sqrt(norm)
+		for t, w := range vector {
+			postings[t] = append(postings[t], posting{id, w / norm})
+		}
+	}
+
+	dots := make(map[pair]float64)
+	for _, list := range postings {
+		if len(list) > maxGroupSize {
+			// Keep the abstracts the term weighs most in, so pairing stays
+			// bounded however many abstracts share it.
+			sort.Slice(list, func(i, j int) bool {
+				if list[i].weight != list[j].weight {
+					return list[i].weight > list[j].weight
+				}
+				return list[i].article < list[j].article
+			})
+			list = list[:maxGroupSize]
+		}
+		for i := range list {
+			for j := i + 1; j < len(list); j++ {
+				dots[newPair(list[i].article, list[j].article)] += list[i].weight * list[j].weight
+			}
+		}
+	}
+	for p, sim := range dots {
+		if sim < minSimilarity {
+			delete(dots, p)
+		}
+	}
+	return dots
+}
@@ -0,0 +1,35 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/review/v1"
+)
+
+type GrpcHandler struct {
+	review.UnimplementedReviewServiceServer
+	service ReviewServiceInterface
+}
+
+func NewReviewGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewReviewService(conn),
+	}
+}
+
+func (h *GrpcHandler) ScheduleReview(ctx context.Context, request *review.ScheduleReviewRequest) (*review.ScheduleReviewResponse, error) {
+	return h.service.ScheduleReview(ctx, request)
+}
+
+func (h *GrpcHandler) CancelReview(ctx context.Context, request *review.CancelReviewRequest) (*review.CancelReviewResponse, error) {
+	return h.service.CancelReview(ctx, request)
+}
+
+func (h *GrpcHandler) GetReviewQueue(ctx context.Context, request *review.GetReviewQueueRequest) (*review.GetReviewQueueResponse, error) {
+	return h.service.GetReviewQueue(ctx, request)
+}
+
+func (h *GrpcHandler) RecordReview(ctx context.Context, request *review.RecordReviewRequest) (*review.RecordReviewResponse, error) {
+	return h.service.RecordReview(ctx, request)
+}
@@ -0,0 +1,258 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	internalstats "github.com/chiquitav2/journalful/internal/stats"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/review/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultQueueLimit = 20
+	maxQueueLimit     = 100
+)
+
+type ReviewServiceInterface interface {
+	ScheduleReview(ctx context.Context, request *review.ScheduleReviewRequest) (*review.ScheduleReviewResponse, error)
+	CancelReview(ctx context.Context, request *review.CancelReviewRequest) (*review.CancelReviewResponse, error)
+	GetReviewQueue(ctx context.Context, request *review.GetReviewQueueRequest) (*review.GetReviewQueueResponse, error)
+	RecordReview(ctx context.Context, request *review.RecordReviewRequest) (*review.RecordReviewResponse, error)
+}
+
+type ReviewService struct {
+	conn    *sql.DB
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewReviewService(conn *sql.DB) *ReviewService {
+	return &ReviewService{
+		conn:    conn,
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+func (s *ReviewService) today(timeZone string) (time.Time, error) {
+	loc, err := internalstats.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "unknown time zone %q", timeZone)
+	}
+	return internalstats.Today(s.now(), loc), nil
+}
+
+// getLibraryArticle loads an entry in one of the caller's libraries. Entries
+// in other users' libraries are not found, except by admins.
+func (s *ReviewService) getLibraryArticle(ctx context.Context, id int64) (db.GetLibraryArticleDetailsRow, error) {
+	row, err := s.queries.GetLibraryArticleDetails(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return row, status.Error(codes.NotFound, "library article not found")
+		}
+		slog.Error("failed to get library article", "error", err)
+		return row, status.Error(codes.Internal, "failed to get library article")
+	}
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if profileID, _ := auth.ProfileID(ctx); row.OwnerID != profileID && !principal.IsAdmin() {
+		return row, status.Error(codes.NotFound, "library article not found")
+	}
+	return row, nil
+}
+
+// ScheduleReview puts a read article on the review schedule, first due the
+// day after. Scheduling an article twice keeps its existing schedule.
+func (s *ReviewService) ScheduleReview(ctx context.Context, request *review.ScheduleReviewRequest) (*review.ScheduleReviewResponse, error) {
+	today, err := s.today(request.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.getLibraryArticle(ctx, request.LibraryArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if library.ReadingStatus(entry.ReadingStatus.Int16) != library.ReadingStatus_READING_STATUS_READ {
+		return nil, status.Error(codes.FailedPrecondition, "only read articles can be scheduled for review")
+	}
+
+	existing, err := s.queries.GetReviewSchedule(ctx, entry.ID)
+	if err == nil {
+		return &review.ScheduleReviewResponse{Schedule: dbToGrpcSchedule(existing)}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("failed to get review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get review schedule")
+	}
+
+	initial := newSchedule()
+	err = s.queries.CreateReviewSchedule(ctx, db.CreateReviewScheduleParams{
+		LibraryArticleID: entry.ID,
+		EaseFactor:       initial.easeFactor,
+		IntervalDays:     int32(initial.intervalDays),
+		Repetitions:      int32(initial.repetitions),
+		DueDate:          internalstats.DateIn(today.AddDate(0, 0, 1), time.UTC),
+	})
+	if err != nil {
+		slog.Error("failed to create review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create review schedule")
+	}
+
+	created, err := s.queries.GetReviewSchedule(ctx, entry.ID)
+	if err != nil {
+		slog.Error("failed to get review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get review schedule")
+	}
+	return &review.ScheduleReviewResponse{Schedule: dbToGrpcSchedule(created)}, nil
+}
+
+func (s *ReviewService) CancelReview(ctx context.Context, request *review.CancelReviewRequest) (*review.CancelReviewResponse, error) {
+	entry, err := s.getLibraryArticle(ctx, request.LibraryArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queries.DeleteReviewSchedule(ctx, entry.ID); err != nil {
+		slog.Error("failed to delete review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete review schedule")
+	}
+	return &review.CancelReviewResponse{Success: true}, nil
+}
+
+// GetReviewQueue lists the caller's articles due for review today or earlier.
+func (s *ReviewService) GetReviewQueue(ctx context.Context, request *review.GetReviewQueueRequest) (*review.GetReviewQueueResponse, error) {
+	today, err := s.today(request.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultQueueLimit
+	}
+	if limit > maxQueueLimit {
+		limit = maxQueueLimit
+	}
+	dueBy := internalstats.DateIn(today, time.UTC)
+
+	rows, err := s.queries.ListDueReviews(ctx, db.ListDueReviewsParams{
+		OwnerID: request.UserId,
+		DueDate: dueBy,
+		Limit:   limit,
+	})
+	if err != nil {
+		slog.Error("failed to list due reviews", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list due reviews")
+	}
+	total, err := s.queries.CountDueReviews(ctx, db.CountDueReviewsParams{
+		OwnerID: request.UserId,
+		DueDate: dueBy,
+	})
+	if err != nil {
+		slog.Error("failed to count due reviews", "error", err)
+		return nil, status.Error(codes.Internal, "failed to count due reviews")
+	}
+
+	items := make([]*review.ReviewItem, len(rows))
+	for i, row := range rows {
+		item := &review.ReviewItem{
+			Schedule:     dbToGrpcSchedule(row.ReviewSchedule),
+			LibraryId:    row.LibraryID,
+			ArticleId:    row.ArticleID,
+			ArticleTitle: row.ArticleTitle,
+			Doi:          row.Doi,
+		}
+		if row.Notes.Valid {
+			item.Notes = &row.Notes.String
+		}
+		items[i] = item
+	}
+
+	return &review.GetReviewQueueResponse{Items: items, TotalDue: total}, nil
+}
+
+// RecordReview applies a recall rating to a scheduled article and moves its
+// due date according to SM-2. The review is also logged as a reading event.
+func (s *ReviewService) RecordReview(ctx context.Context, request *review.RecordReviewRequest) (*review.RecordReviewResponse, error) {
+	today, err := s.today(request.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.getLibraryArticle(ctx, request.LibraryArticleId)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.queries.GetReviewSchedule(ctx, entry.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "article is not scheduled for review")
+		}
+		slog.Error("failed to get review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get review schedule")
+	}
+
+	updated := schedule{
+		easeFactor:   current.EaseFactor,
+		intervalDays: int(current.IntervalDays),
+		repetitions:  int(current.Repetitions),
+	}.next(int(request.Rating))
+
+	err = db.WithTx(ctx, s.conn, func(q *db.Queries) error {
+		err := q.UpdateReviewSchedule(ctx, db.UpdateReviewScheduleParams{
+			EaseFactor:       updated.easeFactor,
+			IntervalDays:     int32(updated.intervalDays),
+			Repetitions:      int32(updated.repetitions),
+			DueDate:          internalstats.DateIn(updated.dueDate(today), time.UTC),
+			LastReviewedAt:   sql.NullTime{Time: s.now(), Valid: true},
+			LastRating:       sql.NullInt16{Int16: int16(request.Rating), Valid: true},
+			LibraryArticleID: entry.ID,
+		})
+		if err != nil {
+			return err
+		}
+		return q.CreateReadingEvent(ctx, db.CreateReadingEventParams{
+			ProfileID:        entry.OwnerID,
+			LibraryID:        entry.LibraryID,
+			ArticleID:        entry.ArticleID,
+			LibraryArticleID: entry.ID,
+			EventType:        int8(library.ReadingEventType_READING_EVENT_TYPE_REVIEWED),
+		})
+	})
+	if err != nil {
+		slog.Error("failed to record review", "error", err)
+		return nil, status.Error(codes.Internal, "failed to record review")
+	}
+
+	reviewed, err := s.queries.GetReviewSchedule(ctx, entry.ID)
+	if err != nil {
+		slog.Error("failed to get review schedule", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get review schedule")
+	}
+	return &review.RecordReviewResponse{Schedule: dbToGrpcSchedule(reviewed)}, nil
+}
+
+func dbToGrpcSchedule(row db.ReviewSchedule) *review.ReviewSchedule {
+	grpcSchedule := &review.ReviewSchedule{
+		LibraryArticleId: row.LibraryArticleID,
+		EaseFactor:       row.EaseFactor,
+		IntervalDays:     row.IntervalDays,
+		Repetitions:      row.Repetitions,
+		DueDate:          timestamppb.New(row.DueDate),
+	}
+	if row.LastReviewedAt.Valid {
+		grpcSchedule.LastReviewedAt = timestamppb.New(row.LastReviewedAt.Time)
+	}
+	if row.LastRating.Valid {
+		rating := int32(row.LastRating.Int16)
+		grpcSchedule.LastRating = &rating
+	}
+	return grpcSchedule
+}
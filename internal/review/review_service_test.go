@@ -0,0 +1,44 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/chiquitav2/journalful/internal/auth"
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/internal/db/dbtest"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/review/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReviewsOfOtherUsersEntriesAreNotFound(t *testing.T) {
+	conn, fake := dbtest.Open(t)
+	s := NewReviewService(conn)
+	fake.Return("GetLibraryArticleDetails", db.GetLibraryArticleDetailsRow{
+		ID:            4,
+		LibraryID:     2,
+		ArticleID:     3,
+		ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true},
+		OwnerID:       7,
+	})
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "u", ProfileID: 8})
+
+	_, err := s.ScheduleReview(ctx, &review.ScheduleReviewRequest{LibraryArticleId: 4})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.RecordReview(ctx, &review.RecordReviewRequest{LibraryArticleId: 4, Rating: 5})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.CancelReview(ctx, &review.CancelReviewRequest{LibraryArticleId: 4})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, fake.Calls("CreateReviewSchedule"))
+	assert.Empty(t, fake.Calls("UpdateReviewSchedule"))
+	assert.Empty(t, fake.Calls("DeleteReviewSchedule"))
+
+	owner := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "o", ProfileID: 7})
+	_, err = s.CancelReview(owner, &review.CancelReviewRequest{LibraryArticleId: 4})
+	assert.NoError(t, err)
+	assert.Len(t, fake.Calls("DeleteReviewSchedule"), 1)
+}
@@ -0,0 +1,58 @@
+package review
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	initialEaseFactor = 2.5
+	minEaseFactor     = 1.3
+	// passingRating is the lowest rating that still counts as recalled.
+	passingRating = 3
+	maxRating     = 5
+)
+
+// schedule is the SM-2 state of one library article.
+type schedule struct {
+	easeFactor   float64
+	intervalDays int
+	repetitions  int
+}
+
+// newSchedule returns the state of an article that has never been reviewed.
+func newSchedule() schedule {
+	return schedule{easeFactor: initialEaseFactor}
+}
+
+// next applies a review with the given rating (0-5) and returns the updated
+// schedule. A failed recall starts the intervals over but keeps the lowered
+// ease factor, as in the original SM-2 algorithm.
+func (s schedule) next(rating int) schedule {
+	if rating >= passingRating {
+		switch s.repetitions {
+		case 0:
+			s.intervalDays = 1
+		case 1:
+			s.intervalDays = 6
+		default:
+			s.intervalDays = int(math.Round(float64(s.intervalDays) * s.easeFactor))
+		}
+		s.repetitions++
+	} else {
+		s.repetitions = 0
+		s.intervalDays = 1
+	}
+
+	miss := float64(maxRating - rating)
+	s.easeFactor += 0.1 - miss*(0.08+miss*0.02)
+	if s.easeFactor < minEaseFactor {
+		s.easeFactor = minEaseFactor
+	}
+	return s
+}
+
+// dueDate returns the date the next review is due after reviewing today.
+func (s schedule) dueDate(today time.Time) time.Time {
+	return today.AddDate(0, 0, s.intervalDays)
+}
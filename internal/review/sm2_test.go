@@ -0,0 +1,40 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleNextIntervals(t *testing.T) {
+	s := newSchedule()
+
+	s = s.next(5)
+	assert.Equal(t, 1, s.intervalDays)
+	assert.InDelta(t, 2.6, s.easeFactor, 1e-9)
+
+	s = s.next(4)
+	assert.Equal(t, 6, s.intervalDays)
+	assert.InDelta(t, 2.6, s.easeFactor, 1e-9)
+
+	s = s.next(3)
+	assert.Equal(t, 16, s.intervalDays) // 6 * 2.6 rounded
+	assert.InDelta(t, 2.46, s.easeFactor, 1e-9)
+	assert.Equal(t, 3, s.repetitions)
+}
+
+func TestScheduleNextFailedRecall(t *testing.T) {
+	s := schedule{easeFactor: 1.4, intervalDays: 20, repetitions: 4}
+
+	s = s.next(1)
+	assert.Equal(t, 0, s.repetitions)
+	assert.Equal(t, 1, s.intervalDays)
+	assert.Equal(t, minEaseFactor, s.easeFactor)
+}
+
+func TestScheduleDueDate(t *testing.T) {
+	s := schedule{intervalDays: 6}
+	today := time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC), s.dueDate(today))
+}
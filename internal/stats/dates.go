@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+)
+
+// EntryDates are the calendar dates, in the reader's time zone, on which
+// library entries were added and last marked read. They come from the
+// reading events, whose timestamps are instants; the dateAdded and
+// dateCompleted columns hold the server's UTC date.
+type EntryDates struct {
+	loc       *time.Location
+	added     map[int64]time.Time
+	completed map[int64]time.Time
+}
+
+// NewEntryDates reads the dates from events, which are ordered by time.
+func NewEntryDates(events []db.ListReadingEventTimesForOwnerRow, loc *time.Location) EntryDates {
+	d := EntryDates{loc: loc, added: make(map[int64]time.Time), completed: make(map[int64]time.Time)}
+	for _, e := range events {
+		if !e.OccurredAt.Valid {
+			continue
+		}
+		day := DateIn(e.OccurredAt.Time.In(loc), loc)
+		eventType := library.ReadingEventType(e.EventType)
+		if eventType == library.ReadingEventType_READING_EVENT_TYPE_ADDED {
+			d.added[e.LibraryArticleID] = day
+		}
+		if (eventType == library.ReadingEventType_READING_EVENT_TYPE_ADDED || eventType == library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED) &&
+			library.ReadingStatus(e.ToStatus.Int16) == library.ReadingStatus_READING_STATUS_READ {
+			d.completed[e.LibraryArticleID] = day
+		}
+	}
+	return d
+}
+
+// Added returns the date a was added. Entries from before the event log
+// fall back to their dateAdded column.
+func (d EntryDates) Added(a db.ListLibraryArticlesForOwnerRow) (time.Time, bool) {
+	if day, ok := d.added[a.ID]; ok {
+		return day, true
+	}
+	if !a.Dateadded.Valid {
+		return time.Time{}, false
+	}
+	return DateIn(a.Dateadded.Time, d.loc), true
+}
+
+// Completed returns the date a was last marked read, if it still is. Entries
+// from before the event log fall back to their dateCompleted column.
+func (d EntryDates) Completed(a db.ListLibraryArticlesForOwnerRow) (time.Time, bool) {
+	if !a.Datecompleted.Valid {
+		return time.Time{}, false
+	}
+	if day, ok := d.completed[a.ID]; ok {
+		return day, true
+	}
+	return DateIn(a.Datecompleted.Time, d.loc), true
+}
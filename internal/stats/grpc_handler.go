@@ -0,0 +1,23 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
+)
+
+type GrpcHandler struct {
+	stats.UnimplementedStatsServiceServer
+	service StatsServiceInterface
+}
+
+func NewStatsGrpcHandler(conn *sql.DB) *GrpcHandler {
+	return &GrpcHandler{
+		service: NewStatsService(conn),
+	}
+}
+
+func (h *GrpcHandler) GetReadingStats(ctx context.Context, request *stats.GetReadingStatsRequest) (*stats.GetReadingStatsResponse, error) {
+	return h.service.GetReadingStats(ctx, request)
+}
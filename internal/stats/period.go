@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
+)
+
+// bucket is one slot of the activity chart, covering [start, end).
+type bucket struct {
+	label string
+	start time.Time
+	end   time.Time
+}
+
+// LoadLocation resolves an IANA time zone name, treating an empty name as UTC.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// DateIn returns the calendar date of t as midnight in loc. DATE columns come
+// back from the driver as midnight UTC, so their calendar date is read as-is.
+func DateIn(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// Today returns the current calendar date in loc.
+func Today(now time.Time, loc *time.Location) time.Time {
+	return DateIn(now.In(loc), loc)
+}
+
+// DaysBetween returns the number of calendar days from one date to another,
+// unaffected by daylight saving transitions in between.
+func DaysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	return int(time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC).Sub(time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
+// periodBuckets returns the chart buckets of the calendar period containing
+// today: the days of the week (starting Monday), the weeks of the month or
+// the months of the year.
+func periodBuckets(period stats.StatsPeriod, today time.Time) []bucket {
+	loc := today.Location()
+	var buckets []bucket
+	switch period {
+	case stats.StatsPeriod_STATS_PERIOD_WEEK:
+		offset := (int(today.Weekday()) + 6) % 7 // days since Monday
+		start := today.AddDate(0, 0, -offset)
+		for i := 0; i < 7; i++ {
+			day := start.AddDate(0, 0, i)
+			buckets = append(buckets, bucket{label: day.Format("Mon"), start: day, end: day.AddDate(0, 0, 1)})
+		}
+	case stats.StatsPeriod_STATS_PERIOD_YEAR:
+		for m := time.January; m <= time.December; m++ {
+			start := time.Date(today.Year(), m, 1, 0, 0, 0, 0, loc)
+			buckets = append(buckets, bucket{label: start.Format("Jan"), start: start, end: start.AddDate(0, 1, 0)})
+		}
+	default:
+		monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, loc)
+		monthEnd := monthStart.AddDate(0, 1, 0)
+		for start := monthStart; start.Before(monthEnd); start = start.AddDate(0, 0, 7) {
+			end := start.AddDate(0, 0, 7)
+			if end.After(monthEnd) {
+				end = monthEnd
+			}
+			buckets = append(buckets, bucket{label: start.Format("Jan 2"), start: start, end: end})
+		}
+	}
+	return buckets
+}
+
+// bucketIndex returns the index of the bucket containing day, or -1.
+func bucketIndex(buckets []bucket, day time.Time) int {
+	for i, b := range buckets {
+		if !day.Before(b.start) && day.Before(b.end) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Streaks returns the current and longest runs of consecutive days in days.
+// The current streak ends today, or yesterday if nothing happened yet today.
+// All days must be midnights in the same location as today.
+func Streaks(days []time.Time, today time.Time) (current, longest int) {
+	if len(days) == 0 {
+		return 0, 0
+	}
+
+	seen := make(map[time.Time]bool, len(days))
+	var unique []time.Time
+	for _, d := range days {
+		if !seen[d] {
+			seen[d] = true
+			unique = append(unique, d)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool { return unique[i].Before(unique[j]) })
+
+	run := 0
+	for i, d := range unique {
+		if i > 0 && unique[i-1].AddDate(0, 0, 1).Equal(d) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+
+	day := today
+	if !seen[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	for seen[day] {
+		current++
+		day = day.AddDate(0, 0, -1)
+	}
+	return current, longest
+}
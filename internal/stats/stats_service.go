@@ -0,0 +1,264 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const topicLimit = 10
+
+type StatsServiceInterface interface {
+	GetReadingStats(ctx context.Context, request *stats.GetReadingStatsRequest) (*stats.GetReadingStatsResponse, error)
+}
+
+type StatsService struct {
+	queries *db.Queries
+	now     func() time.Time
+}
+
+func NewStatsService(conn *sql.DB) *StatsService {
+	return &StatsService{
+		queries: db.NewTraced(conn),
+		now:     time.Now,
+	}
+}
+
+func (s *StatsService) GetReadingStats(ctx context.Context, request *stats.GetReadingStatsRequest) (*stats.GetReadingStatsResponse, error) {
+	loc, err := LoadLocation(request.TimeZone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", request.TimeZone)
+	}
+
+	libraries, err := s.queries.ListLibrariesByUserID(ctx, request.UserId)
+	if err != nil {
+		slog.Error("failed to list libraries", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list libraries")
+	}
+
+	articles, err := s.queries.ListLibraryArticlesForOwner(ctx, request.UserId)
+	if err != nil {
+		slog.Error("failed to list library articles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list library articles")
+	}
+
+	events, err := s.queries.ListReadingEventTimesForOwner(ctx, request.UserId)
+	if err != nil {
+		slog.Error("failed to list reading events", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reading events")
+	}
+
+	tags, err := s.queries.CountTagsForOwner(ctx, db.CountTagsForOwnerParams{
+		OwnerID: request.UserId,
+		Limit:   topicLimit,
+	})
+	if err != nil {
+		slog.Error("failed to count tags", "error", err)
+		return nil, status.Error(codes.Internal, "failed to count tags")
+	}
+
+	return computeReadingStats(statsInput{
+		period:    request.Period,
+		today:     Today(s.now(), loc),
+		libraries: libraries,
+		articles:  articles,
+		events:    events,
+		tags:      tags,
+	}), nil
+}
+
+type statsInput struct {
+	period    stats.StatsPeriod
+	today     time.Time
+	libraries []db.Library
+	articles  []db.ListLibraryArticlesForOwnerRow
+	events    []db.ListReadingEventTimesForOwnerRow // Ordered by time
+	tags      []db.CountTagsForOwnerRow
+}
+
+// isReadingActivity reports whether an event shows the user actually reading,
+// as opposed to curating their libraries.
+func isReadingActivity(eventType library.ReadingEventType) bool {
+	switch eventType {
+	case library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED,
+		library.ReadingEventType_READING_EVENT_TYPE_PROGRESS_UPDATED,
+		library.ReadingEventType_READING_EVENT_TYPE_NOTE_EDITED,
+		library.ReadingEventType_READING_EVENT_TYPE_OPENED:
+		return true
+	}
+	return false
+}
+
+func computeReadingStats(in statsInput) *stats.GetReadingStatsResponse {
+	loc := in.today.Location()
+	buckets := periodBuckets(in.period, in.today)
+	periodStart, periodEnd := buckets[0].start, buckets[len(buckets)-1].end
+	inPeriod := func(day time.Time) bool {
+		return !day.Before(periodStart) && day.Before(periodEnd)
+	}
+
+	totals := &stats.ReadingTotals{}
+	activity := make([]*stats.ActivityBucket, len(buckets))
+	for i, b := range buckets {
+		activity[i] = &stats.ActivityBucket{Label: b.label, Start: timestamppb.New(b.start)}
+	}
+
+	// Walk the event log for activity days, the hour people read at and the
+	// first time each entry moved to reading.
+	var activityDays []time.Time
+	var hourCounts [24]int
+	startedAt := make(map[int64]time.Time)
+	for _, e := range in.events {
+		eventType := library.ReadingEventType(e.EventType)
+		occurred := e.OccurredAt.Time.In(loc)
+		if isReadingActivity(eventType) {
+			activityDays = append(activityDays, DateIn(occurred, loc))
+			hourCounts[occurred.Hour()]++
+		}
+		if eventType == library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED &&
+			library.ReadingStatus(e.ToStatus.Int16) == library.ReadingStatus_READING_STATUS_READING {
+			if _, ok := startedAt[e.LibraryArticleID]; !ok {
+				startedAt[e.LibraryArticleID] = DateIn(occurred, loc)
+			}
+		}
+	}
+
+	dates := NewEntryDates(in.events, loc)
+	libraryCounts := make(map[int64]int32)
+	libraryActivity := make(map[int64]int32)
+	completionsByWeekday := make(map[time.Weekday]int)
+	var completionDays []time.Time
+	var progressSum, progressCount int32
+	var completionSpanSum float64
+	var completionSpanCount int
+	var firstAdded time.Time
+
+	for _, a := range in.articles {
+		totals.TotalArticles++
+		libraryCounts[a.LibraryID]++
+
+		switch library.ReadingStatus(a.ReadingStatus.Int16) {
+		case library.ReadingStatus_READING_STATUS_READ:
+			totals.CompletedArticles++
+		case library.ReadingStatus_READING_STATUS_READING:
+			totals.InProgressArticles++
+			progressSum += a.ReadingProgress.Int32
+			progressCount++
+		case library.ReadingStatus_READING_STATUS_ABANDONED:
+			totals.AbandonedArticles++
+		default:
+			totals.ToReadArticles++
+		}
+
+		added, ok := dates.Added(a)
+		if ok {
+			if firstAdded.IsZero() || added.Before(firstAdded) {
+				firstAdded = added
+			}
+			if inPeriod(added) {
+				totals.NewThisPeriod++
+				libraryActivity[a.LibraryID]++
+			}
+		}
+
+		started, ok := startedAt[a.ID]
+		if !ok {
+			started = added
+		}
+		if !started.IsZero() && inPeriod(started) {
+			if i := bucketIndex(buckets, started); i >= 0 {
+				activity[i].Started++
+			}
+		}
+
+		completed, ok := dates.Completed(a)
+		if !ok {
+			continue
+		}
+		completionDays = append(completionDays, completed)
+		completionsByWeekday[completed.Weekday()]++
+		if !started.IsZero() && !completed.Before(started) {
+			completionSpanSum += float64(DaysBetween(started, completed))
+			completionSpanCount++
+		}
+		if inPeriod(completed) {
+			totals.CompletedThisPeriod++
+			libraryActivity[a.LibraryID]++
+			if i := bucketIndex(buckets, completed); i >= 0 {
+				activity[i].Completed++
+			}
+		}
+	}
+	if progressCount > 0 {
+		totals.AverageProgress = progressSum / progressCount
+	}
+
+	current, longest := Streaks(append(activityDays, completionDays...), in.today)
+
+	habits := &stats.ReadingHabits{}
+	if len(completionDays) > 0 {
+		weeks := float64(DaysBetween(firstAdded, in.today)) / 7
+		if weeks < 1 {
+			weeks = 1
+		}
+		habits.AveragePerWeek = float64(len(completionDays)) / weeks
+
+		best := time.Sunday
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if completionsByWeekday[day] > completionsByWeekday[best] {
+				best = day
+			}
+		}
+		habits.BestDay = best.String()
+	}
+	if completionSpanCount > 0 {
+		habits.AverageCompletionDays = completionSpanSum / float64(completionSpanCount)
+	}
+	if len(activityDays) > 0 {
+		preferred := 0
+		for hour, count := range hourCounts {
+			if count > hourCounts[preferred] {
+				preferred = hour
+			}
+		}
+		hour := int32(preferred)
+		habits.PreferredHour = &hour
+	}
+
+	topics := make([]*stats.TopicCount, 0, len(in.tags))
+	for _, t := range in.tags {
+		topics = append(topics, &stats.TopicCount{Name: t.Name, Count: int32(t.ArticleCount)})
+	}
+
+	overview := &stats.LibraryOverview{TotalLibraries: int32(len(in.libraries))}
+	for _, lib := range in.libraries {
+		if overview.Largest == nil || libraryCounts[lib.ID] > overview.Largest.Count {
+			overview.Largest = &stats.LibraryStat{LibraryId: lib.ID, Name: lib.Name.String, Count: libraryCounts[lib.ID]}
+		}
+		if libraryActivity[lib.ID] > 0 && (overview.MostActive == nil || libraryActivity[lib.ID] > overview.MostActive.Count) {
+			overview.MostActive = &stats.LibraryStat{LibraryId: lib.ID, Name: lib.Name.String, Count: libraryActivity[lib.ID]}
+		}
+	}
+	if totals.TotalArticles > 0 {
+		overview.CompletionRate = totals.CompletedArticles * 100 / totals.TotalArticles
+	}
+
+	return &stats.GetReadingStatsResponse{
+		Totals:      totals,
+		Streak:      &stats.ReadingStreak{CurrentDays: int32(current), LongestDays: int32(longest)},
+		Activity:    activity,
+		Topics:      topics,
+		Habits:      habits,
+		Libraries:   overview,
+		PeriodStart: timestamppb.New(periodStart),
+		PeriodEnd:   timestamppb.New(periodEnd),
+	}
+}
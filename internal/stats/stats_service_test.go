@@ -0,0 +1,163 @@
+package stats
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/chiquitav2/journalful/internal/db"
+	"github.com/chiquitav2/journalful/pkg/library/v1"
+	"github.com/chiquitav2/journalful/pkg/stats/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStreaks(t *testing.T) {
+	today := date(2025, 3, 10)
+	days := []time.Time{
+		date(2025, 3, 1), date(2025, 3, 2), date(2025, 3, 3), date(2025, 3, 4),
+		date(2025, 3, 8), date(2025, 3, 9), date(2025, 3, 9),
+	}
+
+	current, longest := Streaks(days, today)
+
+	// Nothing completed today yet, so the streak ending yesterday still counts.
+	assert.Equal(t, 2, current)
+	assert.Equal(t, 4, longest)
+
+	current, _ = Streaks(days, date(2025, 3, 11))
+	assert.Equal(t, 0, current)
+}
+
+func TestTodayRespectsTimeZone(t *testing.T) {
+	loc, err := LoadLocation("Pacific/Auckland")
+	assert.NoError(t, err)
+
+	// 20:00 UTC on March 9th is already March 10th in Auckland.
+	today := Today(time.Date(2025, 3, 9, 20, 0, 0, 0, time.UTC), loc)
+	assert.Equal(t, 10, today.Day())
+	assert.Equal(t, loc, today.Location())
+}
+
+func TestComputeReadingStatsWeek(t *testing.T) {
+	today := date(2025, 3, 12) // a Wednesday
+	read := func(added, completed time.Time) db.ListLibraryArticlesForOwnerRow {
+		return db.ListLibraryArticlesForOwnerRow{
+			LibraryID:     1,
+			ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true},
+			Dateadded:     sql.NullTime{Time: added, Valid: true},
+			Datecompleted: sql.NullTime{Time: completed, Valid: true},
+		}
+	}
+
+	response := computeReadingStats(statsInput{
+		period: stats.StatsPeriod_STATS_PERIOD_WEEK,
+		today:  today,
+		libraries: []db.Library{
+			{ID: 1, Name: sql.NullString{String: "Thesis", Valid: true}},
+			{ID: 2, Name: sql.NullString{String: "Empty", Valid: true}},
+		},
+		articles: []db.ListLibraryArticlesForOwnerRow{
+			read(date(2025, 3, 1), date(2025, 3, 11)),
+			read(date(2025, 3, 10), date(2025, 3, 12)),
+			{
+				LibraryID:       1,
+				ReadingStatus:   sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READING), Valid: true},
+				ReadingProgress: sql.NullInt32{Int32: 40, Valid: true},
+				Dateadded:       sql.NullTime{Time: date(2025, 3, 10), Valid: true},
+			},
+		},
+	})
+
+	assert.Equal(t, int32(3), response.Totals.TotalArticles)
+	assert.Equal(t, int32(2), response.Totals.CompletedArticles)
+	assert.Equal(t, int32(1), response.Totals.InProgressArticles)
+	assert.Equal(t, int32(40), response.Totals.AverageProgress)
+	assert.Equal(t, int32(2), response.Totals.NewThisPeriod)
+	assert.Equal(t, int32(2), response.Totals.CompletedThisPeriod)
+
+	assert.Len(t, response.Activity, 7)
+	assert.Equal(t, "Mon", response.Activity[0].Label)
+	assert.Equal(t, int32(2), response.Activity[0].Started)
+	assert.Equal(t, int32(1), response.Activity[1].Completed)
+	assert.Equal(t, int32(1), response.Activity[2].Completed)
+
+	assert.Equal(t, int32(2), response.Streak.CurrentDays)
+	assert.Equal(t, "Thesis", response.Libraries.Largest.Name)
+	assert.Equal(t, int32(66), response.Libraries.CompletionRate)
+	assert.Equal(t, 6.0, response.Habits.AverageCompletionDays)
+}
+
+func TestComputeReadingStatsUsesEvents(t *testing.T) {
+	loc, err := LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	today := time.Date(2025, 3, 12, 0, 0, 0, 0, loc)
+
+	event := func(eventType library.ReadingEventType, toStatus library.ReadingStatus, at time.Time) db.ListReadingEventTimesForOwnerRow {
+		return db.ListReadingEventTimesForOwnerRow{
+			LibraryArticleID: 7,
+			EventType:        int8(eventType),
+			ToStatus:         sql.NullInt16{Int16: int16(toStatus), Valid: toStatus != library.ReadingStatus_READING_STATUS_UNSPECIFIED},
+			OccurredAt:       sql.NullTime{Time: at, Valid: true},
+		}
+	}
+
+	response := computeReadingStats(statsInput{
+		period: stats.StatsPeriod_STATS_PERIOD_MONTH,
+		today:  today,
+		articles: []db.ListLibraryArticlesForOwnerRow{{
+			ID:            7,
+			LibraryID:     1,
+			ReadingStatus: sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true},
+			Dateadded:     sql.NullTime{Time: date(2025, 2, 1), Valid: true},
+			Datecompleted: sql.NullTime{Time: date(2025, 3, 11), Valid: true},
+		}},
+		events: []db.ListReadingEventTimesForOwnerRow{
+			// 01:30 UTC on March 10th is still the evening of March 9th in New York.
+			event(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED, library.ReadingStatus_READING_STATUS_READING, time.Date(2025, 3, 10, 1, 30, 0, 0, time.UTC)),
+			event(library.ReadingEventType_READING_EVENT_TYPE_OPENED, library.ReadingStatus_READING_STATUS_UNSPECIFIED, time.Date(2025, 3, 11, 1, 0, 0, 0, time.UTC)),
+			event(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED, library.ReadingStatus_READING_STATUS_READ, time.Date(2025, 3, 11, 14, 0, 0, 0, time.UTC)),
+		},
+	})
+
+	// Started on March 9th local time, completed on March 11th.
+	assert.Equal(t, 2.0, response.Habits.AverageCompletionDays)
+	assert.Equal(t, int32(1), response.Activity[1].Started)
+	assert.Equal(t, int32(3), response.Streak.CurrentDays)
+	assert.NotNil(t, response.Habits.PreferredHour)
+	assert.Equal(t, int32(21), *response.Habits.PreferredHour)
+}
+
+func TestEntryDatesUseEventTimesInTimeZone(t *testing.T) {
+	loc, err := LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	entry := db.ListLibraryArticlesForOwnerRow{
+		ID:            7,
+		Dateadded:     sql.NullTime{Time: date(2025, 3, 1), Valid: true},
+		Datecompleted: sql.NullTime{Time: date(2025, 3, 12), Valid: true},
+	}
+	read := sql.NullInt16{Int16: int16(library.ReadingStatus_READING_STATUS_READ), Valid: true}
+	dates := NewEntryDates([]db.ListReadingEventTimesForOwnerRow{
+		{LibraryArticleID: 7, EventType: int8(library.ReadingEventType_READING_EVENT_TYPE_ADDED), OccurredAt: sql.NullTime{Time: time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC), Valid: true}},
+		{LibraryArticleID: 7, EventType: int8(library.ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED), ToStatus: read, OccurredAt: sql.NullTime{Time: time.Date(2025, 3, 12, 2, 0, 0, 0, time.UTC), Valid: true}},
+	}, loc)
+
+	// Both happened in the evening of the day before in New York, although
+	// the server's UTC dates were stored.
+	added, ok := dates.Added(entry)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, loc), added)
+	completed, ok := dates.Completed(entry)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 11, 0, 0, 0, 0, loc), completed)
+
+	older := db.ListLibraryArticlesForOwnerRow{ID: 8, Datecompleted: sql.NullTime{Time: date(2025, 1, 5), Valid: true}}
+	completed, ok = dates.Completed(older)
+	assert.True(t, ok, "entries from before the event log keep their column")
+	assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, loc), completed)
+	_, ok = dates.Added(older)
+	assert.False(t, ok)
+}
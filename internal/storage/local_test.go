@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	exists, err := store.Exists(ctx, "ab/cd/abcd")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, store.Put(ctx, "ab/cd/abcd", strings.NewReader("hello"), 5, "text/plain"))
+	exists, err = store.Exists(ctx, "ab/cd/abcd")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	r, err := store.Open(ctx, "ab/cd/abcd")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "hello", string(content))
+
+	assert.NoError(t, store.Delete(ctx, "ab/cd/abcd"))
+	_, err = store.Open(ctx, "ab/cd/abcd")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, "ab/cd/abcd"), "deleting a missing blob is not an error")
+}
+
+func TestLocalStoreRejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+	assert.Error(t, store.Put(context.Background(), "../outside", strings.NewReader("x"), 1, ""))
+}
+
+func TestContentKey(t *testing.T) {
+	assert.Equal(t, "ab/cd/abcdef", ContentKey("abcdef"))
+}
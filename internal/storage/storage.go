@@ -0,0 +1,47 @@
+// Package storage keeps file contents in a blob store addressed by key.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+)
+
+// ErrNotFound is returned when no blob exists under a key.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores immutable blobs. Writing an existing key replaces it.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+const defaultLocalPath = "./data/blobs"
+
+// ContentKey returns the key of content with the given hex SHA-256 hash. Keys
+// are spread over two directory levels so no single directory of the local
+// store grows too large.
+func ContentKey(hash string) string {
+	return hash[:2] + "/" + hash[2:4] + "/" + hash
+}
+
+// New creates the blob store selected in the config.
+func New(ctx context.Context, cfg conf.StorageConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case "", "local":
+		path := cfg.LocalPath
+		if path == "" {
+			path = defaultLocalPath
+		}
+		return NewLocalStore(path)
+	case "s3":
+		return NewS3Store(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,101 @@
+// Package tracing sets up OpenTelemetry tracing for the server.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+)
+
+const defaultServiceName = "journalful"
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator, so incoming calls continue the caller's trace. The returned
+// function flushes pending spans and shuts the exporter down. When tracing
+// is disabled only the propagator is installed.
+func Setup(ctx context.Context, cfg conf.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if cfg.Exporter == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeOutput, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+	sampleRatio := cfg.SampleRatio
+	if sampleRatio == 0 {
+		sampleRatio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		return errors.Join(err, closeOutput())
+	}, nil
+}
+
+// newExporter creates the configured exporter, and a function that closes
+// the file the stdout exporter writes to, if any.
+func newExporter(ctx context.Context, cfg conf.TracingConfig) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+	switch cfg.Exporter {
+	case conf.TracingExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		return exporter, noClose, nil
+	case conf.TracingExporterStdout:
+		var out io.Writer = os.Stdout
+		closeOutput := noClose
+		if cfg.File != "" {
+			if err := os.MkdirAll(filepath.Dir(cfg.File), 0o750); err != nil {
+				return nil, nil, fmt.Errorf("failed to create trace directory: %w", err)
+			}
+			file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to open trace file: %w", err)
+			}
+			out, closeOutput = file, file.Close
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(out))
+		if err != nil {
+			return nil, nil, errors.Join(fmt.Errorf("failed to create stdout trace exporter: %w", err), closeOutput())
+		}
+		return exporter, closeOutput, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+}
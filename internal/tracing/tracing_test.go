@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chiquitav2/journalful/pkg/conf"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetupWritesSpansToFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "data", "traces.jsonl")
+	shutdown, err := Setup(context.Background(), conf.TracingConfig{Exporter: conf.TracingExporterStdout, File: file})
+	assert.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "CreateArticle")
+	span.End()
+	assert.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"Name":"CreateArticle"`)
+	assert.Contains(t, string(data), `"Value":"journalful"`, "the service name is set")
+}
+
+func TestSetupDisabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), conf.TracingConfig{})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: accesstoken/v1/access_token.proto
+
+package accesstoken
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccessTokenScope int32
+
+const (
+	AccessTokenScope_ACCESS_TOKEN_SCOPE_UNSPECIFIED   AccessTokenScope = 0
+	AccessTokenScope_ACCESS_TOKEN_SCOPE_READ          AccessTokenScope = 1 // Get, list, search, watch and export RPCs
+	AccessTokenScope_ACCESS_TOKEN_SCOPE_LIBRARY_WRITE AccessTokenScope = 2 // Read, plus changing libraries and their articles
+	AccessTokenScope_ACCESS_TOKEN_SCOPE_ADMIN         AccessTokenScope = 3 // Everything, including admin-only RPCs; only admins may grant it
+	AccessTokenScope_ACCESS_TOKEN_SCOPE_WRITE         AccessTokenScope = 4 // Library write, plus changing articles, annotations, goals, reviews, attachments and profiles
+)
+
+// Enum value maps for AccessTokenScope.
+var (
+	AccessTokenScope_name = map[int32]string{
+		0: "ACCESS_TOKEN_SCOPE_UNSPECIFIED",
+		1: "ACCESS_TOKEN_SCOPE_READ",
+		2: "ACCESS_TOKEN_SCOPE_LIBRARY_WRITE",
+		3: "ACCESS_TOKEN_SCOPE_ADMIN",
+		4: "ACCESS_TOKEN_SCOPE_WRITE",
+	}
+	AccessTokenScope_value = map[string]int32{
+		"ACCESS_TOKEN_SCOPE_UNSPECIFIED":   0,
+		"ACCESS_TOKEN_SCOPE_READ":          1,
+		"ACCESS_TOKEN_SCOPE_LIBRARY_WRITE": 2,
+		"ACCESS_TOKEN_SCOPE_ADMIN":         3,
+		"ACCESS_TOKEN_SCOPE_WRITE":         4,
+	}
+)
+
+func (x AccessTokenScope) Enum() *AccessTokenScope {
+	p := new(AccessTokenScope)
+	*p = x
+	return p
+}
+
+func (x AccessTokenScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessTokenScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_accesstoken_v1_access_token_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessTokenScope) Type() protoreflect.EnumType {
+	return &file_accesstoken_v1_access_token_proto_enumTypes[0]
+}
+
+func (x AccessTokenScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessTokenScope.Descriptor instead.
+func (AccessTokenScope) EnumDescriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{0}
+}
+
+type AccessToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TokenPrefix   string                 `protobuf:"bytes,3,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"` // Start of the token, to tell tokens apart
+	Scopes        []AccessTokenScope     `protobuf:"varint,4,rep,packed,name=scopes,proto3,enum=api.accesstoken.v1.AccessTokenScope" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Unset if never used
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      // Unset unless revoked
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessToken) ProtoMessage() {}
+
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessToken) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *AccessToken) GetScopes() []AccessTokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AccessToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AccessToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *AccessToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *AccessToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []AccessTokenScope     `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=api.accesstoken.v1.AccessTokenScope" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Defaults to 90 days from now, at most a year
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenRequest) Reset() {
+	*x = CreateAccessTokenRequest{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetScopes() []AccessTokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   *AccessToken           `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // The secret; it is not stored and cannot be shown again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenResponse) Reset() {
+	*x = CreateAccessTokenResponse{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenResponse) ProtoMessage() {}
+
+func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccessTokenResponse) GetAccessToken() *AccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListAccessTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessTokensRequest) Reset() {
+	*x = ListAccessTokensRequest{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessTokensRequest) ProtoMessage() {}
+
+func (x *ListAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{3}
+}
+
+type ListAccessTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessTokens  []*AccessToken         `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessTokensResponse) Reset() {
+	*x = ListAccessTokensResponse{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessTokensResponse) ProtoMessage() {}
+
+func (x *ListAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessTokensResponse) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAccessTokensResponse) GetAccessTokens() []*AccessToken {
+	if x != nil {
+		return x.AccessTokens
+	}
+	return nil
+}
+
+type RevokeAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessTokenRequest) Reset() {
+	*x = RevokeAccessTokenRequest{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenRequest) ProtoMessage() {}
+
+func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeAccessTokenRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessTokenResponse) Reset() {
+	*x = RevokeAccessTokenResponse{}
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenResponse) ProtoMessage() {}
+
+func (x *RevokeAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accesstoken_v1_access_token_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_accesstoken_v1_access_token_proto_rawDescGZIP(), []int{6}
+}
+
+var File_accesstoken_v1_access_token_proto protoreflect.FileDescriptor
+
+const file_accesstoken_v1_access_token_proto_rawDesc = "" +
+	"\n" +
+	"!accesstoken/v1/access_token.proto\x12\x12api.accesstoken.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x03\n" +
+	"\vAccessToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\ftoken_prefix\x18\x03 \x01(\tR\vtokenPrefix\x12<\n" +
+	"\x06scopes\x18\x04 \x03(\x0e2$.api.accesstoken.v1.AccessTokenScopeR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc7\x01\n" +
+	"\x18CreateAccessTokenRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xbaH\br\x06\x18d2\x02\\SR\x04name\x12O\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2$.api.accesstoken.v1.AccessTokenScopeB\x11\xbaH\x0e\x92\x01\v\b\x01\"\a\x82\x01\x04\x10\x01 \x00R\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"u\n" +
+	"\x19CreateAccessTokenResponse\x12B\n" +
+	"\faccess_token\x18\x01 \x01(\v2\x1f.api.accesstoken.v1.AccessTokenR\vaccessToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x19\n" +
+	"\x17ListAccessTokensRequest\"`\n" +
+	"\x18ListAccessTokensResponse\x12D\n" +
+	"\raccess_tokens\x18\x01 \x03(\v2\x1f.api.accesstoken.v1.AccessTokenR\faccessTokens\"3\n" +
+	"\x18RevokeAccessTokenRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x1b\n" +
+	"\x19RevokeAccessTokenResponse*\xb5\x01\n" +
+	"\x10AccessTokenScope\x12\"\n" +
+	"\x1eACCESS_TOKEN_SCOPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ACCESS_TOKEN_SCOPE_READ\x10\x01\x12$\n" +
+	" ACCESS_TOKEN_SCOPE_LIBRARY_WRITE\x10\x02\x12\x1c\n" +
+	"\x18ACCESS_TOKEN_SCOPE_ADMIN\x10\x03\x12\x1c\n" +
+	"\x18ACCESS_TOKEN_SCOPE_WRITE\x10\x042\xe7\x02\n" +
+	"\x12AccessTokenService\x12p\n" +
+	"\x11CreateAccessToken\x12,.api.accesstoken.v1.CreateAccessTokenRequest\x1a-.api.accesstoken.v1.CreateAccessTokenResponse\x12m\n" +
+	"\x10ListAccessTokens\x12+.api.accesstoken.v1.ListAccessTokensRequest\x1a,.api.accesstoken.v1.ListAccessTokensResponse\x12p\n" +
+	"\x11RevokeAccessToken\x12,.api.accesstoken.v1.RevokeAccessTokenRequest\x1a-.api.accesstoken.v1.RevokeAccessTokenResponseBAZ?github.com/chiquitav2/journalful/pkg/accesstoken/v1;accesstokenb\x06proto3"
+
+var (
+	file_accesstoken_v1_access_token_proto_rawDescOnce sync.Once
+	file_accesstoken_v1_access_token_proto_rawDescData []byte
+)
+
+func file_accesstoken_v1_access_token_proto_rawDescGZIP() []byte {
+	file_accesstoken_v1_access_token_proto_rawDescOnce.Do(func() {
+		file_accesstoken_v1_access_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_accesstoken_v1_access_token_proto_rawDesc), len(file_accesstoken_v1_access_token_proto_rawDesc)))
+	})
+	return file_accesstoken_v1_access_token_proto_rawDescData
+}
+
+var file_accesstoken_v1_access_token_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_accesstoken_v1_access_token_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_accesstoken_v1_access_token_proto_goTypes = []any{
+	(AccessTokenScope)(0),             // 0: api.accesstoken.v1.AccessTokenScope
+	(*AccessToken)(nil),               // 1: api.accesstoken.v1.AccessToken
+	(*CreateAccessTokenRequest)(nil),  // 2: api.accesstoken.v1.CreateAccessTokenRequest
+	(*CreateAccessTokenResponse)(nil), // 3: api.accesstoken.v1.CreateAccessTokenResponse
+	(*ListAccessTokensRequest)(nil),   // 4: api.accesstoken.v1.ListAccessTokensRequest
+	(*ListAccessTokensResponse)(nil),  // 5: api.accesstoken.v1.ListAccessTokensResponse
+	(*RevokeAccessTokenRequest)(nil),  // 6: api.accesstoken.v1.RevokeAccessTokenRequest
+	(*RevokeAccessTokenResponse)(nil), // 7: api.accesstoken.v1.RevokeAccessTokenResponse
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_accesstoken_v1_access_token_proto_depIdxs = []int32{
+	0,  // 0: api.accesstoken.v1.AccessToken.scopes:type_name -> api.accesstoken.v1.AccessTokenScope
+	8,  // 1: api.accesstoken.v1.AccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: api.accesstoken.v1.AccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: api.accesstoken.v1.AccessToken.revoked_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: api.accesstoken.v1.AccessToken.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: api.accesstoken.v1.CreateAccessTokenRequest.scopes:type_name -> api.accesstoken.v1.AccessTokenScope
+	8,  // 6: api.accesstoken.v1.CreateAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: api.accesstoken.v1.CreateAccessTokenResponse.access_token:type_name -> api.accesstoken.v1.AccessToken
+	1,  // 8: api.accesstoken.v1.ListAccessTokensResponse.access_tokens:type_name -> api.accesstoken.v1.AccessToken
+	2,  // 9: api.accesstoken.v1.AccessTokenService.CreateAccessToken:input_type -> api.accesstoken.v1.CreateAccessTokenRequest
+	4,  // 10: api.accesstoken.v1.AccessTokenService.ListAccessTokens:input_type -> api.accesstoken.v1.ListAccessTokensRequest
+	6,  // 11: api.accesstoken.v1.AccessTokenService.RevokeAccessToken:input_type -> api.accesstoken.v1.RevokeAccessTokenRequest
+	3,  // 12: api.accesstoken.v1.AccessTokenService.CreateAccessToken:output_type -> api.accesstoken.v1.CreateAccessTokenResponse
+	5,  // 13: api.accesstoken.v1.AccessTokenService.ListAccessTokens:output_type -> api.accesstoken.v1.ListAccessTokensResponse
+	7,  // 14: api.accesstoken.v1.AccessTokenService.RevokeAccessToken:output_type -> api.accesstoken.v1.RevokeAccessTokenResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_accesstoken_v1_access_token_proto_init() }
+func file_accesstoken_v1_access_token_proto_init() {
+	if File_accesstoken_v1_access_token_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_accesstoken_v1_access_token_proto_rawDesc), len(file_accesstoken_v1_access_token_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_accesstoken_v1_access_token_proto_goTypes,
+		DependencyIndexes: file_accesstoken_v1_access_token_proto_depIdxs,
+		EnumInfos:         file_accesstoken_v1_access_token_proto_enumTypes,
+		MessageInfos:      file_accesstoken_v1_access_token_proto_msgTypes,
+	}.Build()
+	File_accesstoken_v1_access_token_proto = out.File
+	file_accesstoken_v1_access_token_proto_goTypes = nil
+	file_accesstoken_v1_access_token_proto_depIdxs = nil
+}
@@ -0,0 +1,1605 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: annotation/v1/annotation.proto
+
+package annotation
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AnnotationColor int32
+
+const (
+	AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED AnnotationColor = 0
+	AnnotationColor_ANNOTATION_COLOR_YELLOW      AnnotationColor = 1
+	AnnotationColor_ANNOTATION_COLOR_GREEN       AnnotationColor = 2
+	AnnotationColor_ANNOTATION_COLOR_BLUE        AnnotationColor = 3
+	AnnotationColor_ANNOTATION_COLOR_PINK        AnnotationColor = 4
+	AnnotationColor_ANNOTATION_COLOR_PURPLE      AnnotationColor = 5
+)
+
+// Enum value maps for AnnotationColor.
+var (
+	AnnotationColor_name = map[int32]string{
+		0: "ANNOTATION_COLOR_UNSPECIFIED",
+		1: "ANNOTATION_COLOR_YELLOW",
+		2: "ANNOTATION_COLOR_GREEN",
+		3: "ANNOTATION_COLOR_BLUE",
+		4: "ANNOTATION_COLOR_PINK",
+		5: "ANNOTATION_COLOR_PURPLE",
+	}
+	AnnotationColor_value = map[string]int32{
+		"ANNOTATION_COLOR_UNSPECIFIED": 0,
+		"ANNOTATION_COLOR_YELLOW":      1,
+		"ANNOTATION_COLOR_GREEN":       2,
+		"ANNOTATION_COLOR_BLUE":        3,
+		"ANNOTATION_COLOR_PINK":        4,
+		"ANNOTATION_COLOR_PURPLE":      5,
+	}
+)
+
+func (x AnnotationColor) Enum() *AnnotationColor {
+	p := new(AnnotationColor)
+	*p = x
+	return p
+}
+
+func (x AnnotationColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnotationColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_annotation_v1_annotation_proto_enumTypes[0].Descriptor()
+}
+
+func (AnnotationColor) Type() protoreflect.EnumType {
+	return &file_annotation_v1_annotation_proto_enumTypes[0]
+}
+
+func (x AnnotationColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnotationColor.Descriptor instead.
+func (AnnotationColor) EnumDescriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{0}
+}
+
+type AnnotationVisibility int32
+
+const (
+	AnnotationVisibility_ANNOTATION_VISIBILITY_UNSPECIFIED AnnotationVisibility = 0 // Treated as private
+	AnnotationVisibility_ANNOTATION_VISIBILITY_PRIVATE     AnnotationVisibility = 1 // Only the author can see it
+	AnnotationVisibility_ANNOTATION_VISIBILITY_SHARED      AnnotationVisibility = 2 // Anyone who can view the library can see it
+)
+
+// Enum value maps for AnnotationVisibility.
+var (
+	AnnotationVisibility_name = map[int32]string{
+		0: "ANNOTATION_VISIBILITY_UNSPECIFIED",
+		1: "ANNOTATION_VISIBILITY_PRIVATE",
+		2: "ANNOTATION_VISIBILITY_SHARED",
+	}
+	AnnotationVisibility_value = map[string]int32{
+		"ANNOTATION_VISIBILITY_UNSPECIFIED": 0,
+		"ANNOTATION_VISIBILITY_PRIVATE":     1,
+		"ANNOTATION_VISIBILITY_SHARED":      2,
+	}
+)
+
+func (x AnnotationVisibility) Enum() *AnnotationVisibility {
+	p := new(AnnotationVisibility)
+	*p = x
+	return p
+}
+
+func (x AnnotationVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnotationVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_annotation_v1_annotation_proto_enumTypes[1].Descriptor()
+}
+
+func (AnnotationVisibility) Type() protoreflect.EnumType {
+	return &file_annotation_v1_annotation_proto_enumTypes[1]
+}
+
+func (x AnnotationVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnotationVisibility.Descriptor instead.
+func (AnnotationVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // Treated as Markdown
+	ExportFormat_EXPORT_FORMAT_MARKDOWN    ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_JSON        ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_MARKDOWN",
+		2: "EXPORT_FORMAT_CSV",
+		3: "EXPORT_FORMAT_JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_MARKDOWN":    1,
+		"EXPORT_FORMAT_CSV":         2,
+		"EXPORT_FORMAT_JSON":        3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_annotation_v1_annotation_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_annotation_v1_annotation_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{2}
+}
+
+type Annotation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LibraryArticleId int64                  `protobuf:"varint,2,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	LibraryId        int64                  `protobuf:"varint,3,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	ArticleId        int64                  `protobuf:"varint,4,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	AuthorId         int64                  `protobuf:"varint,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Quote            *string                `protobuf:"bytes,6,opt,name=quote,proto3,oneof" json:"quote,omitempty"` // Highlighted text
+	Comment          *string                `protobuf:"bytes,7,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Page             *int32                 `protobuf:"varint,8,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Location         *string                `protobuf:"bytes,9,opt,name=location,proto3,oneof" json:"location,omitempty"` // Free-form position within the page, e.g. a section or paragraph
+	Color            AnnotationColor        `protobuf:"varint,10,opt,name=color,proto3,enum=api.annotation.v1.AnnotationColor" json:"color,omitempty"`
+	Visibility       AnnotationVisibility   `protobuf:"varint,11,opt,name=visibility,proto3,enum=api.annotation.v1.AnnotationVisibility" json:"visibility,omitempty"`
+	Tags             []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Annotation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Annotation) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *Annotation) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *Annotation) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Annotation) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Annotation) GetQuote() string {
+	if x != nil && x.Quote != nil {
+		return *x.Quote
+	}
+	return ""
+}
+
+func (x *Annotation) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *Annotation) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *Annotation) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *Annotation) GetColor() AnnotationColor {
+	if x != nil {
+		return x.Color
+	}
+	return AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED
+}
+
+func (x *Annotation) GetVisibility() AnnotationVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return AnnotationVisibility_ANNOTATION_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Annotation) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Annotation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Annotation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AnnotationTags wraps a tag list so updates can tell "leave tags alone"
+// apart from "remove all tags".
+type AnnotationTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotationTags) Reset() {
+	*x = AnnotationTags{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotationTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotationTags) ProtoMessage() {}
+
+func (x *AnnotationTags) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotationTags.ProtoReflect.Descriptor instead.
+func (*AnnotationTags) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnnotationTags) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type CreateAnnotationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LibraryArticleId int64                  `protobuf:"varint,2,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	Quote            *string                `protobuf:"bytes,3,opt,name=quote,proto3,oneof" json:"quote,omitempty"`
+	Comment          *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Page             *int32                 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Location         *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Color            AnnotationColor        `protobuf:"varint,7,opt,name=color,proto3,enum=api.annotation.v1.AnnotationColor" json:"color,omitempty"`
+	Visibility       AnnotationVisibility   `protobuf:"varint,8,opt,name=visibility,proto3,enum=api.annotation.v1.AnnotationVisibility" json:"visibility,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateAnnotationRequest) Reset() {
+	*x = CreateAnnotationRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnotationRequest) ProtoMessage() {}
+
+func (x *CreateAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAnnotationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAnnotationRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *CreateAnnotationRequest) GetQuote() string {
+	if x != nil && x.Quote != nil {
+		return *x.Quote
+	}
+	return ""
+}
+
+func (x *CreateAnnotationRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *CreateAnnotationRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *CreateAnnotationRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *CreateAnnotationRequest) GetColor() AnnotationColor {
+	if x != nil {
+		return x.Color
+	}
+	return AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED
+}
+
+func (x *CreateAnnotationRequest) GetVisibility() AnnotationVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return AnnotationVisibility_ANNOTATION_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateAnnotationRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *Annotation            `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnotationResponse) Reset() {
+	*x = CreateAnnotationResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnotationResponse) ProtoMessage() {}
+
+func (x *CreateAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAnnotationResponse) GetAnnotation() *Annotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type GetAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnotationRequest) Reset() {
+	*x = GetAnnotationRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnotationRequest) ProtoMessage() {}
+
+func (x *GetAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAnnotationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAnnotationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *Annotation            `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnotationResponse) Reset() {
+	*x = GetAnnotationResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnotationResponse) ProtoMessage() {}
+
+func (x *GetAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAnnotationResponse) GetAnnotation() *Annotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type ListAnnotationsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The viewer
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListAnnotationsRequest) Reset() {
+	*x = ListAnnotationsRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnotationsRequest) ProtoMessage() {}
+
+func (x *ListAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAnnotationsRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *ListAnnotationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListAnnotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotations   []*Annotation          `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"` // In page order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnotationsResponse) Reset() {
+	*x = ListAnnotationsResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnotationsResponse) ProtoMessage() {}
+
+func (x *ListAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAnnotationsResponse) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UpdateAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quote         *string                `protobuf:"bytes,3,opt,name=quote,proto3,oneof" json:"quote,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Page          *int32                 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Location      *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Color         *AnnotationColor       `protobuf:"varint,7,opt,name=color,proto3,enum=api.annotation.v1.AnnotationColor,oneof" json:"color,omitempty"`
+	Visibility    *AnnotationVisibility  `protobuf:"varint,8,opt,name=visibility,proto3,enum=api.annotation.v1.AnnotationVisibility,oneof" json:"visibility,omitempty"`
+	Tags          *AnnotationTags        `protobuf:"bytes,9,opt,name=tags,proto3" json:"tags,omitempty"` // Replaces the tags when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnotationRequest) Reset() {
+	*x = UpdateAnnotationRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnotationRequest) ProtoMessage() {}
+
+func (x *UpdateAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateAnnotationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAnnotationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateAnnotationRequest) GetQuote() string {
+	if x != nil && x.Quote != nil {
+		return *x.Quote
+	}
+	return ""
+}
+
+func (x *UpdateAnnotationRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateAnnotationRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *UpdateAnnotationRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateAnnotationRequest) GetColor() AnnotationColor {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED
+}
+
+func (x *UpdateAnnotationRequest) GetVisibility() AnnotationVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return AnnotationVisibility_ANNOTATION_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateAnnotationRequest) GetTags() *AnnotationTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *Annotation            `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnotationResponse) Reset() {
+	*x = UpdateAnnotationResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnotationResponse) ProtoMessage() {}
+
+func (x *UpdateAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateAnnotationResponse) GetAnnotation() *Annotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type DeleteAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnotationRequest) Reset() {
+	*x = DeleteAnnotationRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnotationRequest) ProtoMessage() {}
+
+func (x *DeleteAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAnnotationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAnnotationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnotationResponse) Reset() {
+	*x = DeleteAnnotationResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnotationResponse) ProtoMessage() {}
+
+func (x *DeleteAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAnnotationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SearchAnnotationsRequest searches the annotations a user has written,
+// across all of their libraries.
+type SearchAnnotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         *string                `protobuf:"bytes,2,opt,name=query,proto3,oneof" json:"query,omitempty"` // Matched against quote and comment
+	Tag           *string                `protobuf:"bytes,3,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	Color         *AnnotationColor       `protobuf:"varint,4,opt,name=color,proto3,enum=api.annotation.v1.AnnotationColor,oneof" json:"color,omitempty"`
+	LibraryId     *int64                 `protobuf:"varint,5,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 for the default of 25
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAnnotationsRequest) Reset() {
+	*x = SearchAnnotationsRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnnotationsRequest) ProtoMessage() {}
+
+func (x *SearchAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchAnnotationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchAnnotationsRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *SearchAnnotationsRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SearchAnnotationsRequest) GetColor() AnnotationColor {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return AnnotationColor_ANNOTATION_COLOR_UNSPECIFIED
+}
+
+func (x *SearchAnnotationsRequest) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
+}
+
+func (x *SearchAnnotationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAnnotationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchAnnotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotations   []*Annotation          `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAnnotationsResponse) Reset() {
+	*x = SearchAnnotationsResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnnotationsResponse) ProtoMessage() {}
+
+func (x *SearchAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchAnnotationsResponse) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *SearchAnnotationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportAnnotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LibraryId     *int64                 `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"` // Defaults to all of the user's libraries
+	Format        ExportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=api.annotation.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAnnotationsRequest) Reset() {
+	*x = ExportAnnotationsRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAnnotationsRequest) ProtoMessage() {}
+
+func (x *ExportAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportAnnotationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportAnnotationsRequest) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
+}
+
+func (x *ExportAnnotationsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportAnnotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAnnotationsResponse) Reset() {
+	*x = ExportAnnotationsResponse{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAnnotationsResponse) ProtoMessage() {}
+
+func (x *ExportAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportAnnotationsResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportAnnotationsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAnnotationsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ExportNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LibraryId     int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesRequest) Reset() {
+	*x = ExportNotesRequest{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesRequest) ProtoMessage() {}
+
+func (x *ExportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ExportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportNotesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportNotesRequest) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+// ExportNotesChunk is a piece of the zip archive. The filename is only set on
+// the first chunk.
+type ExportNotesChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesChunk) Reset() {
+	*x = ExportNotesChunk{}
+	mi := &file_annotation_v1_annotation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesChunk) ProtoMessage() {}
+
+func (x *ExportNotesChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_annotation_v1_annotation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesChunk.ProtoReflect.Descriptor instead.
+func (*ExportNotesChunk) Descriptor() ([]byte, []int) {
+	return file_annotation_v1_annotation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportNotesChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportNotesChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+var File_annotation_v1_annotation_proto protoreflect.FileDescriptor
+
+const file_annotation_v1_annotation_proto_rawDesc = "" +
+	"\n" +
+	"\x1eannotation/v1/annotation.proto\x12\x11api.annotation.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x04\n" +
+	"\n" +
+	"Annotation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x12library_article_id\x18\x02 \x01(\x03R\x10libraryArticleId\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x03 \x01(\x03R\tlibraryId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x04 \x01(\x03R\tarticleId\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\x03R\bauthorId\x12\x19\n" +
+	"\x05quote\x18\x06 \x01(\tH\x00R\x05quote\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\a \x01(\tH\x01R\acomment\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\b \x01(\x05H\x02R\x04page\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\t \x01(\tH\x03R\blocation\x88\x01\x01\x128\n" +
+	"\x05color\x18\n" +
+	" \x01(\x0e2\".api.annotation.v1.AnnotationColorR\x05color\x12G\n" +
+	"\n" +
+	"visibility\x18\v \x01(\x0e2'.api.annotation.v1.AnnotationVisibilityR\n" +
+	"visibility\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_quoteB\n" +
+	"\n" +
+	"\b_commentB\a\n" +
+	"\x05_pageB\v\n" +
+	"\t_location\"4\n" +
+	"\x0eAnnotationTags\x12\"\n" +
+	"\x05names\x18\x01 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x18dR\x05names\"\xf4\x03\n" +
+	"\x17CreateAnnotationRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x125\n" +
+	"\x12library_article_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10libraryArticleId\x12$\n" +
+	"\x05quote\x18\x03 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x00R\x05quote\x88\x01\x01\x12(\n" +
+	"\acomment\x18\x04 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x01R\acomment\x88\x01\x01\x12 \n" +
+	"\x04page\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\x02R\x04page\x88\x01\x01\x12)\n" +
+	"\blocation\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\blocation\x88\x01\x01\x12B\n" +
+	"\x05color\x18\a \x01(\x0e2\".api.annotation.v1.AnnotationColorB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05color\x12Q\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x0e2'.api.annotation.v1.AnnotationVisibilityB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"visibility\x12 \n" +
+	"\x04tags\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x18dR\x04tagsB\b\n" +
+	"\x06_quoteB\n" +
+	"\n" +
+	"\b_commentB\a\n" +
+	"\x05_pageB\v\n" +
+	"\t_location\"Y\n" +
+	"\x18CreateAnnotationResponse\x12=\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x1d.api.annotation.v1.AnnotationR\n" +
+	"annotation\"H\n" +
+	"\x14GetAnnotationRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"V\n" +
+	"\x15GetAnnotationResponse\x12=\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x1d.api.annotation.v1.AnnotationR\n" +
+	"annotation\"h\n" +
+	"\x16ListAnnotationsRequest\x125\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10libraryArticleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"Z\n" +
+	"\x17ListAnnotationsResponse\x12?\n" +
+	"\vannotations\x18\x01 \x03(\v2\x1d.api.annotation.v1.AnnotationR\vannotations\"\x8e\x04\n" +
+	"\x17UpdateAnnotationRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12$\n" +
+	"\x05quote\x18\x03 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x00R\x05quote\x88\x01\x01\x12(\n" +
+	"\acomment\x18\x04 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x01R\acomment\x88\x01\x01\x12 \n" +
+	"\x04page\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\x02R\x04page\x88\x01\x01\x12)\n" +
+	"\blocation\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\blocation\x88\x01\x01\x12G\n" +
+	"\x05color\x18\a \x01(\x0e2\".api.annotation.v1.AnnotationColorB\b\xbaH\x05\x82\x01\x02\x10\x01H\x04R\x05color\x88\x01\x01\x12V\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x0e2'.api.annotation.v1.AnnotationVisibilityB\b\xbaH\x05\x82\x01\x02\x10\x01H\x05R\n" +
+	"visibility\x88\x01\x01\x125\n" +
+	"\x04tags\x18\t \x01(\v2!.api.annotation.v1.AnnotationTagsR\x04tagsB\b\n" +
+	"\x06_quoteB\n" +
+	"\n" +
+	"\b_commentB\a\n" +
+	"\x05_pageB\v\n" +
+	"\t_locationB\b\n" +
+	"\x06_colorB\r\n" +
+	"\v_visibility\"Y\n" +
+	"\x18UpdateAnnotationResponse\x12=\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x1d.api.annotation.v1.AnnotationR\n" +
+	"annotation\"T\n" +
+	"\x17DeleteAnnotationRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"4\n" +
+	"\x18DeleteAnnotationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe9\x02\n" +
+	"\x18SearchAnnotationsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12#\n" +
+	"\x05query\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\x05query\x88\x01\x01\x12\x1e\n" +
+	"\x03tag\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dH\x01R\x03tag\x88\x01\x01\x12G\n" +
+	"\x05color\x18\x04 \x01(\x0e2\".api.annotation.v1.AnnotationColorB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\x05color\x88\x01\x01\x12+\n" +
+	"\n" +
+	"library_id\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x03R\tlibraryId\x88\x01\x01\x12&\n" +
+	"\tpage_size\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenB\b\n" +
+	"\x06_queryB\x06\n" +
+	"\x04_tagB\b\n" +
+	"\x06_colorB\r\n" +
+	"\v_library_id\"\x84\x01\n" +
+	"\x19SearchAnnotationsResponse\x12?\n" +
+	"\vannotations\x18\x01 \x03(\v2\x1d.api.annotation.v1.AnnotationR\vannotations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
+	"\x18ExportAnnotationsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12+\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tlibraryId\x88\x01\x01\x12A\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1f.api.annotation.v1.ExportFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06formatB\r\n" +
+	"\v_library_id\"t\n" +
+	"\x19ExportAnnotationsResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"^\n" +
+	"\x12ExportNotesRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12&\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\"B\n" +
+	"\x10ExportNotesChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename*\xbf\x01\n" +
+	"\x0fAnnotationColor\x12 \n" +
+	"\x1cANNOTATION_COLOR_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ANNOTATION_COLOR_YELLOW\x10\x01\x12\x1a\n" +
+	"\x16ANNOTATION_COLOR_GREEN\x10\x02\x12\x19\n" +
+	"\x15ANNOTATION_COLOR_BLUE\x10\x03\x12\x19\n" +
+	"\x15ANNOTATION_COLOR_PINK\x10\x04\x12\x1b\n" +
+	"\x17ANNOTATION_COLOR_PURPLE\x10\x05*\x82\x01\n" +
+	"\x14AnnotationVisibility\x12%\n" +
+	"!ANNOTATION_VISIBILITY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dANNOTATION_VISIBILITY_PRIVATE\x10\x01\x12 \n" +
+	"\x1cANNOTATION_VISIBILITY_SHARED\x10\x02*x\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16EXPORT_FORMAT_MARKDOWN\x10\x01\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x02\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x032\xe5\x06\n" +
+	"\x11AnnotationService\x12k\n" +
+	"\x10CreateAnnotation\x12*.api.annotation.v1.CreateAnnotationRequest\x1a+.api.annotation.v1.CreateAnnotationResponse\x12b\n" +
+	"\rGetAnnotation\x12'.api.annotation.v1.GetAnnotationRequest\x1a(.api.annotation.v1.GetAnnotationResponse\x12h\n" +
+	"\x0fListAnnotations\x12).api.annotation.v1.ListAnnotationsRequest\x1a*.api.annotation.v1.ListAnnotationsResponse\x12k\n" +
+	"\x10UpdateAnnotation\x12*.api.annotation.v1.UpdateAnnotationRequest\x1a+.api.annotation.v1.UpdateAnnotationResponse\x12k\n" +
+	"\x10DeleteAnnotation\x12*.api.annotation.v1.DeleteAnnotationRequest\x1a+.api.annotation.v1.DeleteAnnotationResponse\x12n\n" +
+	"\x11SearchAnnotations\x12+.api.annotation.v1.SearchAnnotationsRequest\x1a,.api.annotation.v1.SearchAnnotationsResponse\x12n\n" +
+	"\x11ExportAnnotations\x12+.api.annotation.v1.ExportAnnotationsRequest\x1a,.api.annotation.v1.ExportAnnotationsResponse\x12[\n" +
+	"\vExportNotes\x12%.api.annotation.v1.ExportNotesRequest\x1a#.api.annotation.v1.ExportNotesChunk0\x01B?Z=github.com/chiquitav2/journalful/pkg/annotation/v1;annotationb\x06proto3"
+
+var (
+	file_annotation_v1_annotation_proto_rawDescOnce sync.Once
+	file_annotation_v1_annotation_proto_rawDescData []byte
+)
+
+func file_annotation_v1_annotation_proto_rawDescGZIP() []byte {
+	file_annotation_v1_annotation_proto_rawDescOnce.Do(func() {
+		file_annotation_v1_annotation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_annotation_v1_annotation_proto_rawDesc), len(file_annotation_v1_annotation_proto_rawDesc)))
+	})
+	return file_annotation_v1_annotation_proto_rawDescData
+}
+
+var file_annotation_v1_annotation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_annotation_v1_annotation_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_annotation_v1_annotation_proto_goTypes = []any{
+	(AnnotationColor)(0),              // 0: api.annotation.v1.AnnotationColor
+	(AnnotationVisibility)(0),         // 1: api.annotation.v1.AnnotationVisibility
+	(ExportFormat)(0),                 // 2: api.annotation.v1.ExportFormat
+	(*Annotation)(nil),                // 3: api.annotation.v1.Annotation
+	(*AnnotationTags)(nil),            // 4: api.annotation.v1.AnnotationTags
+	(*CreateAnnotationRequest)(nil),   // 5: api.annotation.v1.CreateAnnotationRequest
+	(*CreateAnnotationResponse)(nil),  // 6: api.annotation.v1.CreateAnnotationResponse
+	(*GetAnnotationRequest)(nil),      // 7: api.annotation.v1.GetAnnotationRequest
+	(*GetAnnotationResponse)(nil),     // 8: api.annotation.v1.GetAnnotationResponse
+	(*ListAnnotationsRequest)(nil),    // 9: api.annotation.v1.ListAnnotationsRequest
+	(*ListAnnotationsResponse)(nil),   // 10: api.annotation.v1.ListAnnotationsResponse
+	(*UpdateAnnotationRequest)(nil),   // 11: api.annotation.v1.UpdateAnnotationRequest
+	(*UpdateAnnotationResponse)(nil),  // 12: api.annotation.v1.UpdateAnnotationResponse
+	(*DeleteAnnotationRequest)(nil),   // 13: api.annotation.v1.DeleteAnnotationRequest
+	(*DeleteAnnotationResponse)(nil),  // 14: api.annotation.v1.DeleteAnnotationResponse
+	(*SearchAnnotationsRequest)(nil),  // 15: api.annotation.v1.SearchAnnotationsRequest
+	(*SearchAnnotationsResponse)(nil), // 16: api.annotation.v1.SearchAnnotationsResponse
+	(*ExportAnnotationsRequest)(nil),  // 17: api.annotation.v1.ExportAnnotationsRequest
+	(*ExportAnnotationsResponse)(nil), // 18: api.annotation.v1.ExportAnnotationsResponse
+	(*ExportNotesRequest)(nil),        // 19: api.annotation.v1.ExportNotesRequest
+	(*ExportNotesChunk)(nil),          // 20: api.annotation.v1.ExportNotesChunk
+	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
+}
+var file_annotation_v1_annotation_proto_depIdxs = []int32{
+	0,  // 0: api.annotation.v1.Annotation.color:type_name -> api.annotation.v1.AnnotationColor
+	1,  // 1: api.annotation.v1.Annotation.visibility:type_name -> api.annotation.v1.AnnotationVisibility
+	21, // 2: api.annotation.v1.Annotation.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: api.annotation.v1.Annotation.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: api.annotation.v1.CreateAnnotationRequest.color:type_name -> api.annotation.v1.AnnotationColor
+	1,  // 5: api.annotation.v1.CreateAnnotationRequest.visibility:type_name -> api.annotation.v1.AnnotationVisibility
+	3,  // 6: api.annotation.v1.CreateAnnotationResponse.annotation:type_name -> api.annotation.v1.Annotation
+	3,  // 7: api.annotation.v1.GetAnnotationResponse.annotation:type_name -> api.annotation.v1.Annotation
+	3,  // 8: api.annotation.v1.ListAnnotationsResponse.annotations:type_name -> api.annotation.v1.Annotation
+	0,  // 9: api.annotation.v1.UpdateAnnotationRequest.color:type_name -> api.annotation.v1.AnnotationColor
+	1,  // 10: api.annotation.v1.UpdateAnnotationRequest.visibility:type_name -> api.annotation.v1.AnnotationVisibility
+	4,  // 11: api.annotation.v1.UpdateAnnotationRequest.tags:type_name -> api.annotation.v1.AnnotationTags
+	3,  // 12: api.annotation.v1.UpdateAnnotationResponse.annotation:type_name -> api.annotation.v1.Annotation
+	0,  // 13: api.annotation.v1.SearchAnnotationsRequest.color:type_name -> api.annotation.v1.AnnotationColor
+	3,  // 14: api.annotation.v1.SearchAnnotationsResponse.annotations:type_name -> api.annotation.v1.Annotation
+	2,  // 15: api.annotation.v1.ExportAnnotationsRequest.format:type_name -> api.annotation.v1.ExportFormat
+	5,  // 16: api.annotation.v1.AnnotationService.CreateAnnotation:input_type -> api.annotation.v1.CreateAnnotationRequest
+	7,  // 17: api.annotation.v1.AnnotationService.GetAnnotation:input_type -> api.annotation.v1.GetAnnotationRequest
+	9,  // 18: api.annotation.v1.AnnotationService.ListAnnotations:input_type -> api.annotation.v1.ListAnnotationsRequest
+	11, // 19: api.annotation.v1.AnnotationService.UpdateAnnotation:input_type -> api.annotation.v1.UpdateAnnotationRequest
+	13, // 20: api.annotation.v1.AnnotationService.DeleteAnnotation:input_type -> api.annotation.v1.DeleteAnnotationRequest
+	15, // 21: api.annotation.v1.AnnotationService.SearchAnnotations:input_type -> api.annotation.v1.SearchAnnotationsRequest
+	17, // 22: api.annotation.v1.AnnotationService.ExportAnnotations:input_type -> api.annotation.v1.ExportAnnotationsRequest
+	19, // 23: api.annotation.v1.AnnotationService.ExportNotes:input_type -> api.annotation.v1.ExportNotesRequest
+	6,  // 24: api.annotation.v1.AnnotationService.CreateAnnotation:output_type -> api.annotation.v1.CreateAnnotationResponse
+	8,  // 25: api.annotation.v1.AnnotationService.GetAnnotation:output_type -> api.annotation.v1.GetAnnotationResponse
+	10, // 26: api.annotation.v1.AnnotationService.ListAnnotations:output_type -> api.annotation.v1.ListAnnotationsResponse
+	12, // 27: api.annotation.v1.AnnotationService.UpdateAnnotation:output_type -> api.annotation.v1.UpdateAnnotationResponse
+	14, // 28: api.annotation.v1.AnnotationService.DeleteAnnotation:output_type -> api.annotation.v1.DeleteAnnotationResponse
+	16, // 29: api.annotation.v1.AnnotationService.SearchAnnotations:output_type -> api.annotation.v1.SearchAnnotationsResponse
+	18, // 30: api.annotation.v1.AnnotationService.ExportAnnotations:output_type -> api.annotation.v1.ExportAnnotationsResponse
+	20, // 31: api.annotation.v1.AnnotationService.ExportNotes:output_type -> api.annotation.v1.ExportNotesChunk
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_annotation_v1_annotation_proto_init() }
+func file_annotation_v1_annotation_proto_init() {
+	if File_annotation_v1_annotation_proto != nil {
+		return
+	}
+	file_annotation_v1_annotation_proto_msgTypes[0].OneofWrappers = []any{}
+	file_annotation_v1_annotation_proto_msgTypes[2].OneofWrappers = []any{}
+	file_annotation_v1_annotation_proto_msgTypes[8].OneofWrappers = []any{}
+	file_annotation_v1_annotation_proto_msgTypes[12].OneofWrappers = []any{}
+	file_annotation_v1_annotation_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_annotation_v1_annotation_proto_rawDesc), len(file_annotation_v1_annotation_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_annotation_v1_annotation_proto_goTypes,
+		DependencyIndexes: file_annotation_v1_annotation_proto_depIdxs,
+		EnumInfos:         file_annotation_v1_annotation_proto_enumTypes,
+		MessageInfos:      file_annotation_v1_annotation_proto_msgTypes,
+	}.Build()
+	File_annotation_v1_annotation_proto = out.File
+	file_annotation_v1_annotation_proto_goTypes = nil
+	file_annotation_v1_annotation_proto_depIdxs = nil
+}
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: annotation/v1/annotation.proto
+
+package annotation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnotationService_CreateAnnotation_FullMethodName  = "/api.annotation.v1.AnnotationService/CreateAnnotation"
+	AnnotationService_GetAnnotation_FullMethodName     = "/api.annotation.v1.AnnotationService/GetAnnotation"
+	AnnotationService_ListAnnotations_FullMethodName   = "/api.annotation.v1.AnnotationService/ListAnnotations"
+	AnnotationService_UpdateAnnotation_FullMethodName  = "/api.annotation.v1.AnnotationService/UpdateAnnotation"
+	AnnotationService_DeleteAnnotation_FullMethodName  = "/api.annotation.v1.AnnotationService/DeleteAnnotation"
+	AnnotationService_SearchAnnotations_FullMethodName = "/api.annotation.v1.AnnotationService/SearchAnnotations"
+	AnnotationService_ExportAnnotations_FullMethodName = "/api.annotation.v1.AnnotationService/ExportAnnotations"
+	AnnotationService_ExportNotes_FullMethodName       = "/api.annotation.v1.AnnotationService/ExportNotes"
+)
+
+// AnnotationServiceClient is the client API for AnnotationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AnnotationService manages highlights and comments on saved articles.
+type AnnotationServiceClient interface {
+	CreateAnnotation(ctx context.Context, in *CreateAnnotationRequest, opts ...grpc.CallOption) (*CreateAnnotationResponse, error)
+	GetAnnotation(ctx context.Context, in *GetAnnotationRequest, opts ...grpc.CallOption) (*GetAnnotationResponse, error)
+	ListAnnotations(ctx context.Context, in *ListAnnotationsRequest, opts ...grpc.CallOption) (*ListAnnotationsResponse, error)
+	UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, opts ...grpc.CallOption) (*UpdateAnnotationResponse, error)
+	DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...grpc.CallOption) (*DeleteAnnotationResponse, error)
+	SearchAnnotations(ctx context.Context, in *SearchAnnotationsRequest, opts ...grpc.CallOption) (*SearchAnnotationsResponse, error)
+	ExportAnnotations(ctx context.Context, in *ExportAnnotationsRequest, opts ...grpc.CallOption) (*ExportAnnotationsResponse, error)
+	// ExportNotes streams a zip archive with one Markdown file per article in a
+	// library, holding its notes and annotations.
+	ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesChunk], error)
+}
+
+type annotationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnotationServiceClient(cc grpc.ClientConnInterface) AnnotationServiceClient {
+	return &annotationServiceClient{cc}
+}
+
+func (c *annotationServiceClient) CreateAnnotation(ctx context.Context, in *CreateAnnotationRequest, opts ...grpc.CallOption) (*CreateAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAnnotationResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_CreateAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) GetAnnotation(ctx context.Context, in *GetAnnotationRequest, opts ...grpc.CallOption) (*GetAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnnotationResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_GetAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) ListAnnotations(ctx context.Context, in *ListAnnotationsRequest, opts ...grpc.CallOption) (*ListAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnotationsResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_ListAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) UpdateAnnotation(ctx context.Context, in *UpdateAnnotationRequest, opts ...grpc.CallOption) (*UpdateAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAnnotationResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_UpdateAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...grpc.CallOption) (*DeleteAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAnnotationResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_DeleteAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) SearchAnnotations(ctx context.Context, in *SearchAnnotationsRequest, opts ...grpc.CallOption) (*SearchAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAnnotationsResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_SearchAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) ExportAnnotations(ctx context.Context, in *ExportAnnotationsRequest, opts ...grpc.CallOption) (*ExportAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAnnotationsResponse)
+	err := c.cc.Invoke(ctx, AnnotationService_ExportAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationServiceClient) ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnnotationService_ServiceDesc.Streams[0], AnnotationService_ExportNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportNotesRequest, ExportNotesChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnotationService_ExportNotesClient = grpc.ServerStreamingClient[ExportNotesChunk]
+
+// AnnotationServiceServer is the server API for AnnotationService service.
+// All implementations must embed UnimplementedAnnotationServiceServer
+// for forward compatibility.
+//
+// AnnotationService manages highlights and comments on saved articles.
+type AnnotationServiceServer interface {
+	CreateAnnotation(context.Context, *CreateAnnotationRequest) (*CreateAnnotationResponse, error)
+	GetAnnotation(context.Context, *GetAnnotationRequest) (*GetAnnotationResponse, error)
+	ListAnnotations(context.Context, *ListAnnotationsRequest) (*ListAnnotationsResponse, error)
+	UpdateAnnotation(context.Context, *UpdateAnnotationRequest) (*UpdateAnnotationResponse, error)
+	DeleteAnnotation(context.Context, *DeleteAnnotationRequest) (*DeleteAnnotationResponse, error)
+	SearchAnnotations(context.Context, *SearchAnnotationsRequest) (*SearchAnnotationsResponse, error)
+	ExportAnnotations(context.Context, *ExportAnnotationsRequest) (*ExportAnnotationsResponse, error)
+	// ExportNotes streams a zip archive with one Markdown file per article in a
+	// library, holding its notes and annotations.
+	ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesChunk]) error
+	mustEmbedUnimplementedAnnotationServiceServer()
+}
+
+// UnimplementedAnnotationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnotationServiceServer struct{}
+
+func (UnimplementedAnnotationServiceServer) CreateAnnotation(context.Context, *CreateAnnotationRequest) (*CreateAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnotation not implemented")
+}
+func (UnimplementedAnnotationServiceServer) GetAnnotation(context.Context, *GetAnnotationRequest) (*GetAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnotation not implemented")
+}
+func (UnimplementedAnnotationServiceServer) ListAnnotations(context.Context, *ListAnnotationsRequest) (*ListAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnotations not implemented")
+}
+func (UnimplementedAnnotationServiceServer) UpdateAnnotation(context.Context, *UpdateAnnotationRequest) (*UpdateAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnotation not implemented")
+}
+func (UnimplementedAnnotationServiceServer) DeleteAnnotation(context.Context, *DeleteAnnotationRequest) (*DeleteAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnotation not implemented")
+}
+func (UnimplementedAnnotationServiceServer) SearchAnnotations(context.Context, *SearchAnnotationsRequest) (*SearchAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAnnotations not implemented")
+}
+func (UnimplementedAnnotationServiceServer) ExportAnnotations(context.Context, *ExportAnnotationsRequest) (*ExportAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAnnotations not implemented")
+}
+func (UnimplementedAnnotationServiceServer) ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportNotes not implemented")
+}
+func (UnimplementedAnnotationServiceServer) mustEmbedUnimplementedAnnotationServiceServer() {}
+func (UnimplementedAnnotationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAnnotationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnotationServiceServer will
+// result in compilation errors.
+type UnsafeAnnotationServiceServer interface {
+	mustEmbedUnimplementedAnnotationServiceServer()
+}
+
+func RegisterAnnotationServiceServer(s grpc.ServiceRegistrar, srv AnnotationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnotationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnotationService_ServiceDesc, srv)
+}
+
+func _AnnotationService_CreateAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).CreateAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_CreateAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).CreateAnnotation(ctx, req.(*CreateAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_GetAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).GetAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_GetAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).GetAnnotation(ctx, req.(*GetAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_ListAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).ListAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_ListAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).ListAnnotations(ctx, req.(*ListAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_UpdateAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).UpdateAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_UpdateAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).UpdateAnnotation(ctx, req.(*UpdateAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_DeleteAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).DeleteAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_DeleteAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).DeleteAnnotation(ctx, req.(*DeleteAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_SearchAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).SearchAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_SearchAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).SearchAnnotations(ctx, req.(*SearchAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_ExportAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationServiceServer).ExportAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotationService_ExportAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationServiceServer).ExportAnnotations(ctx, req.(*ExportAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnotationService_ExportNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnnotationServiceServer).ExportNotes(m, &grpc.GenericServerStream[ExportNotesRequest, ExportNotesChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnotationService_ExportNotesServer = grpc.ServerStreamingServer[ExportNotesChunk]
+
+// AnnotationService_ServiceDesc is the grpc.ServiceDesc for AnnotationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnotationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.annotation.v1.AnnotationService",
+	HandlerType: (*AnnotationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAnnotation",
+			Handler:    _AnnotationService_CreateAnnotation_Handler,
+		},
+		{
+			MethodName: "GetAnnotation",
+			Handler:    _AnnotationService_GetAnnotation_Handler,
+		},
+		{
+			MethodName: "ListAnnotations",
+			Handler:    _AnnotationService_ListAnnotations_Handler,
+		},
+		{
+			MethodName: "UpdateAnnotation",
+			Handler:    _AnnotationService_UpdateAnnotation_Handler,
+		},
+		{
+			MethodName: "DeleteAnnotation",
+			Handler:    _AnnotationService_DeleteAnnotation_Handler,
+		},
+		{
+			MethodName: "SearchAnnotations",
+			Handler:    _AnnotationService_SearchAnnotations_Handler,
+		},
+		{
+			MethodName: "ExportAnnotations",
+			Handler:    _AnnotationService_ExportAnnotations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportNotes",
+			Handler:       _AnnotationService_ExportNotes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "annotation/v1/annotation.proto",
+}
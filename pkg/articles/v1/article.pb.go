@@ -1,13 +1,15 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.9
 // 	protoc        v6.32.0
 // source: articles/v1/article.proto
 
 package article
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	v1 "github.com/chiquitav2/journalful/pkg/profile/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,6 +25,163 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ArticleFlag is ordered by severity.
+type ArticleFlag int32
+
+const (
+	ArticleFlag_ARTICLE_FLAG_NONE                  ArticleFlag = 0
+	ArticleFlag_ARTICLE_FLAG_CORRECTED             ArticleFlag = 1 // Corrections, errata, addenda
+	ArticleFlag_ARTICLE_FLAG_EXPRESSION_OF_CONCERN ArticleFlag = 2 // Also partial retractions
+	ArticleFlag_ARTICLE_FLAG_RETRACTED             ArticleFlag = 3 // Retracted, withdrawn or removed
+)
+
+// Enum value maps for ArticleFlag.
+var (
+	ArticleFlag_name = map[int32]string{
+		0: "ARTICLE_FLAG_NONE",
+		1: "ARTICLE_FLAG_CORRECTED",
+		2: "ARTICLE_FLAG_EXPRESSION_OF_CONCERN",
+		3: "ARTICLE_FLAG_RETRACTED",
+	}
+	ArticleFlag_value = map[string]int32{
+		"ARTICLE_FLAG_NONE":                  0,
+		"ARTICLE_FLAG_CORRECTED":             1,
+		"ARTICLE_FLAG_EXPRESSION_OF_CONCERN": 2,
+		"ARTICLE_FLAG_RETRACTED":             3,
+	}
+)
+
+func (x ArticleFlag) Enum() *ArticleFlag {
+	p := new(ArticleFlag)
+	*p = x
+	return p
+}
+
+func (x ArticleFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_articles_v1_article_proto_enumTypes[0].Descriptor()
+}
+
+func (ArticleFlag) Type() protoreflect.EnumType {
+	return &file_articles_v1_article_proto_enumTypes[0]
+}
+
+func (x ArticleFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleFlag.Descriptor instead.
+func (ArticleFlag) EnumDescriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{0}
+}
+
+type CitationDirection int32
+
+const (
+	CitationDirection_CITATION_DIRECTION_UNSPECIFIED CitationDirection = 0 // Both directions
+	CitationDirection_CITATION_DIRECTION_REFERENCES  CitationDirection = 1
+	CitationDirection_CITATION_DIRECTION_CITED_BY    CitationDirection = 2
+)
+
+// Enum value maps for CitationDirection.
+var (
+	CitationDirection_name = map[int32]string{
+		0: "CITATION_DIRECTION_UNSPECIFIED",
+		1: "CITATION_DIRECTION_REFERENCES",
+		2: "CITATION_DIRECTION_CITED_BY",
+	}
+	CitationDirection_value = map[string]int32{
+		"CITATION_DIRECTION_UNSPECIFIED": 0,
+		"CITATION_DIRECTION_REFERENCES":  1,
+		"CITATION_DIRECTION_CITED_BY":    2,
+	}
+)
+
+func (x CitationDirection) Enum() *CitationDirection {
+	p := new(CitationDirection)
+	*p = x
+	return p
+}
+
+func (x CitationDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CitationDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_articles_v1_article_proto_enumTypes[1].Descriptor()
+}
+
+func (CitationDirection) Type() protoreflect.EnumType {
+	return &file_articles_v1_article_proto_enumTypes[1]
+}
+
+func (x CitationDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CitationDirection.Descriptor instead.
+func (CitationDirection) EnumDescriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{1}
+}
+
+type DuplicateReason int32
+
+const (
+	DuplicateReason_DUPLICATE_REASON_UNSPECIFIED       DuplicateReason = 0
+	DuplicateReason_DUPLICATE_REASON_SAME_DOI          DuplicateReason = 1 // Equal after normalization
+	DuplicateReason_DUPLICATE_REASON_SIMILAR_TITLE     DuplicateReason = 2
+	DuplicateReason_DUPLICATE_REASON_CLOSE_YEAR        DuplicateReason = 3 // Published within two years, e.g. preprint and journal version
+	DuplicateReason_DUPLICATE_REASON_SAME_FIRST_AUTHOR DuplicateReason = 4
+)
+
+// Enum value maps for DuplicateReason.
+var (
+	DuplicateReason_name = map[int32]string{
+		0: "DUPLICATE_REASON_UNSPECIFIED",
+		1: "DUPLICATE_REASON_SAME_DOI",
+		2: "DUPLICATE_REASON_SIMILAR_TITLE",
+		3: "DUPLICATE_REASON_CLOSE_YEAR",
+		4: "DUPLICATE_REASON_SAME_FIRST_AUTHOR",
+	}
+	DuplicateReason_value = map[string]int32{
+		"DUPLICATE_REASON_UNSPECIFIED":       0,
+		"DUPLICATE_REASON_SAME_DOI":          1,
+		"DUPLICATE_REASON_SIMILAR_TITLE":     2,
+		"DUPLICATE_REASON_CLOSE_YEAR":        3,
+		"DUPLICATE_REASON_SAME_FIRST_AUTHOR": 4,
+	}
+)
+
+func (x DuplicateReason) Enum() *DuplicateReason {
+	p := new(DuplicateReason)
+	*p = x
+	return p
+}
+
+func (x DuplicateReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_articles_v1_article_proto_enumTypes[2].Descriptor()
+}
+
+func (DuplicateReason) Type() protoreflect.EnumType {
+	return &file_articles_v1_article_proto_enumTypes[2]
+}
+
+func (x DuplicateReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateReason.Descriptor instead.
+func (DuplicateReason) EnumDescriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{2}
+}
+
 type Article struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -35,6 +194,8 @@ type Article struct {
 	JournalName     *string                `protobuf:"bytes,8,opt,name=journal_name,json=journalName,proto3,oneof" json:"journal_name,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags            []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`                                   // New field for article tags
+	Flag            ArticleFlag            `protobuf:"varint,12,opt,name=flag,proto3,enum=api.articles.v1.ArticleFlag" json:"flag,omitempty"` // Most severe correction notice on record
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -139,6 +300,98 @@ func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Article) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Article) GetFlag() ArticleFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return ArticleFlag_ARTICLE_FLAG_NONE
+}
+
+// ArticleUpdate is a notice concerning an article, taken from CrossRef's
+// update-to/updated-by relations.
+type ArticleUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // CrossRef update type, e.g. "retraction" or "erratum"
+	Flag          ArticleFlag            `protobuf:"varint,2,opt,name=flag,proto3,enum=api.articles.v1.ArticleFlag" json:"flag,omitempty"`
+	NoticeDoi     string                 `protobuf:"bytes,3,opt,name=notice_doi,json=noticeDoi,proto3" json:"notice_doi,omitempty"`
+	Label         *string                `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	UpdatedOn     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_on,json=updatedOn,proto3" json:"updated_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleUpdate) Reset() {
+	*x = ArticleUpdate{}
+	mi := &file_articles_v1_article_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleUpdate) ProtoMessage() {}
+
+func (x *ArticleUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleUpdate.ProtoReflect.Descriptor instead.
+func (*ArticleUpdate) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArticleUpdate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ArticleUpdate) GetFlag() ArticleFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return ArticleFlag_ARTICLE_FLAG_NONE
+}
+
+func (x *ArticleUpdate) GetNoticeDoi() string {
+	if x != nil {
+		return x.NoticeDoi
+	}
+	return ""
+}
+
+func (x *ArticleUpdate) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ArticleUpdate) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
 type GetArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -148,7 +401,7 @@ type GetArticleRequest struct {
 
 func (x *GetArticleRequest) Reset() {
 	*x = GetArticleRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[1]
+	mi := &file_articles_v1_article_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -160,7 +413,7 @@ func (x *GetArticleRequest) String() string {
 func (*GetArticleRequest) ProtoMessage() {}
 
 func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[1]
+	mi := &file_articles_v1_article_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +426,7 @@ func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
 func (*GetArticleRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{1}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetArticleRequest) GetId() int64 {
@@ -184,15 +437,16 @@ func (x *GetArticleRequest) GetId() int64 {
 }
 
 type GetArticleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Article          *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	RedirectedFromId *int64                 `protobuf:"varint,2,opt,name=redirected_from_id,json=redirectedFromId,proto3,oneof" json:"redirected_from_id,omitempty"` // Set when the requested article was merged into this one
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetArticleResponse) Reset() {
 	*x = GetArticleResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[2]
+	mi := &file_articles_v1_article_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -204,7 +458,7 @@ func (x *GetArticleResponse) String() string {
 func (*GetArticleResponse) ProtoMessage() {}
 
 func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[2]
+	mi := &file_articles_v1_article_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +471,7 @@ func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
 func (*GetArticleResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{2}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetArticleResponse) GetArticle() *Article {
@@ -227,16 +481,23 @@ func (x *GetArticleResponse) GetArticle() *Article {
 	return nil
 }
 
+func (x *GetArticleResponse) GetRedirectedFromId() int64 {
+	if x != nil && x.RedirectedFromId != nil {
+		return *x.RedirectedFromId
+	}
+	return 0
+}
+
 type GetArticleByDOIRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Doi           string                 `protobuf:"bytes,1,opt,name=doi,proto3" json:"doi,omitempty"`
+	Doi           string                 `protobuf:"bytes,1,opt,name=doi,proto3" json:"doi,omitempty"` // With or without a resolver prefix like https://doi.org/
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetArticleByDOIRequest) Reset() {
 	*x = GetArticleByDOIRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[3]
+	mi := &file_articles_v1_article_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +509,7 @@ func (x *GetArticleByDOIRequest) String() string {
 func (*GetArticleByDOIRequest) ProtoMessage() {}
 
 func (x *GetArticleByDOIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[3]
+	mi := &file_articles_v1_article_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +522,7 @@ func (x *GetArticleByDOIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleByDOIRequest.ProtoReflect.Descriptor instead.
 func (*GetArticleByDOIRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{3}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetArticleByDOIRequest) GetDoi() string {
@@ -272,15 +533,16 @@ func (x *GetArticleByDOIRequest) GetDoi() string {
 }
 
 type GetArticleByDOIResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Article          *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	RedirectedFromId *int64                 `protobuf:"varint,2,opt,name=redirected_from_id,json=redirectedFromId,proto3,oneof" json:"redirected_from_id,omitempty"` // Set when the article with this DOI was merged into this one
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetArticleByDOIResponse) Reset() {
 	*x = GetArticleByDOIResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[4]
+	mi := &file_articles_v1_article_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +554,7 @@ func (x *GetArticleByDOIResponse) String() string {
 func (*GetArticleByDOIResponse) ProtoMessage() {}
 
 func (x *GetArticleByDOIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[4]
+	mi := &file_articles_v1_article_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +567,7 @@ func (x *GetArticleByDOIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleByDOIResponse.ProtoReflect.Descriptor instead.
 func (*GetArticleByDOIResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{4}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetArticleByDOIResponse) GetArticle() *Article {
@@ -315,6 +577,13 @@ func (x *GetArticleByDOIResponse) GetArticle() *Article {
 	return nil
 }
 
+func (x *GetArticleByDOIResponse) GetRedirectedFromId() int64 {
+	if x != nil && x.RedirectedFromId != nil {
+		return *x.RedirectedFromId
+	}
+	return 0
+}
+
 type ListArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                         // Page number for pagination
@@ -325,7 +594,7 @@ type ListArticlesRequest struct {
 
 func (x *ListArticlesRequest) Reset() {
 	*x = ListArticlesRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[5]
+	mi := &file_articles_v1_article_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +606,7 @@ func (x *ListArticlesRequest) String() string {
 func (*ListArticlesRequest) ProtoMessage() {}
 
 func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[5]
+	mi := &file_articles_v1_article_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +619,7 @@ func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
 func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{5}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListArticlesRequest) GetPage() int32 {
@@ -376,7 +645,7 @@ type ListArticlesResponse struct {
 
 func (x *ListArticlesResponse) Reset() {
 	*x = ListArticlesResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[6]
+	mi := &file_articles_v1_article_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +657,7 @@ func (x *ListArticlesResponse) String() string {
 func (*ListArticlesResponse) ProtoMessage() {}
 
 func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[6]
+	mi := &file_articles_v1_article_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +670,7 @@ func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
 func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{6}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListArticlesResponse) GetArticles() []*Article {
@@ -414,7 +683,7 @@ func (x *ListArticlesResponse) GetArticles() []*Article {
 type CreateArticleRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Doi             string                 `protobuf:"bytes,1,opt,name=doi,proto3" json:"doi,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`     // Required if the DOI's metadata cannot be fetched
 	Authors         []*v1.Author           `protobuf:"bytes,4,rep,name=authors,proto3" json:"authors,omitempty"` // List of authors
 	Abstract        *string                `protobuf:"bytes,5,opt,name=abstract,proto3,oneof" json:"abstract,omitempty"`
 	PublicationYear *int32                 `protobuf:"varint,6,opt,name=publication_year,json=publicationYear,proto3,oneof" json:"publication_year,omitempty"`
@@ -425,7 +694,7 @@ type CreateArticleRequest struct {
 
 func (x *CreateArticleRequest) Reset() {
 	*x = CreateArticleRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[7]
+	mi := &file_articles_v1_article_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +706,7 @@ func (x *CreateArticleRequest) String() string {
 func (*CreateArticleRequest) ProtoMessage() {}
 
 func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[7]
+	mi := &file_articles_v1_article_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +719,7 @@ func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
 func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{7}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateArticleRequest) GetDoi() string {
@@ -504,7 +773,7 @@ type CreateArticleResponse struct {
 
 func (x *CreateArticleResponse) Reset() {
 	*x = CreateArticleResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[8]
+	mi := &file_articles_v1_article_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +785,7 @@ func (x *CreateArticleResponse) String() string {
 func (*CreateArticleResponse) ProtoMessage() {}
 
 func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[8]
+	mi := &file_articles_v1_article_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +798,7 @@ func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArticleResponse.ProtoReflect.Descriptor instead.
 func (*CreateArticleResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{8}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateArticleResponse) GetId() int64 {
@@ -553,7 +822,7 @@ type UpdateArticleRequest struct {
 
 func (x *UpdateArticleRequest) Reset() {
 	*x = UpdateArticleRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[9]
+	mi := &file_articles_v1_article_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +834,7 @@ func (x *UpdateArticleRequest) String() string {
 func (*UpdateArticleRequest) ProtoMessage() {}
 
 func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[9]
+	mi := &file_articles_v1_article_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +847,7 @@ func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{9}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateArticleRequest) GetId() int64 {
@@ -631,7 +900,7 @@ type UpdateArticleResponse struct {
 
 func (x *UpdateArticleResponse) Reset() {
 	*x = UpdateArticleResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[10]
+	mi := &file_articles_v1_article_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +912,7 @@ func (x *UpdateArticleResponse) String() string {
 func (*UpdateArticleResponse) ProtoMessage() {}
 
 func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[10]
+	mi := &file_articles_v1_article_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +925,7 @@ func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateArticleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateArticleResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{10}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{11}
 }
 
 type DeleteArticleRequest struct {
@@ -668,7 +937,7 @@ type DeleteArticleRequest struct {
 
 func (x *DeleteArticleRequest) Reset() {
 	*x = DeleteArticleRequest{}
-	mi := &file_articles_v1_article_proto_msgTypes[11]
+	mi := &file_articles_v1_article_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +949,7 @@ func (x *DeleteArticleRequest) String() string {
 func (*DeleteArticleRequest) ProtoMessage() {}
 
 func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[11]
+	mi := &file_articles_v1_article_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +962,7 @@ func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{11}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteArticleRequest) GetId() int64 {
@@ -711,7 +980,7 @@ type DeleteArticleResponse struct {
 
 func (x *DeleteArticleResponse) Reset() {
 	*x = DeleteArticleResponse{}
-	mi := &file_articles_v1_article_proto_msgTypes[12]
+	mi := &file_articles_v1_article_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +992,7 @@ func (x *DeleteArticleResponse) String() string {
 func (*DeleteArticleResponse) ProtoMessage() {}
 
 func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_articles_v1_article_proto_msgTypes[12]
+	mi := &file_articles_v1_article_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,81 +1005,1201 @@ func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
-	return file_articles_v1_article_proto_rawDescGZIP(), []int{12}
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{13}
 }
 
-var File_articles_v1_article_proto protoreflect.FileDescriptor
+// SearchArticlesRequest matches the query against article metadata and the
+// text of attached PDFs.
+type SearchArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // Also searches the user's private library attachments
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 for the default of 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_articles_v1_article_proto_rawDesc = "" +
-	"\n" +
-	"\x19articles/v1/article.proto\x12\x0fapi.articles.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17profile/v1/author.proto\"\xa7\x03\n" +
-	"\aArticle\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
-	"\x03doi\x18\x02 \x01(\tR\x03doi\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x04 \x01(\tR\x03url\x120\n" +
-	"\aauthors\x18\x05 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\x12\x1f\n" +
-	"\babstract\x18\x06 \x01(\tH\x00R\babstract\x88\x01\x01\x12.\n" +
-	"\x10publication_year\x18\a \x01(\x05H\x01R\x0fpublicationYear\x88\x01\x01\x12&\n" +
-	"\fjournal_name\x18\b \x01(\tH\x02R\vjournalName\x88\x01\x01\x129\n" +
-	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
-	"\t_abstractB\x13\n" +
-	"\x11_publication_yearB\x0f\n" +
-	"\r_journal_name\"#\n" +
-	"\x11GetArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
-	"\x12GetArticleResponse\x122\n" +
-	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\"*\n" +
-	"\x16GetArticleByDOIRequest\x12\x10\n" +
-	"\x03doi\x18\x01 \x01(\tR\x03doi\"M\n" +
-	"\x17GetArticleByDOIResponse\x122\n" +
-	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\"g\n" +
-	"\x13ListArticlesRequest\x12\x17\n" +
-	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01B\a\n" +
-	"\x05_pageB\f\n" +
-	"\n" +
-	"_page_size\"L\n" +
-	"\x14ListArticlesResponse\x124\n" +
-	"\barticles\x18\x01 \x03(\v2\x18.api.articles.v1.ArticleR\barticles\"\x9c\x02\n" +
-	"\x14CreateArticleRequest\x12\x10\n" +
-	"\x03doi\x18\x01 \x01(\tR\x03doi\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x120\n" +
-	"\aauthors\x18\x04 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\x12\x1f\n" +
-	"\babstract\x18\x05 \x01(\tH\x00R\babstract\x88\x01\x01\x12.\n" +
-	"\x10publication_year\x18\x06 \x01(\x05H\x01R\x0fpublicationYear\x88\x01\x01\x12&\n" +
-	"\fjournal_name\x18\a \x01(\tH\x02R\vjournalName\x88\x01\x01B\v\n" +
-	"\t_abstractB\x13\n" +
-	"\x11_publication_yearB\x0f\n" +
-	"\r_journal_name\"'\n" +
-	"\x15CreateArticleResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xfa\x01\n" +
-	"\x14UpdateArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
-	"\x03doi\x18\x02 \x01(\tR\x03doi\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1f\n" +
-	"\babstract\x18\x04 \x01(\tH\x00R\babstract\x88\x01\x01\x12.\n" +
-	"\x10publication_year\x18\x05 \x01(\x05H\x01R\x0fpublicationYear\x88\x01\x01\x12&\n" +
-	"\fjournal_name\x18\x06 \x01(\tH\x02R\vjournalName\x88\x01\x01B\v\n" +
-	"\t_abstractB\x13\n" +
-	"\x11_publication_yearB\x0f\n" +
-	"\r_journal_name\"\x17\n" +
-	"\x15UpdateArticleResponse\"&\n" +
-	"\x14DeleteArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
-	"\x15DeleteArticleResponse2\xcb\x04\n" +
-	"\x0fArticlesService\x12U\n" +
+func (x *SearchArticlesRequest) Reset() {
+	*x = SearchArticlesRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesRequest) ProtoMessage() {}
+
+func (x *SearchArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesRequest.ProtoReflect.Descriptor instead.
+func (*SearchArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchArticlesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type PageHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  int64                  `protobuf:"varint,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	PageNumber    int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"` // Starting at 1
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageHit) Reset() {
+	*x = PageHit{}
+	mi := &file_articles_v1_article_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageHit) ProtoMessage() {}
+
+func (x *PageHit) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageHit.ProtoReflect.Descriptor instead.
+func (*PageHit) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PageHit) GetAttachmentId() int64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+func (x *PageHit) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *PageHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type ArticleSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	MetadataMatch bool                   `protobuf:"varint,2,opt,name=metadata_match,json=metadataMatch,proto3" json:"metadata_match,omitempty"` // The title, abstract or DOI matched
+	PageHits      []*PageHit             `protobuf:"bytes,3,rep,name=page_hits,json=pageHits,proto3" json:"page_hits,omitempty"`                 // Most relevant first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleSearchHit) Reset() {
+	*x = ArticleSearchHit{}
+	mi := &file_articles_v1_article_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleSearchHit) ProtoMessage() {}
+
+func (x *ArticleSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleSearchHit.ProtoReflect.Descriptor instead.
+func (*ArticleSearchHit) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArticleSearchHit) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *ArticleSearchHit) GetMetadataMatch() bool {
+	if x != nil {
+		return x.MetadataMatch
+	}
+	return false
+}
+
+func (x *ArticleSearchHit) GetPageHits() []*PageHit {
+	if x != nil {
+		return x.PageHits
+	}
+	return nil
+}
+
+type SearchArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*ArticleSearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesResponse) Reset() {
+	*x = SearchArticlesResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesResponse) ProtoMessage() {}
+
+func (x *SearchArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesResponse.ProtoReflect.Descriptor instead.
+func (*SearchArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchArticlesResponse) GetHits() []*ArticleSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// Reference is an entry in an article's reference list. cited_article_id is
+// set when the cited work is also stored locally; otherwise the reference is
+// dangling and only described by the metadata CrossRef provided.
+type Reference struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Position        int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // Starting at 1, in the order CrossRef lists them
+	Doi             *string                `protobuf:"bytes,2,opt,name=doi,proto3,oneof" json:"doi,omitempty"`
+	CitedArticleId  *int64                 `protobuf:"varint,3,opt,name=cited_article_id,json=citedArticleId,proto3,oneof" json:"cited_article_id,omitempty"`
+	Title           *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Author          *string                `protobuf:"bytes,5,opt,name=author,proto3,oneof" json:"author,omitempty"` // First author as given by CrossRef
+	PublicationYear *int32                 `protobuf:"varint,6,opt,name=publication_year,json=publicationYear,proto3,oneof" json:"publication_year,omitempty"`
+	JournalName     *string                `protobuf:"bytes,7,opt,name=journal_name,json=journalName,proto3,oneof" json:"journal_name,omitempty"`
+	Unstructured    *string                `protobuf:"bytes,8,opt,name=unstructured,proto3,oneof" json:"unstructured,omitempty"` // Free-text citation when nothing else is known
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	mi := &file_articles_v1_article_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Reference) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Reference) GetDoi() string {
+	if x != nil && x.Doi != nil {
+		return *x.Doi
+	}
+	return ""
+}
+
+func (x *Reference) GetCitedArticleId() int64 {
+	if x != nil && x.CitedArticleId != nil {
+		return *x.CitedArticleId
+	}
+	return 0
+}
+
+func (x *Reference) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Reference) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
+	}
+	return ""
+}
+
+func (x *Reference) GetPublicationYear() int32 {
+	if x != nil && x.PublicationYear != nil {
+		return *x.PublicationYear
+	}
+	return 0
+}
+
+func (x *Reference) GetJournalName() string {
+	if x != nil && x.JournalName != nil {
+		return *x.JournalName
+	}
+	return ""
+}
+
+func (x *Reference) GetUnstructured() string {
+	if x != nil && x.Unstructured != nil {
+		return *x.Unstructured
+	}
+	return ""
+}
+
+type ListReferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReferencesRequest) Reset() {
+	*x = ListReferencesRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReferencesRequest) ProtoMessage() {}
+
+func (x *ListReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListReferencesRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type ListReferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	References    []*Reference           `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReferencesResponse) Reset() {
+	*x = ListReferencesResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReferencesResponse) ProtoMessage() {}
+
+func (x *ListReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListReferencesResponse) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type ListCitedByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitedByRequest) Reset() {
+	*x = ListCitedByRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitedByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitedByRequest) ProtoMessage() {}
+
+func (x *ListCitedByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitedByRequest.ProtoReflect.Descriptor instead.
+func (*ListCitedByRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCitedByRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type ListCitedByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"` // Local articles whose references include this one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitedByResponse) Reset() {
+	*x = ListCitedByResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitedByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitedByResponse) ProtoMessage() {}
+
+func (x *ListCitedByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitedByResponse.ProtoReflect.Descriptor instead.
+func (*ListCitedByResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCitedByResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type GetCitationGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"` // 0 for the default of 1
+	Direction     CitationDirection      `protobuf:"varint,3,opt,name=direction,proto3,enum=api.articles.v1.CitationDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCitationGraphRequest) Reset() {
+	*x = GetCitationGraphRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCitationGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitationGraphRequest) ProtoMessage() {}
+
+func (x *GetCitationGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitationGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetCitationGraphRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCitationGraphRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *GetCitationGraphRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetCitationGraphRequest) GetDirection() CitationDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return CitationDirection_CITATION_DIRECTION_UNSPECIFIED
+}
+
+type CitationNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // "article:<id>" for local articles, "doi:<doi>" or "ref:<id>" for dangling references
+	ArticleId     *int64                 `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3,oneof" json:"article_id,omitempty"`
+	Doi           *string                `protobuf:"bytes,3,opt,name=doi,proto3,oneof" json:"doi,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Depth         int32                  `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"` // Distance from the requested article
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitationNode) Reset() {
+	*x = CitationNode{}
+	mi := &file_articles_v1_article_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CitationNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitationNode) ProtoMessage() {}
+
+func (x *CitationNode) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitationNode.ProtoReflect.Descriptor instead.
+func (*CitationNode) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CitationNode) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CitationNode) GetArticleId() int64 {
+	if x != nil && x.ArticleId != nil {
+		return *x.ArticleId
+	}
+	return 0
+}
+
+func (x *CitationNode) GetDoi() string {
+	if x != nil && x.Doi != nil {
+		return *x.Doi
+	}
+	return ""
+}
+
+func (x *CitationNode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CitationNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type CitationEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CitingKey     string                 `protobuf:"bytes,1,opt,name=citing_key,json=citingKey,proto3" json:"citing_key,omitempty"`
+	CitedKey      string                 `protobuf:"bytes,2,opt,name=cited_key,json=citedKey,proto3" json:"cited_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitationEdge) Reset() {
+	*x = CitationEdge{}
+	mi := &file_articles_v1_article_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CitationEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitationEdge) ProtoMessage() {}
+
+func (x *CitationEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitationEdge.ProtoReflect.Descriptor instead.
+func (*CitationEdge) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CitationEdge) GetCitingKey() string {
+	if x != nil {
+		return x.CitingKey
+	}
+	return ""
+}
+
+func (x *CitationEdge) GetCitedKey() string {
+	if x != nil {
+		return x.CitedKey
+	}
+	return ""
+}
+
+type GetCitationGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*CitationNode        `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*CitationEdge        `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // The node limit was reached before the requested depth
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCitationGraphResponse) Reset() {
+	*x = GetCitationGraphResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCitationGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitationGraphResponse) ProtoMessage() {}
+
+func (x *GetCitationGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitationGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetCitationGraphResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCitationGraphResponse) GetNodes() []*CitationNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetCitationGraphResponse) GetEdges() []*CitationEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetCitationGraphResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type DuplicateCandidate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Article         *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Reasons         []DuplicateReason      `protobuf:"varint,2,rep,packed,name=reasons,proto3,enum=api.articles.v1.DuplicateReason" json:"reasons,omitempty"`
+	TitleSimilarity float64                `protobuf:"fixed64,3,opt,name=title_similarity,json=titleSimilarity,proto3" json:"title_similarity,omitempty"` // 0 to 1
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_articles_v1_article_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DuplicateCandidate) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetReasons() []DuplicateReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetTitleSimilarity() float64 {
+	if x != nil {
+		return x.TitleSimilarity
+	}
+	return 0
+}
+
+type FindDuplicatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FindDuplicatesRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type FindDuplicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*DuplicateCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"` // Most likely first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FindDuplicatesResponse) GetCandidates() []*DuplicateCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// MergeArticlesRequest folds the duplicate into the survivor. Library
+// entries, tags, authors, attachments and references move to the survivor,
+// and the duplicate's id and DOI redirect to it afterwards.
+type MergeArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SurvivorId    int64                  `protobuf:"varint,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	DuplicateId   int64                  `protobuf:"varint,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeArticlesRequest) Reset() {
+	*x = MergeArticlesRequest{}
+	mi := &file_articles_v1_article_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeArticlesRequest) ProtoMessage() {}
+
+func (x *MergeArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeArticlesRequest.ProtoReflect.Descriptor instead.
+func (*MergeArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MergeArticlesRequest) GetSurvivorId() int64 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeArticlesRequest) GetDuplicateId() int64 {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return 0
+}
+
+type MergeArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"` // The survivor after the merge
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeArticlesResponse) Reset() {
+	*x = MergeArticlesResponse{}
+	mi := &file_articles_v1_article_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeArticlesResponse) ProtoMessage() {}
+
+func (x *MergeArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_v1_article_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeArticlesResponse.ProtoReflect.Descriptor instead.
+func (*MergeArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_v1_article_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MergeArticlesResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+var File_articles_v1_article_proto protoreflect.FileDescriptor
+
+const file_articles_v1_article_proto_rawDesc = "" +
+	"\n" +
+	"\x19articles/v1/article.proto\x12\x0fapi.articles.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17profile/v1/author.proto\"\xed\x03\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03doi\x18\x02 \x01(\tR\x03doi\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x120\n" +
+	"\aauthors\x18\x05 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\x12\x1f\n" +
+	"\babstract\x18\x06 \x01(\tH\x00R\babstract\x88\x01\x01\x12.\n" +
+	"\x10publication_year\x18\a \x01(\x05H\x01R\x0fpublicationYear\x88\x01\x01\x12&\n" +
+	"\fjournal_name\x18\b \x01(\tH\x02R\vjournalName\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x120\n" +
+	"\x04flag\x18\f \x01(\x0e2\x1c.api.articles.v1.ArticleFlagR\x04flagB\v\n" +
+	"\t_abstractB\x13\n" +
+	"\x11_publication_yearB\x0f\n" +
+	"\r_journal_name\"\xd4\x01\n" +
+	"\rArticleUpdate\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x120\n" +
+	"\x04flag\x18\x02 \x01(\x0e2\x1c.api.articles.v1.ArticleFlagR\x04flag\x12\x1d\n" +
+	"\n" +
+	"notice_doi\x18\x03 \x01(\tR\tnoticeDoi\x12\x19\n" +
+	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOnB\b\n" +
+	"\x06_label\",\n" +
+	"\x11GetArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x92\x01\n" +
+	"\x12GetArticleResponse\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\x121\n" +
+	"\x12redirected_from_id\x18\x02 \x01(\x03H\x00R\x10redirectedFromId\x88\x01\x01B\x15\n" +
+	"\x13_redirected_from_id\"{\n" +
+	"\x16GetArticleByDOIRequest\x12a\n" +
+	"\x03doi\x18\x01 \x01(\tBO\xbaHLrJ\x18d2F^(?i)(doi:\\s*|https?://(dx\\.)?doi\\.org/|doi\\.org/)?10\\.[0-9]{4,9}/\\S+$R\x03doi\"\x97\x01\n" +
+	"\x17GetArticleByDOIResponse\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\x121\n" +
+	"\x12redirected_from_id\x18\x02 \x01(\x03H\x00R\x10redirectedFromId\x88\x01\x01B\x15\n" +
+	"\x13_redirected_from_id\"{\n" +
+	"\x13ListArticlesRequest\x12 \n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\x00R\x04page\x88\x01\x01\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"L\n" +
+	"\x14ListArticlesResponse\x124\n" +
+	"\barticles\x18\x01 \x03(\v2\x18.api.articles.v1.ArticleR\barticles\"\x8e\x03\n" +
+	"\x14CreateArticleRequest\x12a\n" +
+	"\x03doi\x18\x01 \x01(\tBO\xbaHLrJ\x18d2F^(?i)(doi:\\s*|https?://(dx\\.)?doi\\.org/|doi\\.org/)?10\\.[0-9]{4,9}/\\S+$R\x03doi\x12\x1e\n" +
+	"\x05title\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05title\x120\n" +
+	"\aauthors\x18\x04 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\x12\x1f\n" +
+	"\babstract\x18\x05 \x01(\tH\x00R\babstract\x88\x01\x01\x12;\n" +
+	"\x10publication_year\x18\x06 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x8fN(\xe8\aH\x01R\x0fpublicationYear\x88\x01\x01\x120\n" +
+	"\fjournal_name\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\vjournalName\x88\x01\x01B\v\n" +
+	"\t_abstractB\x13\n" +
+	"\x11_publication_yearB\x0f\n" +
+	"\r_journal_name\"'\n" +
+	"\x15CreateArticleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xf7\x02\n" +
+	"\x14UpdateArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12a\n" +
+	"\x03doi\x18\x02 \x01(\tBO\xbaHLrJ\x18d2F^(?i)(doi:\\s*|https?://(dx\\.)?doi\\.org/|doi\\.org/)?10\\.[0-9]{4,9}/\\S+$R\x03doi\x12 \n" +
+	"\x05title\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12\x1f\n" +
+	"\babstract\x18\x04 \x01(\tH\x00R\babstract\x88\x01\x01\x12;\n" +
+	"\x10publication_year\x18\x05 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x8fN(\xe8\aH\x01R\x0fpublicationYear\x88\x01\x01\x120\n" +
+	"\fjournal_name\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\vjournalName\x88\x01\x01B\v\n" +
+	"\t_abstractB\x13\n" +
+	"\x11_publication_yearB\x0f\n" +
+	"\r_journal_name\"\x17\n" +
+	"\x15UpdateArticleResponse\"/\n" +
+	"\x14DeleteArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x17\n" +
+	"\x15DeleteArticleResponse\"\x85\x01\n" +
+	"\x15SearchArticlesRequest\x12\"\n" +
+	"\x05query\x18\x01 \x01(\tB\f\xbaH\tr\a\x18\xf4\x032\x02\\SR\x05query\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06userId\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x182(\x00R\bpageSize\"i\n" +
+	"\aPageHit\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\x03R\fattachmentId\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\x05R\n" +
+	"pageNumber\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"\xa4\x01\n" +
+	"\x10ArticleSearchHit\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\x12%\n" +
+	"\x0emetadata_match\x18\x02 \x01(\bR\rmetadataMatch\x125\n" +
+	"\tpage_hits\x18\x03 \x03(\v2\x18.api.articles.v1.PageHitR\bpageHits\"O\n" +
+	"\x16SearchArticlesResponse\x125\n" +
+	"\x04hits\x18\x01 \x03(\v2!.api.articles.v1.ArticleSearchHitR\x04hits\"\x8f\x03\n" +
+	"\tReference\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x15\n" +
+	"\x03doi\x18\x02 \x01(\tH\x00R\x03doi\x88\x01\x01\x12-\n" +
+	"\x10cited_article_id\x18\x03 \x01(\x03H\x01R\x0ecitedArticleId\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x04 \x01(\tH\x02R\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06author\x18\x05 \x01(\tH\x03R\x06author\x88\x01\x01\x12.\n" +
+	"\x10publication_year\x18\x06 \x01(\x05H\x04R\x0fpublicationYear\x88\x01\x01\x12&\n" +
+	"\fjournal_name\x18\a \x01(\tH\x05R\vjournalName\x88\x01\x01\x12'\n" +
+	"\funstructured\x18\b \x01(\tH\x06R\funstructured\x88\x01\x01B\x06\n" +
+	"\x04_doiB\x13\n" +
+	"\x11_cited_article_idB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_authorB\x13\n" +
+	"\x11_publication_yearB\x0f\n" +
+	"\r_journal_nameB\x0f\n" +
+	"\r_unstructured\"?\n" +
+	"\x15ListReferencesRequest\x12&\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\"T\n" +
+	"\x16ListReferencesResponse\x12:\n" +
+	"\n" +
+	"references\x18\x01 \x03(\v2\x1a.api.articles.v1.ReferenceR\n" +
+	"references\"<\n" +
+	"\x12ListCitedByRequest\x12&\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\"K\n" +
+	"\x13ListCitedByResponse\x124\n" +
+	"\barticles\x18\x01 \x03(\v2\x18.api.articles.v1.ArticleR\barticles\"\xae\x01\n" +
+	"\x17GetCitationGraphRequest\x12&\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\x12\x1f\n" +
+	"\x05depth\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x03(\x00R\x05depth\x12J\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\".api.articles.v1.CitationDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01R\tdirection\"\x9e\x01\n" +
+	"\fCitationNode\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03H\x00R\tarticleId\x88\x01\x01\x12\x15\n" +
+	"\x03doi\x18\x03 \x01(\tH\x01R\x03doi\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x14\n" +
+	"\x05depth\x18\x05 \x01(\x05R\x05depthB\r\n" +
+	"\v_article_idB\x06\n" +
+	"\x04_doi\"J\n" +
+	"\fCitationEdge\x12\x1d\n" +
+	"\n" +
+	"citing_key\x18\x01 \x01(\tR\tcitingKey\x12\x1b\n" +
+	"\tcited_key\x18\x02 \x01(\tR\bcitedKey\"\xa2\x01\n" +
+	"\x18GetCitationGraphResponse\x123\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1d.api.articles.v1.CitationNodeR\x05nodes\x123\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1d.api.articles.v1.CitationEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xaf\x01\n" +
+	"\x12DuplicateCandidate\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\x12:\n" +
+	"\areasons\x18\x02 \x03(\x0e2 .api.articles.v1.DuplicateReasonR\areasons\x12)\n" +
+	"\x10title_similarity\x18\x03 \x01(\x01R\x0ftitleSimilarity\"?\n" +
+	"\x15FindDuplicatesRequest\x12&\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\"]\n" +
+	"\x16FindDuplicatesResponse\x12C\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2#.api.articles.v1.DuplicateCandidateR\n" +
+	"candidates\"\xd8\x01\n" +
+	"\x14MergeArticlesRequest\x12(\n" +
+	"\vsurvivor_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"survivorId\x12*\n" +
+	"\fduplicate_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vduplicateId:j\xbaHg\x1ae\n" +
+	"\x17merge_articles.distinct\x12#cannot merge an article into itself\x1a%this.survivor_id != this.duplicate_id\"K\n" +
+	"\x15MergeArticlesResponse\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle*\x84\x01\n" +
+	"\vArticleFlag\x12\x15\n" +
+	"\x11ARTICLE_FLAG_NONE\x10\x00\x12\x1a\n" +
+	"\x16ARTICLE_FLAG_CORRECTED\x10\x01\x12&\n" +
+	"\"ARTICLE_FLAG_EXPRESSION_OF_CONCERN\x10\x02\x12\x1a\n" +
+	"\x16ARTICLE_FLAG_RETRACTED\x10\x03*{\n" +
+	"\x11CitationDirection\x12\"\n" +
+	"\x1eCITATION_DIRECTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCITATION_DIRECTION_REFERENCES\x10\x01\x12\x1f\n" +
+	"\x1bCITATION_DIRECTION_CITED_BY\x10\x02*\xbf\x01\n" +
+	"\x0fDuplicateReason\x12 \n" +
+	"\x1cDUPLICATE_REASON_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DUPLICATE_REASON_SAME_DOI\x10\x01\x12\"\n" +
+	"\x1eDUPLICATE_REASON_SIMILAR_TITLE\x10\x02\x12\x1f\n" +
+	"\x1bDUPLICATE_REASON_CLOSE_YEAR\x10\x03\x12&\n" +
+	"\"DUPLICATE_REASON_SAME_FIRST_AUTHOR\x10\x042\xc0\f\n" +
+	"\x0fArticlesService\x12p\n" +
 	"\n" +
-	"GetArticle\x12\".api.articles.v1.GetArticleRequest\x1a#.api.articles.v1.GetArticleResponse\x12d\n" +
-	"\x0fGetArticleByDOI\x12'.api.articles.v1.GetArticleByDOIRequest\x1a(.api.articles.v1.GetArticleByDOIResponse\x12[\n" +
-	"\fListArticles\x12$.api.articles.v1.ListArticlesRequest\x1a%.api.articles.v1.ListArticlesResponse\x12^\n" +
-	"\rCreateArticle\x12%.api.articles.v1.CreateArticleRequest\x1a&.api.articles.v1.CreateArticleResponse\x12^\n" +
-	"\rUpdateArticle\x12%.api.articles.v1.UpdateArticleRequest\x1a&.api.articles.v1.UpdateArticleResponse\x12^\n" +
-	"\rDeleteArticle\x12%.api.articles.v1.DeleteArticleRequest\x1a&.api.articles.v1.DeleteArticleResponseB:Z8github.com/chiquitav2/journalful/pkg/articles/v1;articleb\x06proto3"
+	"GetArticle\x12\".api.articles.v1.GetArticleRequest\x1a#.api.articles.v1.GetArticleResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/articles/{id}\x12\x80\x01\n" +
+	"\x0fGetArticleByDOI\x12'.api.articles.v1.GetArticleByDOIRequest\x1a(.api.articles.v1.GetArticleByDOIResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/articles:byDoi\x12q\n" +
+	"\fListArticles\x12$.api.articles.v1.ListArticlesRequest\x1a%.api.articles.v1.ListArticlesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/articles\x12w\n" +
+	"\rCreateArticle\x12%.api.articles.v1.CreateArticleRequest\x1a&.api.articles.v1.CreateArticleResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/articles\x12|\n" +
+	"\rUpdateArticle\x12%.api.articles.v1.UpdateArticleRequest\x1a&.api.articles.v1.UpdateArticleResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/articles/{id}\x12y\n" +
+	"\rDeleteArticle\x12%.api.articles.v1.DeleteArticleRequest\x1a&.api.articles.v1.DeleteArticleResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/articles/{id}\x12~\n" +
+	"\x0eSearchArticles\x12&.api.articles.v1.SearchArticlesRequest\x1a'.api.articles.v1.SearchArticlesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/articles:search\x12\x8f\x01\n" +
+	"\x0eListReferences\x12&.api.articles.v1.ListReferencesRequest\x1a'.api.articles.v1.ListReferencesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/articles/{article_id}/references\x12\x84\x01\n" +
+	"\vListCitedBy\x12#.api.articles.v1.ListCitedByRequest\x1a$.api.articles.v1.ListCitedByResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/articles/{article_id}/cited-by\x12\x99\x01\n" +
+	"\x10GetCitationGraph\x12(.api.articles.v1.GetCitationGraphRequest\x1a).api.articles.v1.GetCitationGraphResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/articles/{article_id}/citation-graph\x12\x8f\x01\n" +
+	"\x0eFindDuplicates\x12&.api.articles.v1.FindDuplicatesRequest\x1a'.api.articles.v1.FindDuplicatesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/articles/{article_id}/duplicates\x12\x8b\x01\n" +
+	"\rMergeArticles\x12%.api.articles.v1.MergeArticlesRequest\x1a&.api.articles.v1.MergeArticlesResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/articles/{survivor_id}:mergeB:Z8github.com/chiquitav2/journalful/pkg/articles/v1;articleb\x06proto3"
 
 var (
 	file_articles_v1_article_proto_rawDescOnce sync.Once
@@ -824,49 +2213,99 @@ func file_articles_v1_article_proto_rawDescGZIP() []byte {
 	return file_articles_v1_article_proto_rawDescData
 }
 
-var file_articles_v1_article_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_articles_v1_article_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_articles_v1_article_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_articles_v1_article_proto_goTypes = []any{
-	(*Article)(nil),                 // 0: api.articles.v1.Article
-	(*GetArticleRequest)(nil),       // 1: api.articles.v1.GetArticleRequest
-	(*GetArticleResponse)(nil),      // 2: api.articles.v1.GetArticleResponse
-	(*GetArticleByDOIRequest)(nil),  // 3: api.articles.v1.GetArticleByDOIRequest
-	(*GetArticleByDOIResponse)(nil), // 4: api.articles.v1.GetArticleByDOIResponse
-	(*ListArticlesRequest)(nil),     // 5: api.articles.v1.ListArticlesRequest
-	(*ListArticlesResponse)(nil),    // 6: api.articles.v1.ListArticlesResponse
-	(*CreateArticleRequest)(nil),    // 7: api.articles.v1.CreateArticleRequest
-	(*CreateArticleResponse)(nil),   // 8: api.articles.v1.CreateArticleResponse
-	(*UpdateArticleRequest)(nil),    // 9: api.articles.v1.UpdateArticleRequest
-	(*UpdateArticleResponse)(nil),   // 10: api.articles.v1.UpdateArticleResponse
-	(*DeleteArticleRequest)(nil),    // 11: api.articles.v1.DeleteArticleRequest
-	(*DeleteArticleResponse)(nil),   // 12: api.articles.v1.DeleteArticleResponse
-	(*v1.Author)(nil),               // 13: api.profile.v1.Author
-	(*timestamppb.Timestamp)(nil),   // 14: google.protobuf.Timestamp
+	(ArticleFlag)(0),                 // 0: api.articles.v1.ArticleFlag
+	(CitationDirection)(0),           // 1: api.articles.v1.CitationDirection
+	(DuplicateReason)(0),             // 2: api.articles.v1.DuplicateReason
+	(*Article)(nil),                  // 3: api.articles.v1.Article
+	(*ArticleUpdate)(nil),            // 4: api.articles.v1.ArticleUpdate
+	(*GetArticleRequest)(nil),        // 5: api.articles.v1.GetArticleRequest
+	(*GetArticleResponse)(nil),       // 6: api.articles.v1.GetArticleResponse
+	(*GetArticleByDOIRequest)(nil),   // 7: api.articles.v1.GetArticleByDOIRequest
+	(*GetArticleByDOIResponse)(nil),  // 8: api.articles.v1.GetArticleByDOIResponse
+	(*ListArticlesRequest)(nil),      // 9: api.articles.v1.ListArticlesRequest
+	(*ListArticlesResponse)(nil),     // 10: api.articles.v1.ListArticlesResponse
+	(*CreateArticleRequest)(nil),     // 11: api.articles.v1.CreateArticleRequest
+	(*CreateArticleResponse)(nil),    // 12: api.articles.v1.CreateArticleResponse
+	(*UpdateArticleRequest)(nil),     // 13: api.articles.v1.UpdateArticleRequest
+	(*UpdateArticleResponse)(nil),    // 14: api.articles.v1.UpdateArticleResponse
+	(*DeleteArticleRequest)(nil),     // 15: api.articles.v1.DeleteArticleRequest
+	(*DeleteArticleResponse)(nil),    // 16: api.articles.v1.DeleteArticleResponse
+	(*SearchArticlesRequest)(nil),    // 17: api.articles.v1.SearchArticlesRequest
+	(*PageHit)(nil),                  // 18: api.articles.v1.PageHit
+	(*ArticleSearchHit)(nil),         // 19: api.articles.v1.ArticleSearchHit
+	(*SearchArticlesResponse)(nil),   // 20: api.articles.v1.SearchArticlesResponse
+	(*Reference)(nil),                // 21: api.articles.v1.Reference
+	(*ListReferencesRequest)(nil),    // 22: api.articles.v1.ListReferencesRequest
+	(*ListReferencesResponse)(nil),   // 23: api.articles.v1.ListReferencesResponse
+	(*ListCitedByRequest)(nil),       // 24: api.articles.v1.ListCitedByRequest
+	(*ListCitedByResponse)(nil),      // 25: api.articles.v1.ListCitedByResponse
+	(*GetCitationGraphRequest)(nil),  // 26: api.articles.v1.GetCitationGraphRequest
+	(*CitationNode)(nil),             // 27: api.articles.v1.CitationNode
+	(*CitationEdge)(nil),             // 28: api.articles.v1.CitationEdge
+	(*GetCitationGraphResponse)(nil), // 29: api.articles.v1.GetCitationGraphResponse
+	(*DuplicateCandidate)(nil),       // 30: api.articles.v1.DuplicateCandidate
+	(*FindDuplicatesRequest)(nil),    // 31: api.articles.v1.FindDuplicatesRequest
+	(*FindDuplicatesResponse)(nil),   // 32: api.articles.v1.FindDuplicatesResponse
+	(*MergeArticlesRequest)(nil),     // 33: api.articles.v1.MergeArticlesRequest
+	(*MergeArticlesResponse)(nil),    // 34: api.articles.v1.MergeArticlesResponse
+	(*v1.Author)(nil),                // 35: api.profile.v1.Author
+	(*timestamppb.Timestamp)(nil),    // 36: google.protobuf.Timestamp
 }
 var file_articles_v1_article_proto_depIdxs = []int32{
-	13, // 0: api.articles.v1.Article.authors:type_name -> api.profile.v1.Author
-	14, // 1: api.articles.v1.Article.created_at:type_name -> google.protobuf.Timestamp
-	14, // 2: api.articles.v1.Article.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: api.articles.v1.GetArticleResponse.article:type_name -> api.articles.v1.Article
-	0,  // 4: api.articles.v1.GetArticleByDOIResponse.article:type_name -> api.articles.v1.Article
-	0,  // 5: api.articles.v1.ListArticlesResponse.articles:type_name -> api.articles.v1.Article
-	13, // 6: api.articles.v1.CreateArticleRequest.authors:type_name -> api.profile.v1.Author
-	1,  // 7: api.articles.v1.ArticlesService.GetArticle:input_type -> api.articles.v1.GetArticleRequest
-	3,  // 8: api.articles.v1.ArticlesService.GetArticleByDOI:input_type -> api.articles.v1.GetArticleByDOIRequest
-	5,  // 9: api.articles.v1.ArticlesService.ListArticles:input_type -> api.articles.v1.ListArticlesRequest
-	7,  // 10: api.articles.v1.ArticlesService.CreateArticle:input_type -> api.articles.v1.CreateArticleRequest
-	9,  // 11: api.articles.v1.ArticlesService.UpdateArticle:input_type -> api.articles.v1.UpdateArticleRequest
-	11, // 12: api.articles.v1.ArticlesService.DeleteArticle:input_type -> api.articles.v1.DeleteArticleRequest
-	2,  // 13: api.articles.v1.ArticlesService.GetArticle:output_type -> api.articles.v1.GetArticleResponse
-	4,  // 14: api.articles.v1.ArticlesService.GetArticleByDOI:output_type -> api.articles.v1.GetArticleByDOIResponse
-	6,  // 15: api.articles.v1.ArticlesService.ListArticles:output_type -> api.articles.v1.ListArticlesResponse
-	8,  // 16: api.articles.v1.ArticlesService.CreateArticle:output_type -> api.articles.v1.CreateArticleResponse
-	10, // 17: api.articles.v1.ArticlesService.UpdateArticle:output_type -> api.articles.v1.UpdateArticleResponse
-	12, // 18: api.articles.v1.ArticlesService.DeleteArticle:output_type -> api.articles.v1.DeleteArticleResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	35, // 0: api.articles.v1.Article.authors:type_name -> api.profile.v1.Author
+	36, // 1: api.articles.v1.Article.created_at:type_name -> google.protobuf.Timestamp
+	36, // 2: api.articles.v1.Article.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: api.articles.v1.Article.flag:type_name -> api.articles.v1.ArticleFlag
+	0,  // 4: api.articles.v1.ArticleUpdate.flag:type_name -> api.articles.v1.ArticleFlag
+	36, // 5: api.articles.v1.ArticleUpdate.updated_on:type_name -> google.protobuf.Timestamp
+	3,  // 6: api.articles.v1.GetArticleResponse.article:type_name -> api.articles.v1.Article
+	3,  // 7: api.articles.v1.GetArticleByDOIResponse.article:type_name -> api.articles.v1.Article
+	3,  // 8: api.articles.v1.ListArticlesResponse.articles:type_name -> api.articles.v1.Article
+	35, // 9: api.articles.v1.CreateArticleRequest.authors:type_name -> api.profile.v1.Author
+	3,  // 10: api.articles.v1.ArticleSearchHit.article:type_name -> api.articles.v1.Article
+	18, // 11: api.articles.v1.ArticleSearchHit.page_hits:type_name -> api.articles.v1.PageHit
+	19, // 12: api.articles.v1.SearchArticlesResponse.hits:type_name -> api.articles.v1.ArticleSearchHit
+	21, // 13: api.articles.v1.ListReferencesResponse.references:type_name -> api.articles.v1.Reference
+	3,  // 14: api.articles.v1.ListCitedByResponse.articles:type_name -> api.articles.v1.Article
+	1,  // 15: api.articles.v1.GetCitationGraphRequest.direction:type_name -> api.articles.v1.CitationDirection
+	27, // 16: api.articles.v1.GetCitationGraphResponse.nodes:type_name -> api.articles.v1.CitationNode
+	28, // 17: api.articles.v1.GetCitationGraphResponse.edges:type_name -> api.articles.v1.CitationEdge
+	3,  // 18: api.articles.v1.DuplicateCandidate.article:type_name -> api.articles.v1.Article
+	2,  // 19: api.articles.v1.DuplicateCandidate.reasons:type_name -> api.articles.v1.DuplicateReason
+	30, // 20: api.articles.v1.FindDuplicatesResponse.candidates:type_name -> api.articles.v1.DuplicateCandidate
+	3,  // 21: api.articles.v1.MergeArticlesResponse.article:type_name -> api.articles.v1.Article
+	5,  // 22: api.articles.v1.ArticlesService.GetArticle:input_type -> api.articles.v1.GetArticleRequest
+	7,  // 23: api.articles.v1.ArticlesService.GetArticleByDOI:input_type -> api.articles.v1.GetArticleByDOIRequest
+	9,  // 24: api.articles.v1.ArticlesService.ListArticles:input_type -> api.articles.v1.ListArticlesRequest
+	11, // 25: api.articles.v1.ArticlesService.CreateArticle:input_type -> api.articles.v1.CreateArticleRequest
+	13, // 26: api.articles.v1.ArticlesService.UpdateArticle:input_type -> api.articles.v1.UpdateArticleRequest
+	15, // 27: api.articles.v1.ArticlesService.DeleteArticle:input_type -> api.articles.v1.DeleteArticleRequest
+	17, // 28: api.articles.v1.ArticlesService.SearchArticles:input_type -> api.articles.v1.SearchArticlesRequest
+	22, // 29: api.articles.v1.ArticlesService.ListReferences:input_type -> api.articles.v1.ListReferencesRequest
+	24, // 30: api.articles.v1.ArticlesService.ListCitedBy:input_type -> api.articles.v1.ListCitedByRequest
+	26, // 31: api.articles.v1.ArticlesService.GetCitationGraph:input_type -> api.articles.v1.GetCitationGraphRequest
+	31, // 32: api.articles.v1.ArticlesService.FindDuplicates:input_type -> api.articles.v1.FindDuplicatesRequest
+	33, // 33: api.articles.v1.ArticlesService.MergeArticles:input_type -> api.articles.v1.MergeArticlesRequest
+	6,  // 34: api.articles.v1.ArticlesService.GetArticle:output_type -> api.articles.v1.GetArticleResponse
+	8,  // 35: api.articles.v1.ArticlesService.GetArticleByDOI:output_type -> api.articles.v1.GetArticleByDOIResponse
+	10, // 36: api.articles.v1.ArticlesService.ListArticles:output_type -> api.articles.v1.ListArticlesResponse
+	12, // 37: api.articles.v1.ArticlesService.CreateArticle:output_type -> api.articles.v1.CreateArticleResponse
+	14, // 38: api.articles.v1.ArticlesService.UpdateArticle:output_type -> api.articles.v1.UpdateArticleResponse
+	16, // 39: api.articles.v1.ArticlesService.DeleteArticle:output_type -> api.articles.v1.DeleteArticleResponse
+	20, // 40: api.articles.v1.ArticlesService.SearchArticles:output_type -> api.articles.v1.SearchArticlesResponse
+	23, // 41: api.articles.v1.ArticlesService.ListReferences:output_type -> api.articles.v1.ListReferencesResponse
+	25, // 42: api.articles.v1.ArticlesService.ListCitedBy:output_type -> api.articles.v1.ListCitedByResponse
+	29, // 43: api.articles.v1.ArticlesService.GetCitationGraph:output_type -> api.articles.v1.GetCitationGraphResponse
+	32, // 44: api.articles.v1.ArticlesService.FindDuplicates:output_type -> api.articles.v1.FindDuplicatesResponse
+	34, // 45: api.articles.v1.ArticlesService.MergeArticles:output_type -> api.articles.v1.MergeArticlesResponse
+	34, // [34:46] is the sub-list for method output_type
+	22, // [22:34] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_articles_v1_article_proto_init() }
@@ -875,21 +2314,27 @@ func file_articles_v1_article_proto_init() {
 		return
 	}
 	file_articles_v1_article_proto_msgTypes[0].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[1].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[3].OneofWrappers = []any{}
 	file_articles_v1_article_proto_msgTypes[5].OneofWrappers = []any{}
-	file_articles_v1_article_proto_msgTypes[7].OneofWrappers = []any{}
-	file_articles_v1_article_proto_msgTypes[9].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[6].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[8].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[10].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[18].OneofWrappers = []any{}
+	file_articles_v1_article_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_articles_v1_article_proto_rawDesc), len(file_articles_v1_article_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_articles_v1_article_proto_goTypes,
 		DependencyIndexes: file_articles_v1_article_proto_depIdxs,
+		EnumInfos:         file_articles_v1_article_proto_enumTypes,
 		MessageInfos:      file_articles_v1_article_proto_msgTypes,
 	}.Build()
 	File_articles_v1_article_proto = out.File
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArticlesService_GetArticle_FullMethodName      = "/api.articles.v1.ArticlesService/GetArticle"
-	ArticlesService_GetArticleByDOI_FullMethodName = "/api.articles.v1.ArticlesService/GetArticleByDOI"
-	ArticlesService_ListArticles_FullMethodName    = "/api.articles.v1.ArticlesService/ListArticles"
-	ArticlesService_CreateArticle_FullMethodName   = "/api.articles.v1.ArticlesService/CreateArticle"
-	ArticlesService_UpdateArticle_FullMethodName   = "/api.articles.v1.ArticlesService/UpdateArticle"
-	ArticlesService_DeleteArticle_FullMethodName   = "/api.articles.v1.ArticlesService/DeleteArticle"
+	ArticlesService_GetArticle_FullMethodName       = "/api.articles.v1.ArticlesService/GetArticle"
+	ArticlesService_GetArticleByDOI_FullMethodName  = "/api.articles.v1.ArticlesService/GetArticleByDOI"
+	ArticlesService_ListArticles_FullMethodName     = "/api.articles.v1.ArticlesService/ListArticles"
+	ArticlesService_CreateArticle_FullMethodName    = "/api.articles.v1.ArticlesService/CreateArticle"
+	ArticlesService_UpdateArticle_FullMethodName    = "/api.articles.v1.ArticlesService/UpdateArticle"
+	ArticlesService_DeleteArticle_FullMethodName    = "/api.articles.v1.ArticlesService/DeleteArticle"
+	ArticlesService_SearchArticles_FullMethodName   = "/api.articles.v1.ArticlesService/SearchArticles"
+	ArticlesService_ListReferences_FullMethodName   = "/api.articles.v1.ArticlesService/ListReferences"
+	ArticlesService_ListCitedBy_FullMethodName      = "/api.articles.v1.ArticlesService/ListCitedBy"
+	ArticlesService_GetCitationGraph_FullMethodName = "/api.articles.v1.ArticlesService/GetCitationGraph"
+	ArticlesService_FindDuplicates_FullMethodName   = "/api.articles.v1.ArticlesService/FindDuplicates"
+	ArticlesService_MergeArticles_FullMethodName    = "/api.articles.v1.ArticlesService/MergeArticles"
 )
 
 // ArticlesServiceClient is the client API for ArticlesService service.
@@ -37,6 +43,12 @@ type ArticlesServiceClient interface {
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*CreateArticleResponse, error)
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*UpdateArticleResponse, error)
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleResponse, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	ListReferences(ctx context.Context, in *ListReferencesRequest, opts ...grpc.CallOption) (*ListReferencesResponse, error)
+	ListCitedBy(ctx context.Context, in *ListCitedByRequest, opts ...grpc.CallOption) (*ListCitedByResponse, error)
+	GetCitationGraph(ctx context.Context, in *GetCitationGraphRequest, opts ...grpc.CallOption) (*GetCitationGraphResponse, error)
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	MergeArticles(ctx context.Context, in *MergeArticlesRequest, opts ...grpc.CallOption) (*MergeArticlesResponse, error)
 }
 
 type articlesServiceClient struct {
@@ -107,6 +119,66 @@ func (c *articlesServiceClient) DeleteArticle(ctx context.Context, in *DeleteArt
 	return out, nil
 }
 
+func (c *articlesServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articlesServiceClient) ListReferences(ctx context.Context, in *ListReferencesRequest, opts ...grpc.CallOption) (*ListReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReferencesResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_ListReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articlesServiceClient) ListCitedBy(ctx context.Context, in *ListCitedByRequest, opts ...grpc.CallOption) (*ListCitedByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCitedByResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_ListCitedBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articlesServiceClient) GetCitationGraph(ctx context.Context, in *GetCitationGraphRequest, opts ...grpc.CallOption) (*GetCitationGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCitationGraphResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_GetCitationGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articlesServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articlesServiceClient) MergeArticles(ctx context.Context, in *MergeArticlesRequest, opts ...grpc.CallOption) (*MergeArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticlesService_MergeArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticlesServiceServer is the server API for ArticlesService service.
 // All implementations must embed UnimplementedArticlesServiceServer
 // for forward compatibility.
@@ -117,6 +189,12 @@ type ArticlesServiceServer interface {
 	CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleResponse, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*UpdateArticleResponse, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	ListReferences(context.Context, *ListReferencesRequest) (*ListReferencesResponse, error)
+	ListCitedBy(context.Context, *ListCitedByRequest) (*ListCitedByResponse, error)
+	GetCitationGraph(context.Context, *GetCitationGraphRequest) (*GetCitationGraphResponse, error)
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	MergeArticles(context.Context, *MergeArticlesRequest) (*MergeArticlesResponse, error)
 	mustEmbedUnimplementedArticlesServiceServer()
 }
 
@@ -145,6 +223,24 @@ func (UnimplementedArticlesServiceServer) UpdateArticle(context.Context, *Update
 func (UnimplementedArticlesServiceServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
 }
+func (UnimplementedArticlesServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedArticlesServiceServer) ListReferences(context.Context, *ListReferencesRequest) (*ListReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReferences not implemented")
+}
+func (UnimplementedArticlesServiceServer) ListCitedBy(context.Context, *ListCitedByRequest) (*ListCitedByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCitedBy not implemented")
+}
+func (UnimplementedArticlesServiceServer) GetCitationGraph(context.Context, *GetCitationGraphRequest) (*GetCitationGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCitationGraph not implemented")
+}
+func (UnimplementedArticlesServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedArticlesServiceServer) MergeArticles(context.Context, *MergeArticlesRequest) (*MergeArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeArticles not implemented")
+}
 func (UnimplementedArticlesServiceServer) mustEmbedUnimplementedArticlesServiceServer() {}
 func (UnimplementedArticlesServiceServer) testEmbeddedByValue()                         {}
 
@@ -274,6 +370,114 @@ func _ArticlesService_DeleteArticle_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticlesService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticlesService_ListReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).ListReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_ListReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).ListReferences(ctx, req.(*ListReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticlesService_ListCitedBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCitedByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).ListCitedBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_ListCitedBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).ListCitedBy(ctx, req.(*ListCitedByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticlesService_GetCitationGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCitationGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).GetCitationGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_GetCitationGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).GetCitationGraph(ctx, req.(*GetCitationGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticlesService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticlesService_MergeArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticlesServiceServer).MergeArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticlesService_MergeArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticlesServiceServer).MergeArticles(ctx, req.(*MergeArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArticlesService_ServiceDesc is the grpc.ServiceDesc for ArticlesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +509,30 @@ var ArticlesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArticle",
 			Handler:    _ArticlesService_DeleteArticle_Handler,
 		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _ArticlesService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "ListReferences",
+			Handler:    _ArticlesService_ListReferences_Handler,
+		},
+		{
+			MethodName: "ListCitedBy",
+			Handler:    _ArticlesService_ListCitedBy_Handler,
+		},
+		{
+			MethodName: "GetCitationGraph",
+			Handler:    _ArticlesService_GetCitationGraph_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ArticlesService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeArticles",
+			Handler:    _ArticlesService_MergeArticles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "articles/v1/article.proto",
@@ -0,0 +1,1337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: attachment/v1/attachment.proto
+
+package attachment
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExtractionStatus tracks pulling the text out of an uploaded PDF so it can
+// be searched.
+type ExtractionStatus int32
+
+const (
+	ExtractionStatus_EXTRACTION_STATUS_UNSPECIFIED ExtractionStatus = 0 // Not a PDF, nothing to extract
+	ExtractionStatus_EXTRACTION_STATUS_PENDING     ExtractionStatus = 1
+	ExtractionStatus_EXTRACTION_STATUS_PROCESSING  ExtractionStatus = 2
+	ExtractionStatus_EXTRACTION_STATUS_DONE        ExtractionStatus = 3
+	ExtractionStatus_EXTRACTION_STATUS_FAILED      ExtractionStatus = 4
+)
+
+// Enum value maps for ExtractionStatus.
+var (
+	ExtractionStatus_name = map[int32]string{
+		0: "EXTRACTION_STATUS_UNSPECIFIED",
+		1: "EXTRACTION_STATUS_PENDING",
+		2: "EXTRACTION_STATUS_PROCESSING",
+		3: "EXTRACTION_STATUS_DONE",
+		4: "EXTRACTION_STATUS_FAILED",
+	}
+	ExtractionStatus_value = map[string]int32{
+		"EXTRACTION_STATUS_UNSPECIFIED": 0,
+		"EXTRACTION_STATUS_PENDING":     1,
+		"EXTRACTION_STATUS_PROCESSING":  2,
+		"EXTRACTION_STATUS_DONE":        3,
+		"EXTRACTION_STATUS_FAILED":      4,
+	}
+)
+
+func (x ExtractionStatus) Enum() *ExtractionStatus {
+	p := new(ExtractionStatus)
+	*p = x
+	return p
+}
+
+func (x ExtractionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExtractionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_attachment_v1_attachment_proto_enumTypes[0].Descriptor()
+}
+
+func (ExtractionStatus) Type() protoreflect.EnumType {
+	return &file_attachment_v1_attachment_proto_enumTypes[0]
+}
+
+func (x ExtractionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExtractionStatus.Descriptor instead.
+func (ExtractionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{0}
+}
+
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId     int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Filename    string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // Sniffed from the contents, not taken from the client
+	Size        int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256      string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"` // Hex encoded
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Attachment_ArticleId
+	//	*Attachment_LibraryArticleId
+	Target           isAttachment_Target    `protobuf_oneof:"target"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExtractionStatus ExtractionStatus       `protobuf:"varint,10,opt,name=extraction_status,json=extractionStatus,proto3,enum=api.attachment.v1.ExtractionStatus" json:"extraction_status,omitempty"`
+	ExtractionError  *string                `protobuf:"bytes,11,opt,name=extraction_error,json=extractionError,proto3,oneof" json:"extraction_error,omitempty"` // Set when the extraction failed
+	PageCount        *int32                 `protobuf:"varint,12,opt,name=page_count,json=pageCount,proto3,oneof" json:"page_count,omitempty"`                  // Set once the extraction is done
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetTarget() isAttachment_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Attachment) GetArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*Attachment_ArticleId); ok {
+			return x.ArticleId
+		}
+	}
+	return 0
+}
+
+func (x *Attachment) GetLibraryArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*Attachment_LibraryArticleId); ok {
+			return x.LibraryArticleId
+		}
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Attachment) GetExtractionStatus() ExtractionStatus {
+	if x != nil {
+		return x.ExtractionStatus
+	}
+	return ExtractionStatus_EXTRACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Attachment) GetExtractionError() string {
+	if x != nil && x.ExtractionError != nil {
+		return *x.ExtractionError
+	}
+	return ""
+}
+
+func (x *Attachment) GetPageCount() int32 {
+	if x != nil && x.PageCount != nil {
+		return *x.PageCount
+	}
+	return 0
+}
+
+type isAttachment_Target interface {
+	isAttachment_Target()
+}
+
+type Attachment_ArticleId struct {
+	ArticleId int64 `protobuf:"varint,7,opt,name=article_id,json=articleId,proto3,oneof"` // Shared with everyone who can see the article
+}
+
+type Attachment_LibraryArticleId struct {
+	LibraryArticleId int64 `protobuf:"varint,8,opt,name=library_article_id,json=libraryArticleId,proto3,oneof"` // Private to the library
+}
+
+func (*Attachment_ArticleId) isAttachment_Target() {}
+
+func (*Attachment_LibraryArticleId) isAttachment_Target() {}
+
+type AttachmentMetadata struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filename string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*AttachmentMetadata_ArticleId
+	//	*AttachmentMetadata_LibraryArticleId
+	Target        isAttachmentMetadata_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentMetadata) Reset() {
+	*x = AttachmentMetadata{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMetadata) ProtoMessage() {}
+
+func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachmentMetadata) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AttachmentMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetTarget() isAttachmentMetadata_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *AttachmentMetadata) GetArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*AttachmentMetadata_ArticleId); ok {
+			return x.ArticleId
+		}
+	}
+	return 0
+}
+
+func (x *AttachmentMetadata) GetLibraryArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*AttachmentMetadata_LibraryArticleId); ok {
+			return x.LibraryArticleId
+		}
+	}
+	return 0
+}
+
+type isAttachmentMetadata_Target interface {
+	isAttachmentMetadata_Target()
+}
+
+type AttachmentMetadata_ArticleId struct {
+	ArticleId int64 `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3,oneof"`
+}
+
+type AttachmentMetadata_LibraryArticleId struct {
+	LibraryArticleId int64 `protobuf:"varint,4,opt,name=library_article_id,json=libraryArticleId,proto3,oneof"`
+}
+
+func (*AttachmentMetadata_ArticleId) isAttachmentMetadata_Target() {}
+
+func (*AttachmentMetadata_LibraryArticleId) isAttachmentMetadata_Target() {}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *AttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	Metadata *AttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Deduplicated  bool                   `protobuf:"varint,2,opt,name=deduplicated,proto3" json:"deduplicated,omitempty"` // The contents were already stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *UploadAttachmentResponse) GetDeduplicated() bool {
+	if x != nil {
+		return x.Deduplicated
+	}
+	return false
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAttachmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentResponse) Reset() {
+	*x = GetAttachmentResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ListAttachmentsRequest_ArticleId
+	//	*ListAttachmentsRequest_LibraryArticleId
+	Target        isListAttachmentsRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAttachmentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListAttachmentsRequest) GetTarget() isListAttachmentsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ListAttachmentsRequest) GetArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*ListAttachmentsRequest_ArticleId); ok {
+			return x.ArticleId
+		}
+	}
+	return 0
+}
+
+func (x *ListAttachmentsRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*ListAttachmentsRequest_LibraryArticleId); ok {
+			return x.LibraryArticleId
+		}
+	}
+	return 0
+}
+
+type isListAttachmentsRequest_Target interface {
+	isListAttachmentsRequest_Target()
+}
+
+type ListAttachmentsRequest_ArticleId struct {
+	ArticleId int64 `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3,oneof"`
+}
+
+type ListAttachmentsRequest_LibraryArticleId struct {
+	LibraryArticleId int64 `protobuf:"varint,3,opt,name=library_article_id,json=libraryArticleId,proto3,oneof"`
+}
+
+func (*ListAttachmentsRequest_ArticleId) isListAttachmentsRequest_Target() {}
+
+func (*ListAttachmentsRequest_LibraryArticleId) isListAttachmentsRequest_Target() {}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAttachmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStorageUsageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetStorageUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UsedBytes     int64                  `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes    int64                  `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageResponse) Reset() {
+	*x = GetStorageUsageResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetStorageUsageResponse) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+type RetryExtractionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryExtractionRequest) Reset() {
+	*x = RetryExtractionRequest{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryExtractionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryExtractionRequest) ProtoMessage() {}
+
+func (x *RetryExtractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryExtractionRequest.ProtoReflect.Descriptor instead.
+func (*RetryExtractionRequest) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RetryExtractionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RetryExtractionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RetryExtractionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryExtractionResponse) Reset() {
+	*x = RetryExtractionResponse{}
+	mi := &file_attachment_v1_attachment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryExtractionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryExtractionResponse) ProtoMessage() {}
+
+func (x *RetryExtractionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attachment_v1_attachment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryExtractionResponse.ProtoReflect.Descriptor instead.
+func (*RetryExtractionResponse) Descriptor() ([]byte, []int) {
+	return file_attachment_v1_attachment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetryExtractionResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+var File_attachment_v1_attachment_proto protoreflect.FileDescriptor
+
+const file_attachment_v1_attachment_proto_rawDesc = "" +
+	"\n" +
+	"\x1eattachment/v1/attachment.proto\x12\x11api.attachment.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x04\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x1f\n" +
+	"\n" +
+	"article_id\x18\a \x01(\x03H\x00R\tarticleId\x12.\n" +
+	"\x12library_article_id\x18\b \x01(\x03H\x00R\x10libraryArticleId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12P\n" +
+	"\x11extraction_status\x18\n" +
+	" \x01(\x0e2#.api.attachment.v1.ExtractionStatusR\x10extractionStatus\x12.\n" +
+	"\x10extraction_error\x18\v \x01(\tH\x01R\x0fextractionError\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_count\x18\f \x01(\x05H\x02R\tpageCount\x88\x01\x01B\b\n" +
+	"\x06targetB\x13\n" +
+	"\x11_extraction_errorB\r\n" +
+	"\v_page_count\"\xd2\x01\n" +
+	"\x12AttachmentMetadata\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12&\n" +
+	"\bfilename\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfilename\x12(\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tarticleId\x127\n" +
+	"\x12library_article_id\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x10libraryArticleIdB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"\x81\x01\n" +
+	"\x17UploadAttachmentRequest\x12C\n" +
+	"\bmetadata\x18\x01 \x01(\v2%.api.attachment.v1.AttachmentMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"}\n" +
+	"\x18UploadAttachmentResponse\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1d.api.attachment.v1.AttachmentR\n" +
+	"attachment\x12\"\n" +
+	"\fdeduplicated\x18\x02 \x01(\bR\fdeduplicated\"V\n" +
+	"\x19DownloadAttachmentRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"\x80\x01\n" +
+	"\x1aDownloadAttachmentResponse\x12?\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1d.api.attachment.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"Q\n" +
+	"\x14GetAttachmentRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"V\n" +
+	"\x15GetAttachmentResponse\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1d.api.attachment.v1.AttachmentR\n" +
+	"attachment\"\xae\x01\n" +
+	"\x16ListAttachmentsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12(\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tarticleId\x127\n" +
+	"\x12library_article_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x10libraryArticleIdB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"Z\n" +
+	"\x17ListAttachmentsResponse\x12?\n" +
+	"\vattachments\x18\x01 \x03(\v2\x1d.api.attachment.v1.AttachmentR\vattachments\"T\n" +
+	"\x17DeleteAttachmentRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\":\n" +
+	"\x16GetStorageUsageRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"Y\n" +
+	"\x17GetStorageUsageResponse\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x01 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x02 \x01(\x03R\n" +
+	"quotaBytes\"S\n" +
+	"\x16RetryExtractionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"X\n" +
+	"\x17RetryExtractionResponse\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1d.api.attachment.v1.AttachmentR\n" +
+	"attachment*\xb0\x01\n" +
+	"\x10ExtractionStatus\x12!\n" +
+	"\x1dEXTRACTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EXTRACTION_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cEXTRACTION_STATUS_PROCESSING\x10\x02\x12\x1a\n" +
+	"\x16EXTRACTION_STATUS_DONE\x10\x03\x12\x1c\n" +
+	"\x18EXTRACTION_STATUS_FAILED\x10\x042\x86\x06\n" +
+	"\x11AttachmentService\x12m\n" +
+	"\x10UploadAttachment\x12*.api.attachment.v1.UploadAttachmentRequest\x1a+.api.attachment.v1.UploadAttachmentResponse(\x01\x12s\n" +
+	"\x12DownloadAttachment\x12,.api.attachment.v1.DownloadAttachmentRequest\x1a-.api.attachment.v1.DownloadAttachmentResponse0\x01\x12b\n" +
+	"\rGetAttachment\x12'.api.attachment.v1.GetAttachmentRequest\x1a(.api.attachment.v1.GetAttachmentResponse\x12h\n" +
+	"\x0fListAttachments\x12).api.attachment.v1.ListAttachmentsRequest\x1a*.api.attachment.v1.ListAttachmentsResponse\x12k\n" +
+	"\x10DeleteAttachment\x12*.api.attachment.v1.DeleteAttachmentRequest\x1a+.api.attachment.v1.DeleteAttachmentResponse\x12h\n" +
+	"\x0fGetStorageUsage\x12).api.attachment.v1.GetStorageUsageRequest\x1a*.api.attachment.v1.GetStorageUsageResponse\x12h\n" +
+	"\x0fRetryExtraction\x12).api.attachment.v1.RetryExtractionRequest\x1a*.api.attachment.v1.RetryExtractionResponseB?Z=github.com/chiquitav2/journalful/pkg/attachment/v1;attachmentb\x06proto3"
+
+var (
+	file_attachment_v1_attachment_proto_rawDescOnce sync.Once
+	file_attachment_v1_attachment_proto_rawDescData []byte
+)
+
+func file_attachment_v1_attachment_proto_rawDescGZIP() []byte {
+	file_attachment_v1_attachment_proto_rawDescOnce.Do(func() {
+		file_attachment_v1_attachment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attachment_v1_attachment_proto_rawDesc), len(file_attachment_v1_attachment_proto_rawDesc)))
+	})
+	return file_attachment_v1_attachment_proto_rawDescData
+}
+
+var file_attachment_v1_attachment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_attachment_v1_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_attachment_v1_attachment_proto_goTypes = []any{
+	(ExtractionStatus)(0),              // 0: api.attachment.v1.ExtractionStatus
+	(*Attachment)(nil),                 // 1: api.attachment.v1.Attachment
+	(*AttachmentMetadata)(nil),         // 2: api.attachment.v1.AttachmentMetadata
+	(*UploadAttachmentRequest)(nil),    // 3: api.attachment.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),   // 4: api.attachment.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),  // 5: api.attachment.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil), // 6: api.attachment.v1.DownloadAttachmentResponse
+	(*GetAttachmentRequest)(nil),       // 7: api.attachment.v1.GetAttachmentRequest
+	(*GetAttachmentResponse)(nil),      // 8: api.attachment.v1.GetAttachmentResponse
+	(*ListAttachmentsRequest)(nil),     // 9: api.attachment.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),    // 10: api.attachment.v1.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),    // 11: api.attachment.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),   // 12: api.attachment.v1.DeleteAttachmentResponse
+	(*GetStorageUsageRequest)(nil),     // 13: api.attachment.v1.GetStorageUsageRequest
+	(*GetStorageUsageResponse)(nil),    // 14: api.attachment.v1.GetStorageUsageResponse
+	(*RetryExtractionRequest)(nil),     // 15: api.attachment.v1.RetryExtractionRequest
+	(*RetryExtractionResponse)(nil),    // 16: api.attachment.v1.RetryExtractionResponse
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+}
+var file_attachment_v1_attachment_proto_depIdxs = []int32{
+	17, // 0: api.attachment.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: api.attachment.v1.Attachment.extraction_status:type_name -> api.attachment.v1.ExtractionStatus
+	2,  // 2: api.attachment.v1.UploadAttachmentRequest.metadata:type_name -> api.attachment.v1.AttachmentMetadata
+	1,  // 3: api.attachment.v1.UploadAttachmentResponse.attachment:type_name -> api.attachment.v1.Attachment
+	1,  // 4: api.attachment.v1.DownloadAttachmentResponse.attachment:type_name -> api.attachment.v1.Attachment
+	1,  // 5: api.attachment.v1.GetAttachmentResponse.attachment:type_name -> api.attachment.v1.Attachment
+	1,  // 6: api.attachment.v1.ListAttachmentsResponse.attachments:type_name -> api.attachment.v1.Attachment
+	1,  // 7: api.attachment.v1.RetryExtractionResponse.attachment:type_name -> api.attachment.v1.Attachment
+	3,  // 8: api.attachment.v1.AttachmentService.UploadAttachment:input_type -> api.attachment.v1.UploadAttachmentRequest
+	5,  // 9: api.attachment.v1.AttachmentService.DownloadAttachment:input_type -> api.attachment.v1.DownloadAttachmentRequest
+	7,  // 10: api.attachment.v1.AttachmentService.GetAttachment:input_type -> api.attachment.v1.GetAttachmentRequest
+	9,  // 11: api.attachment.v1.AttachmentService.ListAttachments:input_type -> api.attachment.v1.ListAttachmentsRequest
+	11, // 12: api.attachment.v1.AttachmentService.DeleteAttachment:input_type -> api.attachment.v1.DeleteAttachmentRequest
+	13, // 13: api.attachment.v1.AttachmentService.GetStorageUsage:input_type -> api.attachment.v1.GetStorageUsageRequest
+	15, // 14: api.attachment.v1.AttachmentService.RetryExtraction:input_type -> api.attachment.v1.RetryExtractionRequest
+	4,  // 15: api.attachment.v1.AttachmentService.UploadAttachment:output_type -> api.attachment.v1.UploadAttachmentResponse
+	6,  // 16: api.attachment.v1.AttachmentService.DownloadAttachment:output_type -> api.attachment.v1.DownloadAttachmentResponse
+	8,  // 17: api.attachment.v1.AttachmentService.GetAttachment:output_type -> api.attachment.v1.GetAttachmentResponse
+	10, // 18: api.attachment.v1.AttachmentService.ListAttachments:output_type -> api.attachment.v1.ListAttachmentsResponse
+	12, // 19: api.attachment.v1.AttachmentService.DeleteAttachment:output_type -> api.attachment.v1.DeleteAttachmentResponse
+	14, // 20: api.attachment.v1.AttachmentService.GetStorageUsage:output_type -> api.attachment.v1.GetStorageUsageResponse
+	16, // 21: api.attachment.v1.AttachmentService.RetryExtraction:output_type -> api.attachment.v1.RetryExtractionResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_attachment_v1_attachment_proto_init() }
+func file_attachment_v1_attachment_proto_init() {
+	if File_attachment_v1_attachment_proto != nil {
+		return
+	}
+	file_attachment_v1_attachment_proto_msgTypes[0].OneofWrappers = []any{
+		(*Attachment_ArticleId)(nil),
+		(*Attachment_LibraryArticleId)(nil),
+	}
+	file_attachment_v1_attachment_proto_msgTypes[1].OneofWrappers = []any{
+		(*AttachmentMetadata_ArticleId)(nil),
+		(*AttachmentMetadata_LibraryArticleId)(nil),
+	}
+	file_attachment_v1_attachment_proto_msgTypes[2].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_attachment_v1_attachment_proto_msgTypes[5].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_attachment_v1_attachment_proto_msgTypes[8].OneofWrappers = []any{
+		(*ListAttachmentsRequest_ArticleId)(nil),
+		(*ListAttachmentsRequest_LibraryArticleId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attachment_v1_attachment_proto_rawDesc), len(file_attachment_v1_attachment_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attachment_v1_attachment_proto_goTypes,
+		DependencyIndexes: file_attachment_v1_attachment_proto_depIdxs,
+		EnumInfos:         file_attachment_v1_attachment_proto_enumTypes,
+		MessageInfos:      file_attachment_v1_attachment_proto_msgTypes,
+	}.Build()
+	File_attachment_v1_attachment_proto = out.File
+	file_attachment_v1_attachment_proto_goTypes = nil
+	file_attachment_v1_attachment_proto_depIdxs = nil
+}
@@ -3,6 +3,7 @@ package conf
 import (
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/gookit/config/v2"
 	"github.com/gookit/config/v2/yaml"
@@ -65,6 +66,20 @@ func (c ServerConfig) validate() error {
 	return nil
 }
 
+// GatewayConfig configures the HTTP/JSON gateway in front of the gRPC API.
+// The gateway is disabled unless a port is set.
+type GatewayConfig struct {
+	Port string `yaml:"port" env:"GATEWAY_PORT"`
+}
+
+// AdminConfig configures the admin HTTP server, which serves Prometheus
+// metrics at /metrics. It is unauthenticated, so it is disabled unless a
+// port is set and Host should keep it off public interfaces.
+type AdminConfig struct {
+	Host string `yaml:"host" env:"ADMIN_HOST"`
+	Port string `yaml:"port" env:"ADMIN_PORT"`
+}
+
 type ZitadelConfig struct {
 	Domain       string `yaml:"domain"`
 	ClientID     string `yaml:"clientID"`
@@ -89,10 +104,169 @@ func (c ZitadelConfig) validate() error {
 	return nil
 }
 
+const (
+	AuthModeZitadel = "zitadel"
+	AuthModeJWT     = "jwt"
+	AuthModeDev     = "dev"
+)
+
+// AuthConfig selects how bearer tokens are verified: by Zitadel
+// introspection (the default, configured under zitadel), as JWTs against a
+// local key set, or against a static development token.
+//
+// PublicMethods lists full gRPC method names, like
+// "/api.library.v1.LibraryService/GetLibrary", that may be called without a
+// token, in addition to health checks and reflection. Tokens sent to them
+// are still verified.
+type AuthConfig struct {
+	Mode          string        `yaml:"mode" env:"AUTH_MODE"`
+	JWT           JWTAuthConfig `yaml:"jwt"`
+	Dev           DevAuthConfig `yaml:"dev"`
+	PublicMethods []string      `yaml:"publicMethods"`
+}
+
+// JWTAuthConfig verifies tokens against the keys in JWKSFile or, if that is
+// empty, at JWKSURL. Tokens must be issued by Issuer for Audience, so that
+// tokens the identity provider issues for other applications are refused.
+type JWTAuthConfig struct {
+	JWKSFile   string `yaml:"jwksFile"`
+	JWKSURL    string `yaml:"jwksURL"`
+	Issuer     string `yaml:"issuer"`
+	Audience   string `yaml:"audience"`
+	RolesClaim string `yaml:"rolesClaim"` // Defaults to "roles"
+}
+
+// DevAuthConfig accepts Token as a valid bearer token for the configured
+// user. Only meant for tests and offline development.
+type DevAuthConfig struct {
+	Token  string   `yaml:"token" env:"DEV_AUTH_TOKEN"`
+	UserID string   `yaml:"userID"`
+	Email  string   `yaml:"email"`
+	Name   string   `yaml:"name"`
+	Roles  []string `yaml:"roles"`
+	Scopes []string `yaml:"scopes"`
+}
+
+func (c AuthConfig) validate(zitadel ZitadelConfig) error {
+	for _, method := range c.PublicMethods {
+		service, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+		if !strings.HasPrefix(method, "/") || !ok || service == "" || name == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("public method %q is not a full method name like /package.Service/Method", method)
+		}
+	}
+
+	switch c.Mode {
+	case "", AuthModeZitadel:
+		return zitadel.validate()
+	case AuthModeJWT:
+		if c.JWT.JWKSFile == "" && c.JWT.JWKSURL == "" {
+			return fmt.Errorf("a JWKS file or URL is required for jwt auth")
+		}
+		if c.JWT.Issuer == "" {
+			return fmt.Errorf("an issuer is required for jwt auth")
+		}
+		if c.JWT.Audience == "" {
+			return fmt.Errorf("an audience is required for jwt auth")
+		}
+	case AuthModeDev:
+		if c.Dev.Token == "" {
+			return fmt.Errorf("a token is required for dev auth")
+		}
+		if c.Dev.UserID == "" {
+			return fmt.Errorf("a user ID is required for dev auth")
+		}
+	default:
+		return fmt.Errorf("unknown auth mode %q", c.Mode)
+	}
+	return nil
+}
+
+// S3Config points the blob store at an S3-compatible bucket.
+type S3Config struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"accessKey" env:"S3_ACCESS_KEY"`
+	SecretKey string `yaml:"secretKey" env:"S3_SECRET_KEY"`
+	UseSSL    bool   `yaml:"useSSL"`
+}
+
+// StorageConfig configures where attachments are kept. Zero values fall back
+// to local storage under ./data/blobs, a 1 GiB quota per user and 100 MiB
+// per upload.
+type StorageConfig struct {
+	Driver         string   `yaml:"driver"` // "local" or "s3"
+	LocalPath      string   `yaml:"localPath"`
+	S3             S3Config `yaml:"s3"`
+	QuotaBytes     int64    `yaml:"quotaBytes"`
+	MaxUploadBytes int64    `yaml:"maxUploadBytes"`
+}
+
+func (c StorageConfig) validate() error {
+	switch c.Driver {
+	case "", "local":
+	case "s3":
+		if c.S3.Endpoint == "" {
+			return fmt.Errorf("s3 endpoint is required")
+		}
+		if c.S3.Bucket == "" {
+			return fmt.Errorf("s3 bucket is required")
+		}
+	default:
+		return fmt.Errorf("unknown storage driver %q", c.Driver)
+	}
+	if c.QuotaBytes < 0 || c.MaxUploadBytes < 0 {
+		return fmt.Errorf("storage limits must not be negative")
+	}
+	return nil
+}
+
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+)
+
+// TracingConfig configures OpenTelemetry tracing. Exporter "otlp" sends
+// spans over gRPC to Endpoint, a host:port such as "localhost:4317", and
+// "stdout" writes them as JSON to File, or to standard output if File is
+// empty, for local use. Tracing is disabled when Exporter is empty.
+//
+// SampleRatio is the share of new traces that are recorded, all of them
+// when zero. Calls that carry a sampled trace context are always recorded.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+	Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure"`
+	File        string  `yaml:"file"`
+	ServiceName string  `yaml:"serviceName"` // Defaults to "journalful"
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+func (c TracingConfig) validate() error {
+	switch c.Exporter {
+	case "", TracingExporterStdout:
+	case TracingExporterOTLP:
+		if c.Endpoint == "" {
+			return fmt.Errorf("an endpoint is required for the otlp trace exporter")
+		}
+	default:
+		return fmt.Errorf("unknown trace exporter %q", c.Exporter)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("trace sample ratio must be between 0 and 1")
+	}
+	return nil
+}
+
 type Config struct {
 	Server   ServerConfig   `yaml:"server"`
+	Gateway  GatewayConfig  `yaml:"gateway"`
+	Admin    AdminConfig    `yaml:"admin"`
 	Database DatabaseConfig `yaml:"database"`
+	Auth     AuthConfig     `yaml:"auth"`
 	Zitadel  ZitadelConfig  `yaml:"zitadel"`
+	Storage  StorageConfig  `yaml:"storage"`
+	Tracing  TracingConfig  `yaml:"tracing"`
 }
 
 func (c Config) validate() error {
@@ -101,9 +275,9 @@ func (c Config) validate() error {
 		slog.Error("Error loading server config", "error", err)
 		return err
 	}
-	err = c.Zitadel.validate()
+	err = c.Auth.validate(c.Zitadel)
 	if err != nil {
-		slog.Error("Error loading zitadel config", "error", err)
+		slog.Error("Error loading auth config", "error", err)
 		return err
 	}
 	err = c.Database.validate()
@@ -111,6 +285,16 @@ func (c Config) validate() error {
 		slog.Error("Error loading database config", "error", err)
 		return err
 	}
+	err = c.Storage.validate()
+	if err != nil {
+		slog.Error("Error loading storage config", "error", err)
+		return err
+	}
+	err = c.Tracing.validate()
+	if err != nil {
+		slog.Error("Error loading tracing config", "error", err)
+		return err
+	}
 	return nil
 }
 
@@ -133,11 +317,25 @@ func Load(configFiles ...string) (*Config, error) {
 		"SERVER_HOST": "server.host",
 		"SERVER_PORT": "server.port",
 
+		"GATEWAY_PORT": "gateway.port",
+
+		"ADMIN_HOST": "admin.host",
+		"ADMIN_PORT": "admin.port",
+
+		"AUTH_MODE":      "auth.mode",
+		"DEV_AUTH_TOKEN": "auth.dev.token",
+
 		"DB_HOST":     "database.host",
 		"DB_PORT":     "database.port",
 		"DB_USER":     "database.user",
 		"DB_PASSWORD": "database.PASSWORD",
 		"DB_NAME":     "database.name",
+
+		"S3_ACCESS_KEY": "storage.s3.accessKey",
+		"S3_SECRET_KEY": "storage.s3.secretKey",
+
+		"TRACING_EXPORTER": "tracing.exporter",
+		"TRACING_ENDPOINT": "tracing.endpoint",
 	}
 	cfg.LoadOSEnvs(envConversionMap)
 
@@ -0,0 +1,1247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: goals/v1/goals.proto
+
+package goals
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GoalType int32
+
+const (
+	GoalType_GOAL_TYPE_UNSPECIFIED    GoalType = 0
+	GoalType_GOAL_TYPE_READ_COUNT     GoalType = 1 // Read target_count articles every period
+	GoalType_GOAL_TYPE_FINISH_LIBRARY GoalType = 2 // Read every article of library_id by due_date
+)
+
+// Enum value maps for GoalType.
+var (
+	GoalType_name = map[int32]string{
+		0: "GOAL_TYPE_UNSPECIFIED",
+		1: "GOAL_TYPE_READ_COUNT",
+		2: "GOAL_TYPE_FINISH_LIBRARY",
+	}
+	GoalType_value = map[string]int32{
+		"GOAL_TYPE_UNSPECIFIED":    0,
+		"GOAL_TYPE_READ_COUNT":     1,
+		"GOAL_TYPE_FINISH_LIBRARY": 2,
+	}
+)
+
+func (x GoalType) Enum() *GoalType {
+	p := new(GoalType)
+	*p = x
+	return p
+}
+
+func (x GoalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_goals_v1_goals_proto_enumTypes[0].Descriptor()
+}
+
+func (GoalType) Type() protoreflect.EnumType {
+	return &file_goals_v1_goals_proto_enumTypes[0]
+}
+
+func (x GoalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalType.Descriptor instead.
+func (GoalType) EnumDescriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{0}
+}
+
+type GoalPeriod int32
+
+const (
+	GoalPeriod_GOAL_PERIOD_UNSPECIFIED GoalPeriod = 0
+	GoalPeriod_GOAL_PERIOD_DAY         GoalPeriod = 1
+	GoalPeriod_GOAL_PERIOD_WEEK        GoalPeriod = 2 // Weeks start on Monday
+	GoalPeriod_GOAL_PERIOD_MONTH       GoalPeriod = 3
+	GoalPeriod_GOAL_PERIOD_YEAR        GoalPeriod = 4
+)
+
+// Enum value maps for GoalPeriod.
+var (
+	GoalPeriod_name = map[int32]string{
+		0: "GOAL_PERIOD_UNSPECIFIED",
+		1: "GOAL_PERIOD_DAY",
+		2: "GOAL_PERIOD_WEEK",
+		3: "GOAL_PERIOD_MONTH",
+		4: "GOAL_PERIOD_YEAR",
+	}
+	GoalPeriod_value = map[string]int32{
+		"GOAL_PERIOD_UNSPECIFIED": 0,
+		"GOAL_PERIOD_DAY":         1,
+		"GOAL_PERIOD_WEEK":        2,
+		"GOAL_PERIOD_MONTH":       3,
+		"GOAL_PERIOD_YEAR":        4,
+	}
+)
+
+func (x GoalPeriod) Enum() *GoalPeriod {
+	p := new(GoalPeriod)
+	*p = x
+	return p
+}
+
+func (x GoalPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_goals_v1_goals_proto_enumTypes[1].Descriptor()
+}
+
+func (GoalPeriod) Type() protoreflect.EnumType {
+	return &file_goals_v1_goals_proto_enumTypes[1]
+}
+
+func (x GoalPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalPeriod.Descriptor instead.
+func (GoalPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{1}
+}
+
+type GoalStatus int32
+
+const (
+	GoalStatus_GOAL_STATUS_UNSPECIFIED GoalStatus = 0
+	GoalStatus_GOAL_STATUS_ON_TRACK    GoalStatus = 1
+	GoalStatus_GOAL_STATUS_BEHIND      GoalStatus = 2
+	GoalStatus_GOAL_STATUS_ACHIEVED    GoalStatus = 3
+	GoalStatus_GOAL_STATUS_MISSED      GoalStatus = 4 // The due date passed without reaching the goal
+)
+
+// Enum value maps for GoalStatus.
+var (
+	GoalStatus_name = map[int32]string{
+		0: "GOAL_STATUS_UNSPECIFIED",
+		1: "GOAL_STATUS_ON_TRACK",
+		2: "GOAL_STATUS_BEHIND",
+		3: "GOAL_STATUS_ACHIEVED",
+		4: "GOAL_STATUS_MISSED",
+	}
+	GoalStatus_value = map[string]int32{
+		"GOAL_STATUS_UNSPECIFIED": 0,
+		"GOAL_STATUS_ON_TRACK":    1,
+		"GOAL_STATUS_BEHIND":      2,
+		"GOAL_STATUS_ACHIEVED":    3,
+		"GOAL_STATUS_MISSED":      4,
+	}
+)
+
+func (x GoalStatus) Enum() *GoalStatus {
+	p := new(GoalStatus)
+	*p = x
+	return p
+}
+
+func (x GoalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_goals_v1_goals_proto_enumTypes[2].Descriptor()
+}
+
+func (GoalStatus) Type() protoreflect.EnumType {
+	return &file_goals_v1_goals_proto_enumTypes[2]
+}
+
+func (x GoalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalStatus.Descriptor instead.
+func (GoalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{2}
+}
+
+type Goal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	GoalType      GoalType               `protobuf:"varint,4,opt,name=goal_type,json=goalType,proto3,enum=api.goals.v1.GoalType" json:"goal_type,omitempty"`
+	TargetCount   int32                  `protobuf:"varint,5,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"` // Read count goals only
+	Period        GoalPeriod             `protobuf:"varint,6,opt,name=period,proto3,enum=api.goals.v1.GoalPeriod" json:"period,omitempty"` // Read count goals only
+	LibraryId     *int64                 `protobuf:"varint,7,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"` // Finish library goals only
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`              // Finish library goals only, the UTC date is used
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_goals_v1_goals_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Goal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Goal) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetGoalType() GoalType {
+	if x != nil {
+		return x.GoalType
+	}
+	return GoalType_GOAL_TYPE_UNSPECIFIED
+}
+
+func (x *Goal) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *Goal) GetPeriod() GoalPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return GoalPeriod_GOAL_PERIOD_UNSPECIFIED
+}
+
+func (x *Goal) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
+}
+
+func (x *Goal) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GoalProgress describes a goal's current period, or for library goals the
+// time between creating the goal and its due date.
+type GoalProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Goal           *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Status         GoalStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=api.goals.v1.GoalStatus" json:"status,omitempty"`
+	CompletedCount int32                  `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	TargetCount    int32                  `protobuf:"varint,4,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
+	ExpectedCount  float64                `protobuf:"fixed64,5,opt,name=expected_count,json=expectedCount,proto3" json:"expected_count,omitempty"`    // Where the goal should be by now at an even pace
+	ProjectedCount float64                `protobuf:"fixed64,6,opt,name=projected_count,json=projectedCount,proto3" json:"projected_count,omitempty"` // Where the goal will end up at the current pace
+	PeriodStart    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	DaysRemaining  int32                  `protobuf:"varint,9,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`  // Including today
+	CurrentStreak  int32                  `protobuf:"varint,10,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"` // Consecutive periods (days for library goals) the goal was met
+	LongestStreak  int32                  `protobuf:"varint,11,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_goals_v1_goals_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GoalProgress) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetStatus() GoalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GoalStatus_GOAL_STATUS_UNSPECIFIED
+}
+
+func (x *GoalProgress) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetExpectedCount() float64 {
+	if x != nil {
+		return x.ExpectedCount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProjectedCount() float64 {
+	if x != nil {
+		return x.ProjectedCount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetDaysRemaining() int32 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+type CreateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GoalType      GoalType               `protobuf:"varint,3,opt,name=goal_type,json=goalType,proto3,enum=api.goals.v1.GoalType" json:"goal_type,omitempty"`
+	TargetCount   int32                  `protobuf:"varint,4,opt,name=target_count,json=targetCount,proto3" json:"target_count,omitempty"`
+	Period        GoalPeriod             `protobuf:"varint,5,opt,name=period,proto3,enum=api.goals.v1.GoalPeriod" json:"period,omitempty"`
+	LibraryId     *int64                 `protobuf:"varint,6,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGoalRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetGoalType() GoalType {
+	if x != nil {
+		return x.GoalType
+	}
+	return GoalType_GOAL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateGoalRequest) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetPeriod() GoalPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return GoalPeriod_GOAL_PERIOD_UNSPECIFIED
+}
+
+func (x *CreateGoalRequest) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type CreateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalResponse) Reset() {
+	*x = CreateGoalResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalResponse) ProtoMessage() {}
+
+func (x *CreateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalResponse.ProtoReflect.Descriptor instead.
+func (*CreateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGoalResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalRequest) Reset() {
+	*x = GetGoalRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalRequest) ProtoMessage() {}
+
+func (x *GetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGoalRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalResponse) Reset() {
+	*x = GetGoalResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalResponse) ProtoMessage() {}
+
+func (x *GetGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalResponse.ProtoReflect.Descriptor instead.
+func (*GetGoalResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type ListGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsRequest) ProtoMessage() {}
+
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGoalsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsResponse) ProtoMessage() {}
+
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGoalsResponse) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	TargetCount   *int32                 `protobuf:"varint,3,opt,name=target_count,json=targetCount,proto3,oneof" json:"target_count,omitempty"`
+	Period        GoalPeriod             `protobuf:"varint,4,opt,name=period,proto3,enum=api.goals.v1.GoalPeriod" json:"period,omitempty"` // Unchanged when unspecified
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`              // Unchanged when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateGoalRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetTargetCount() int32 {
+	if x != nil && x.TargetCount != nil {
+		return *x.TargetCount
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetPeriod() GoalPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return GoalPeriod_GOAL_PERIOD_UNSPECIFIED
+}
+
+func (x *UpdateGoalRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type UpdateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalResponse) Reset() {
+	*x = UpdateGoalResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalResponse) ProtoMessage() {}
+
+func (x *UpdateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteGoalRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalResponse) Reset() {
+	*x = DeleteGoalResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalResponse) ProtoMessage() {}
+
+func (x *DeleteGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGoalResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteGoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetGoalProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone name. Defaults to UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalProgressRequest) Reset() {
+	*x = GetGoalProgressRequest{}
+	mi := &file_goals_v1_goals_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalProgressRequest) ProtoMessage() {}
+
+func (x *GetGoalProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalProgressRequest) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetGoalProgressRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetGoalProgressRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetGoalProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      *GoalProgress          `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalProgressResponse) Reset() {
+	*x = GetGoalProgressResponse{}
+	mi := &file_goals_v1_goals_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalProgressResponse) ProtoMessage() {}
+
+func (x *GetGoalProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goals_v1_goals_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetGoalProgressResponse) Descriptor() ([]byte, []int) {
+	return file_goals_v1_goals_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetGoalProgressResponse) GetProgress() *GoalProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+var File_goals_v1_goals_proto protoreflect.FileDescriptor
+
+const file_goals_v1_goals_proto_rawDesc = "" +
+	"\n" +
+	"\x14goals/v1/goals.proto\x12\fapi.goals.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x03\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x123\n" +
+	"\tgoal_type\x18\x04 \x01(\x0e2\x16.api.goals.v1.GoalTypeR\bgoalType\x12!\n" +
+	"\ftarget_count\x18\x05 \x01(\x05R\vtargetCount\x120\n" +
+	"\x06period\x18\x06 \x01(\x0e2\x18.api.goals.v1.GoalPeriodR\x06period\x12\"\n" +
+	"\n" +
+	"library_id\x18\a \x01(\x03H\x00R\tlibraryId\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_library_id\"\xf3\x03\n" +
+	"\fGoalProgress\x12&\n" +
+	"\x04goal\x18\x01 \x01(\v2\x12.api.goals.v1.GoalR\x04goal\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.api.goals.v1.GoalStatusR\x06status\x12'\n" +
+	"\x0fcompleted_count\x18\x03 \x01(\x05R\x0ecompletedCount\x12!\n" +
+	"\ftarget_count\x18\x04 \x01(\x05R\vtargetCount\x12%\n" +
+	"\x0eexpected_count\x18\x05 \x01(\x01R\rexpectedCount\x12'\n" +
+	"\x0fprojected_count\x18\x06 \x01(\x01R\x0eprojectedCount\x12=\n" +
+	"\fperiod_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12%\n" +
+	"\x0edays_remaining\x18\t \x01(\x05R\rdaysRemaining\x12%\n" +
+	"\x0ecurrent_streak\x18\n" +
+	" \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\v \x01(\x05R\rlongestStreak\"\xf1\x02\n" +
+	"\x11CreateGoalRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12?\n" +
+	"\tgoal_type\x18\x03 \x01(\x0e2\x16.api.goals.v1.GoalTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bgoalType\x12*\n" +
+	"\ftarget_count\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\vtargetCount\x12:\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x18.api.goals.v1.GoalPeriodB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06period\x12+\n" +
+	"\n" +
+	"library_id\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tlibraryId\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDateB\r\n" +
+	"\v_library_id\"$\n" +
+	"\x12CreateGoalResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\")\n" +
+	"\x0eGetGoalRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"9\n" +
+	"\x0fGetGoalResponse\x12&\n" +
+	"\x04goal\x18\x01 \x01(\v2\x12.api.goals.v1.GoalR\x04goal\"4\n" +
+	"\x10ListGoalsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"=\n" +
+	"\x11ListGoalsResponse\x12(\n" +
+	"\x05goals\x18\x01 \x03(\v2\x12.api.goals.v1.GoalR\x05goals\"\x8f\x02\n" +
+	"\x11UpdateGoalRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
+	"\ftarget_count\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\vtargetCount\x88\x01\x01\x12:\n" +
+	"\x06period\x18\x04 \x01(\x0e2\x18.api.goals.v1.GoalPeriodB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06period\x125\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDateB\a\n" +
+	"\x05_nameB\x0f\n" +
+	"\r_target_count\"<\n" +
+	"\x12UpdateGoalResponse\x12&\n" +
+	"\x04goal\x18\x01 \x01(\v2\x12.api.goals.v1.GoalR\x04goal\",\n" +
+	"\x11DeleteGoalRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\".\n" +
+	"\x12DeleteGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x16GetGoalProgressRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"Q\n" +
+	"\x17GetGoalProgressResponse\x126\n" +
+	"\bprogress\x18\x01 \x01(\v2\x1a.api.goals.v1.GoalProgressR\bprogress*]\n" +
+	"\bGoalType\x12\x19\n" +
+	"\x15GOAL_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14GOAL_TYPE_READ_COUNT\x10\x01\x12\x1c\n" +
+	"\x18GOAL_TYPE_FINISH_LIBRARY\x10\x02*\x81\x01\n" +
+	"\n" +
+	"GoalPeriod\x12\x1b\n" +
+	"\x17GOAL_PERIOD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fGOAL_PERIOD_DAY\x10\x01\x12\x14\n" +
+	"\x10GOAL_PERIOD_WEEK\x10\x02\x12\x15\n" +
+	"\x11GOAL_PERIOD_MONTH\x10\x03\x12\x14\n" +
+	"\x10GOAL_PERIOD_YEAR\x10\x04*\x8d\x01\n" +
+	"\n" +
+	"GoalStatus\x12\x1b\n" +
+	"\x17GOAL_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14GOAL_STATUS_ON_TRACK\x10\x01\x12\x16\n" +
+	"\x12GOAL_STATUS_BEHIND\x10\x02\x12\x18\n" +
+	"\x14GOAL_STATUS_ACHIEVED\x10\x03\x12\x16\n" +
+	"\x12GOAL_STATUS_MISSED\x10\x042\xf6\x03\n" +
+	"\vGoalService\x12O\n" +
+	"\n" +
+	"CreateGoal\x12\x1f.api.goals.v1.CreateGoalRequest\x1a .api.goals.v1.CreateGoalResponse\x12F\n" +
+	"\aGetGoal\x12\x1c.api.goals.v1.GetGoalRequest\x1a\x1d.api.goals.v1.GetGoalResponse\x12L\n" +
+	"\tListGoals\x12\x1e.api.goals.v1.ListGoalsRequest\x1a\x1f.api.goals.v1.ListGoalsResponse\x12O\n" +
+	"\n" +
+	"UpdateGoal\x12\x1f.api.goals.v1.UpdateGoalRequest\x1a .api.goals.v1.UpdateGoalResponse\x12O\n" +
+	"\n" +
+	"DeleteGoal\x12\x1f.api.goals.v1.DeleteGoalRequest\x1a .api.goals.v1.DeleteGoalResponse\x12^\n" +
+	"\x0fGetGoalProgress\x12$.api.goals.v1.GetGoalProgressRequest\x1a%.api.goals.v1.GetGoalProgressResponseB5Z3github.com/chiquitav2/journalful/pkg/goals/v1;goalsb\x06proto3"
+
+var (
+	file_goals_v1_goals_proto_rawDescOnce sync.Once
+	file_goals_v1_goals_proto_rawDescData []byte
+)
+
+func file_goals_v1_goals_proto_rawDescGZIP() []byte {
+	file_goals_v1_goals_proto_rawDescOnce.Do(func() {
+		file_goals_v1_goals_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goals_v1_goals_proto_rawDesc), len(file_goals_v1_goals_proto_rawDesc)))
+	})
+	return file_goals_v1_goals_proto_rawDescData
+}
+
+var file_goals_v1_goals_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_goals_v1_goals_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_goals_v1_goals_proto_goTypes = []any{
+	(GoalType)(0),                   // 0: api.goals.v1.GoalType
+	(GoalPeriod)(0),                 // 1: api.goals.v1.GoalPeriod
+	(GoalStatus)(0),                 // 2: api.goals.v1.GoalStatus
+	(*Goal)(nil),                    // 3: api.goals.v1.Goal
+	(*GoalProgress)(nil),            // 4: api.goals.v1.GoalProgress
+	(*CreateGoalRequest)(nil),       // 5: api.goals.v1.CreateGoalRequest
+	(*CreateGoalResponse)(nil),      // 6: api.goals.v1.CreateGoalResponse
+	(*GetGoalRequest)(nil),          // 7: api.goals.v1.GetGoalRequest
+	(*GetGoalResponse)(nil),         // 8: api.goals.v1.GetGoalResponse
+	(*ListGoalsRequest)(nil),        // 9: api.goals.v1.ListGoalsRequest
+	(*ListGoalsResponse)(nil),       // 10: api.goals.v1.ListGoalsResponse
+	(*UpdateGoalRequest)(nil),       // 11: api.goals.v1.UpdateGoalRequest
+	(*UpdateGoalResponse)(nil),      // 12: api.goals.v1.UpdateGoalResponse
+	(*DeleteGoalRequest)(nil),       // 13: api.goals.v1.DeleteGoalRequest
+	(*DeleteGoalResponse)(nil),      // 14: api.goals.v1.DeleteGoalResponse
+	(*GetGoalProgressRequest)(nil),  // 15: api.goals.v1.GetGoalProgressRequest
+	(*GetGoalProgressResponse)(nil), // 16: api.goals.v1.GetGoalProgressResponse
+	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
+}
+var file_goals_v1_goals_proto_depIdxs = []int32{
+	0,  // 0: api.goals.v1.Goal.goal_type:type_name -> api.goals.v1.GoalType
+	1,  // 1: api.goals.v1.Goal.period:type_name -> api.goals.v1.GoalPeriod
+	17, // 2: api.goals.v1.Goal.due_date:type_name -> google.protobuf.Timestamp
+	17, // 3: api.goals.v1.Goal.created_at:type_name -> google.protobuf.Timestamp
+	17, // 4: api.goals.v1.Goal.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: api.goals.v1.GoalProgress.goal:type_name -> api.goals.v1.Goal
+	2,  // 6: api.goals.v1.GoalProgress.status:type_name -> api.goals.v1.GoalStatus
+	17, // 7: api.goals.v1.GoalProgress.period_start:type_name -> google.protobuf.Timestamp
+	17, // 8: api.goals.v1.GoalProgress.period_end:type_name -> google.protobuf.Timestamp
+	0,  // 9: api.goals.v1.CreateGoalRequest.goal_type:type_name -> api.goals.v1.GoalType
+	1,  // 10: api.goals.v1.CreateGoalRequest.period:type_name -> api.goals.v1.GoalPeriod
+	17, // 11: api.goals.v1.CreateGoalRequest.due_date:type_name -> google.protobuf.Timestamp
+	3,  // 12: api.goals.v1.GetGoalResponse.goal:type_name -> api.goals.v1.Goal
+	3,  // 13: api.goals.v1.ListGoalsResponse.goals:type_name -> api.goals.v1.Goal
+	1,  // 14: api.goals.v1.UpdateGoalRequest.period:type_name -> api.goals.v1.GoalPeriod
+	17, // 15: api.goals.v1.UpdateGoalRequest.due_date:type_name -> google.protobuf.Timestamp
+	3,  // 16: api.goals.v1.UpdateGoalResponse.goal:type_name -> api.goals.v1.Goal
+	4,  // 17: api.goals.v1.GetGoalProgressResponse.progress:type_name -> api.goals.v1.GoalProgress
+	5,  // 18: api.goals.v1.GoalService.CreateGoal:input_type -> api.goals.v1.CreateGoalRequest
+	7,  // 19: api.goals.v1.GoalService.GetGoal:input_type -> api.goals.v1.GetGoalRequest
+	9,  // 20: api.goals.v1.GoalService.ListGoals:input_type -> api.goals.v1.ListGoalsRequest
+	11, // 21: api.goals.v1.GoalService.UpdateGoal:input_type -> api.goals.v1.UpdateGoalRequest
+	13, // 22: api.goals.v1.GoalService.DeleteGoal:input_type -> api.goals.v1.DeleteGoalRequest
+	15, // 23: api.goals.v1.GoalService.GetGoalProgress:input_type -> api.goals.v1.GetGoalProgressRequest
+	6,  // 24: api.goals.v1.GoalService.CreateGoal:output_type -> api.goals.v1.CreateGoalResponse
+	8,  // 25: api.goals.v1.GoalService.GetGoal:output_type -> api.goals.v1.GetGoalResponse
+	10, // 26: api.goals.v1.GoalService.ListGoals:output_type -> api.goals.v1.ListGoalsResponse
+	12, // 27: api.goals.v1.GoalService.UpdateGoal:output_type -> api.goals.v1.UpdateGoalResponse
+	14, // 28: api.goals.v1.GoalService.DeleteGoal:output_type -> api.goals.v1.DeleteGoalResponse
+	16, // 29: api.goals.v1.GoalService.GetGoalProgress:output_type -> api.goals.v1.GetGoalProgressResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_goals_v1_goals_proto_init() }
+func file_goals_v1_goals_proto_init() {
+	if File_goals_v1_goals_proto != nil {
+		return
+	}
+	file_goals_v1_goals_proto_msgTypes[0].OneofWrappers = []any{}
+	file_goals_v1_goals_proto_msgTypes[2].OneofWrappers = []any{}
+	file_goals_v1_goals_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goals_v1_goals_proto_rawDesc), len(file_goals_v1_goals_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_goals_v1_goals_proto_goTypes,
+		DependencyIndexes: file_goals_v1_goals_proto_depIdxs,
+		EnumInfos:         file_goals_v1_goals_proto_enumTypes,
+		MessageInfos:      file_goals_v1_goals_proto_msgTypes,
+	}.Build()
+	File_goals_v1_goals_proto = out.File
+	file_goals_v1_goals_proto_goTypes = nil
+	file_goals_v1_goals_proto_depIdxs = nil
+}
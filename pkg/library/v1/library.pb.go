@@ -7,6 +7,9 @@
 package library
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/chiquitav2/journalful/pkg/articles/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -77,6 +80,190 @@ func (ReadingStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_library_v1_library_proto_rawDescGZIP(), []int{0}
 }
 
+type LibraryArticleSortField int32
+
+const (
+	LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_UNSPECIFIED      LibraryArticleSortField = 0 // Defaults to date added
+	LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_DATE_ADDED       LibraryArticleSortField = 1
+	LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_TITLE            LibraryArticleSortField = 2
+	LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_PUBLICATION_YEAR LibraryArticleSortField = 3
+	LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_READING_PROGRESS LibraryArticleSortField = 4
+)
+
+// Enum value maps for LibraryArticleSortField.
+var (
+	LibraryArticleSortField_name = map[int32]string{
+		0: "LIBRARY_ARTICLE_SORT_FIELD_UNSPECIFIED",
+		1: "LIBRARY_ARTICLE_SORT_FIELD_DATE_ADDED",
+		2: "LIBRARY_ARTICLE_SORT_FIELD_TITLE",
+		3: "LIBRARY_ARTICLE_SORT_FIELD_PUBLICATION_YEAR",
+		4: "LIBRARY_ARTICLE_SORT_FIELD_READING_PROGRESS",
+	}
+	LibraryArticleSortField_value = map[string]int32{
+		"LIBRARY_ARTICLE_SORT_FIELD_UNSPECIFIED":      0,
+		"LIBRARY_ARTICLE_SORT_FIELD_DATE_ADDED":       1,
+		"LIBRARY_ARTICLE_SORT_FIELD_TITLE":            2,
+		"LIBRARY_ARTICLE_SORT_FIELD_PUBLICATION_YEAR": 3,
+		"LIBRARY_ARTICLE_SORT_FIELD_READING_PROGRESS": 4,
+	}
+)
+
+func (x LibraryArticleSortField) Enum() *LibraryArticleSortField {
+	p := new(LibraryArticleSortField)
+	*p = x
+	return p
+}
+
+func (x LibraryArticleSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryArticleSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_library_v1_library_proto_enumTypes[1].Descriptor()
+}
+
+func (LibraryArticleSortField) Type() protoreflect.EnumType {
+	return &file_api_library_v1_library_proto_enumTypes[1]
+}
+
+func (x LibraryArticleSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryArticleSortField.Descriptor instead.
+func (LibraryArticleSortField) EnumDescriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{1}
+}
+
+type ReadingEventType int32
+
+const (
+	ReadingEventType_READING_EVENT_TYPE_UNSPECIFIED      ReadingEventType = 0
+	ReadingEventType_READING_EVENT_TYPE_ADDED            ReadingEventType = 1
+	ReadingEventType_READING_EVENT_TYPE_STATUS_CHANGED   ReadingEventType = 2
+	ReadingEventType_READING_EVENT_TYPE_PROGRESS_UPDATED ReadingEventType = 3
+	ReadingEventType_READING_EVENT_TYPE_NOTE_EDITED      ReadingEventType = 4
+	ReadingEventType_READING_EVENT_TYPE_OPENED           ReadingEventType = 5
+	ReadingEventType_READING_EVENT_TYPE_REMOVED          ReadingEventType = 6
+	ReadingEventType_READING_EVENT_TYPE_FAVORITE_CHANGED ReadingEventType = 7
+	ReadingEventType_READING_EVENT_TYPE_REVIEWED         ReadingEventType = 8 // A spaced-repetition review was recorded
+)
+
+// Enum value maps for ReadingEventType.
+var (
+	ReadingEventType_name = map[int32]string{
+		0: "READING_EVENT_TYPE_UNSPECIFIED",
+		1: "READING_EVENT_TYPE_ADDED",
+		2: "READING_EVENT_TYPE_STATUS_CHANGED",
+		3: "READING_EVENT_TYPE_PROGRESS_UPDATED",
+		4: "READING_EVENT_TYPE_NOTE_EDITED",
+		5: "READING_EVENT_TYPE_OPENED",
+		6: "READING_EVENT_TYPE_REMOVED",
+		7: "READING_EVENT_TYPE_FAVORITE_CHANGED",
+		8: "READING_EVENT_TYPE_REVIEWED",
+	}
+	ReadingEventType_value = map[string]int32{
+		"READING_EVENT_TYPE_UNSPECIFIED":      0,
+		"READING_EVENT_TYPE_ADDED":            1,
+		"READING_EVENT_TYPE_STATUS_CHANGED":   2,
+		"READING_EVENT_TYPE_PROGRESS_UPDATED": 3,
+		"READING_EVENT_TYPE_NOTE_EDITED":      4,
+		"READING_EVENT_TYPE_OPENED":           5,
+		"READING_EVENT_TYPE_REMOVED":          6,
+		"READING_EVENT_TYPE_FAVORITE_CHANGED": 7,
+		"READING_EVENT_TYPE_REVIEWED":         8,
+	}
+)
+
+func (x ReadingEventType) Enum() *ReadingEventType {
+	p := new(ReadingEventType)
+	*p = x
+	return p
+}
+
+func (x ReadingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_library_v1_library_proto_enumTypes[2].Descriptor()
+}
+
+func (ReadingEventType) Type() protoreflect.EnumType {
+	return &file_api_library_v1_library_proto_enumTypes[2]
+}
+
+func (x ReadingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadingEventType.Descriptor instead.
+func (ReadingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{2}
+}
+
+type LibraryEventType int32
+
+const (
+	LibraryEventType_LIBRARY_EVENT_TYPE_UNSPECIFIED LibraryEventType = 0
+	// Sent once missed events have been replayed. Carries a resume token even
+	// when nothing has changed yet.
+	LibraryEventType_LIBRARY_EVENT_TYPE_CAUGHT_UP       LibraryEventType = 1
+	LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_ADDED   LibraryEventType = 2
+	LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_REMOVED LibraryEventType = 3
+	LibraryEventType_LIBRARY_EVENT_TYPE_STATUS_CHANGED  LibraryEventType = 4
+	// Progress, notes or favorite changed, or the entry now holds another
+	// article because duplicates were merged.
+	LibraryEventType_LIBRARY_EVENT_TYPE_ARTICLE_UPDATED LibraryEventType = 5
+)
+
+// Enum value maps for LibraryEventType.
+var (
+	LibraryEventType_name = map[int32]string{
+		0: "LIBRARY_EVENT_TYPE_UNSPECIFIED",
+		1: "LIBRARY_EVENT_TYPE_CAUGHT_UP",
+		2: "LIBRARY_EVENT_TYPE_ARTICLE_ADDED",
+		3: "LIBRARY_EVENT_TYPE_ARTICLE_REMOVED",
+		4: "LIBRARY_EVENT_TYPE_STATUS_CHANGED",
+		5: "LIBRARY_EVENT_TYPE_ARTICLE_UPDATED",
+	}
+	LibraryEventType_value = map[string]int32{
+		"LIBRARY_EVENT_TYPE_UNSPECIFIED":     0,
+		"LIBRARY_EVENT_TYPE_CAUGHT_UP":       1,
+		"LIBRARY_EVENT_TYPE_ARTICLE_ADDED":   2,
+		"LIBRARY_EVENT_TYPE_ARTICLE_REMOVED": 3,
+		"LIBRARY_EVENT_TYPE_STATUS_CHANGED":  4,
+		"LIBRARY_EVENT_TYPE_ARTICLE_UPDATED": 5,
+	}
+)
+
+func (x LibraryEventType) Enum() *LibraryEventType {
+	p := new(LibraryEventType)
+	*p = x
+	return p
+}
+
+func (x LibraryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_library_v1_library_proto_enumTypes[3].Descriptor()
+}
+
+func (LibraryEventType) Type() protoreflect.EnumType {
+	return &file_api_library_v1_library_proto_enumTypes[3]
+}
+
+func (x LibraryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryEventType.Descriptor instead.
+func (LibraryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{3}
+}
+
 type Library struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -177,6 +364,175 @@ func (x *Library) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// LibrarySummary is a lightweight view of a library without its articles.
+type LibrarySummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,6,opt,name=isPublic,proto3" json:"isPublic,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	ArticleCount  int64                  `protobuf:"varint,11,opt,name=article_count,json=articleCount,proto3" json:"article_count,omitempty"`
+	StatusCounts  []*ReadingStatusCount  `protobuf:"bytes,12,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibrarySummary) Reset() {
+	*x = LibrarySummary{}
+	mi := &file_api_library_v1_library_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibrarySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibrarySummary) ProtoMessage() {}
+
+func (x *LibrarySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibrarySummary.ProtoReflect.Descriptor instead.
+func (*LibrarySummary) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LibrarySummary) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LibrarySummary) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *LibrarySummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibrarySummary) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *LibrarySummary) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *LibrarySummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LibrarySummary) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LibrarySummary) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *LibrarySummary) GetArticleCount() int64 {
+	if x != nil {
+		return x.ArticleCount
+	}
+	return 0
+}
+
+func (x *LibrarySummary) GetStatusCounts() []*ReadingStatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+type ReadingStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadingStatus ReadingStatus          `protobuf:"varint,1,opt,name=reading_status,json=readingStatus,proto3,enum=api.library.v1.ReadingStatus" json:"reading_status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingStatusCount) Reset() {
+	*x = ReadingStatusCount{}
+	mi := &file_api_library_v1_library_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingStatusCount) ProtoMessage() {}
+
+func (x *ReadingStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingStatusCount.ProtoReflect.Descriptor instead.
+func (*ReadingStatusCount) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadingStatusCount) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *ReadingStatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type LibraryArticle struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -196,7 +552,7 @@ type LibraryArticle struct {
 
 func (x *LibraryArticle) Reset() {
 	*x = LibraryArticle{}
-	mi := &file_api_library_v1_library_proto_msgTypes[1]
+	mi := &file_api_library_v1_library_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -208,7 +564,7 @@ func (x *LibraryArticle) String() string {
 func (*LibraryArticle) ProtoMessage() {}
 
 func (x *LibraryArticle) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[1]
+	mi := &file_api_library_v1_library_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +577,7 @@ func (x *LibraryArticle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LibraryArticle.ProtoReflect.Descriptor instead.
 func (*LibraryArticle) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{1}
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LibraryArticle) GetId() int64 {
@@ -313,7 +669,7 @@ type SaveArticleToLibraryRequest struct {
 
 func (x *SaveArticleToLibraryRequest) Reset() {
 	*x = SaveArticleToLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[2]
+	mi := &file_api_library_v1_library_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +681,7 @@ func (x *SaveArticleToLibraryRequest) String() string {
 func (*SaveArticleToLibraryRequest) ProtoMessage() {}
 
 func (x *SaveArticleToLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[2]
+	mi := &file_api_library_v1_library_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +694,7 @@ func (x *SaveArticleToLibraryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveArticleToLibraryRequest.ProtoReflect.Descriptor instead.
 func (*SaveArticleToLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{2}
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SaveArticleToLibraryRequest) GetLibraryId() int64 {
@@ -378,7 +734,7 @@ type SaveArticleToLibraryResponse struct {
 
 func (x *SaveArticleToLibraryResponse) Reset() {
 	*x = SaveArticleToLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[3]
+	mi := &file_api_library_v1_library_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +746,7 @@ func (x *SaveArticleToLibraryResponse) String() string {
 func (*SaveArticleToLibraryResponse) ProtoMessage() {}
 
 func (x *SaveArticleToLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[3]
+	mi := &file_api_library_v1_library_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +759,7 @@ func (x *SaveArticleToLibraryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveArticleToLibraryResponse.ProtoReflect.Descriptor instead.
 func (*SaveArticleToLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{3}
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SaveArticleToLibraryResponse) GetId() int64 {
@@ -413,28 +769,37 @@ func (x *SaveArticleToLibraryResponse) GetId() int64 {
 	return 0
 }
 
-type GetUserLibraryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// The article is saved to one of the caller's libraries.
+type AddByIdentifierRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A DOI, optionally as a doi.org URL or "doi:" URI, or an arXiv id such as
+	// "arXiv:2101.00001". arXiv versions are dropped: the article is the
+	// preprint.
+	Identifier    string        `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	LibraryId     *int64        `protobuf:"varint,3,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"` // Defaults to the caller's default library
+	ReadingStatus ReadingStatus `protobuf:"varint,4,opt,name=reading_status,json=readingStatus,proto3,enum=api.library.v1.ReadingStatus" json:"reading_status,omitempty"`
+	Notes         *string       `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	// Used when the metadata service does not know the identifier.
+	Title         *string `protobuf:"bytes,6,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLibraryRequest) Reset() {
-	*x = GetUserLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[4]
+func (x *AddByIdentifierRequest) Reset() {
+	*x = AddByIdentifierRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLibraryRequest) String() string {
+func (x *AddByIdentifierRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLibraryRequest) ProtoMessage() {}
+func (*AddByIdentifierRequest) ProtoMessage() {}
 
-func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[4]
+func (x *AddByIdentifierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,29 +810,161 @@ func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLibraryRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AddByIdentifierRequest.ProtoReflect.Descriptor instead.
+func (*AddByIdentifierRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetUserLibraryRequest) GetUserId() int64 {
+func (x *AddByIdentifierRequest) GetIdentifier() string {
 	if x != nil {
-		return x.UserId
+		return x.Identifier
 	}
-	return 0
+	return ""
 }
 
-type GetUserLibraryResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DefaultLibrary   *Library               `protobuf:"bytes,1,opt,name=defaultLibrary,proto3" json:"defaultLibrary,omitempty"`
-	PrivateLibraries []*Library             `protobuf:"bytes,2,rep,name=privateLibraries,proto3" json:"privateLibraries,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *AddByIdentifierRequest) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
 }
 
-func (x *GetUserLibraryResponse) Reset() {
+func (x *AddByIdentifierRequest) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *AddByIdentifierRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *AddByIdentifierRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+type AddByIdentifierResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticle *LibraryArticle        `protobuf:"bytes,1,opt,name=library_article,json=libraryArticle,proto3" json:"library_article,omitempty"`
+	ArticleCreated bool                   `protobuf:"varint,2,opt,name=article_created,json=articleCreated,proto3" json:"article_created,omitempty"` // The article was new to Journalful
+	AlreadySaved   bool                   `protobuf:"varint,3,opt,name=already_saved,json=alreadySaved,proto3" json:"already_saved,omitempty"`       // The library already held the article; nothing was changed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddByIdentifierResponse) Reset() {
+	*x = AddByIdentifierResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddByIdentifierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddByIdentifierResponse) ProtoMessage() {}
+
+func (x *AddByIdentifierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddByIdentifierResponse.ProtoReflect.Descriptor instead.
+func (*AddByIdentifierResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddByIdentifierResponse) GetLibraryArticle() *LibraryArticle {
+	if x != nil {
+		return x.LibraryArticle
+	}
+	return nil
+}
+
+func (x *AddByIdentifierResponse) GetArticleCreated() bool {
+	if x != nil {
+		return x.ArticleCreated
+	}
+	return false
+}
+
+func (x *AddByIdentifierResponse) GetAlreadySaved() bool {
+	if x != nil {
+		return x.AlreadySaved
+	}
+	return false
+}
+
+type GetUserLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLibraryRequest) Reset() {
+	*x = GetUserLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLibraryRequest) ProtoMessage() {}
+
+func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLibraryRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserLibraryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserLibraryResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DefaultLibrary   *Library               `protobuf:"bytes,1,opt,name=defaultLibrary,proto3" json:"defaultLibrary,omitempty"`
+	PrivateLibraries []*Library             `protobuf:"bytes,2,rep,name=privateLibraries,proto3" json:"privateLibraries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserLibraryResponse) Reset() {
 	*x = GetUserLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[5]
+	mi := &file_api_library_v1_library_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -479,7 +976,7 @@ func (x *GetUserLibraryResponse) String() string {
 func (*GetUserLibraryResponse) ProtoMessage() {}
 
 func (x *GetUserLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[5]
+	mi := &file_api_library_v1_library_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +989,7 @@ func (x *GetUserLibraryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLibraryResponse.ProtoReflect.Descriptor instead.
 func (*GetUserLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{5}
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserLibraryResponse) GetDefaultLibrary() *Library {
@@ -509,28 +1006,28 @@ func (x *GetUserLibraryResponse) GetPrivateLibraries() []*Library {
 	return nil
 }
 
-type GetLibraryRequest struct {
+type ListLibrarySummariesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLibraryRequest) Reset() {
-	*x = GetLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[6]
+func (x *ListLibrarySummariesRequest) Reset() {
+	*x = ListLibrarySummariesRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLibraryRequest) String() string {
+func (x *ListLibrarySummariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLibraryRequest) ProtoMessage() {}
+func (*ListLibrarySummariesRequest) ProtoMessage() {}
 
-func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[6]
+func (x *ListLibrarySummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,40 +1038,1150 @@ func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLibraryRequest.ProtoReflect.Descriptor instead.
-func (*GetLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListLibrarySummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListLibrarySummariesRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLibrarySummariesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListLibrarySummariesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DefaultLibrary *LibrarySummary        `protobuf:"bytes,1,opt,name=default_library,json=defaultLibrary,proto3" json:"default_library,omitempty"`
+	Libraries      []*LibrarySummary      `protobuf:"bytes,2,rep,name=libraries,proto3" json:"libraries,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListLibrarySummariesResponse) Reset() {
+	*x = ListLibrarySummariesResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLibrarySummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLibrarySummariesResponse) ProtoMessage() {}
+
+func (x *ListLibrarySummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLibrarySummariesResponse.ProtoReflect.Descriptor instead.
+func (*ListLibrarySummariesResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListLibrarySummariesResponse) GetDefaultLibrary() *LibrarySummary {
+	if x != nil {
+		return x.DefaultLibrary
+	}
+	return nil
+}
+
+func (x *ListLibrarySummariesResponse) GetLibraries() []*LibrarySummary {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+type ListLibraryArticlesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	LibraryId     int64                   `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	PageSize      int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 0 for the default of 25
+	PageToken     string                  `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Token from a previous response, empty for the first page
+	SortBy        LibraryArticleSortField `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=api.library.v1.LibraryArticleSortField" json:"sort_by,omitempty"`
+	Descending    bool                    `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	ReadingStatus *ReadingStatus          `protobuf:"varint,6,opt,name=reading_status,json=readingStatus,proto3,enum=api.library.v1.ReadingStatus,oneof" json:"reading_status,omitempty"` // Only return articles with this status
+	IsFavorite    *bool                   `protobuf:"varint,7,opt,name=is_favorite,json=isFavorite,proto3,oneof" json:"is_favorite,omitempty"`                                            // Only return (non-)favorite articles
+	Query         *string                 `protobuf:"bytes,8,opt,name=query,proto3,oneof" json:"query,omitempty"`                                                                         // Case-insensitive match on title or DOI
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLibraryArticlesRequest) Reset() {
+	*x = ListLibraryArticlesRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLibraryArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLibraryArticlesRequest) ProtoMessage() {}
+
+func (x *ListLibraryArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLibraryArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListLibraryArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListLibraryArticlesRequest) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *ListLibraryArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLibraryArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLibraryArticlesRequest) GetSortBy() LibraryArticleSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return LibraryArticleSortField_LIBRARY_ARTICLE_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListLibraryArticlesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListLibraryArticlesRequest) GetReadingStatus() ReadingStatus {
+	if x != nil && x.ReadingStatus != nil {
+		return *x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *ListLibraryArticlesRequest) GetIsFavorite() bool {
+	if x != nil && x.IsFavorite != nil {
+		return *x.IsFavorite
+	}
+	return false
+}
+
+func (x *ListLibraryArticlesRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+type ListLibraryArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*LibraryArticle      `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more pages
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // Number of articles matching the filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLibraryArticlesResponse) Reset() {
+	*x = ListLibraryArticlesResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLibraryArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLibraryArticlesResponse) ProtoMessage() {}
+
+func (x *ListLibraryArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLibraryArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListLibraryArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLibraryArticlesResponse) GetArticles() []*LibraryArticle {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListLibraryArticlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLibraryArticlesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLibraryRequest) Reset() {
+	*x = GetLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLibraryRequest) ProtoMessage() {}
+
+func (x *GetLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLibraryRequest.ProtoReflect.Descriptor instead.
+func (*GetLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLibraryRequest) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+type GetLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Library       *Library               `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLibraryResponse) Reset() {
+	*x = GetLibraryResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLibraryResponse) ProtoMessage() {}
+
+func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLibraryResponse.ProtoReflect.Descriptor instead.
+func (*GetLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetLibraryResponse) GetLibrary() *Library {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+type CreateLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLibraryRequest) Reset() {
+	*x = CreateLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLibraryRequest) ProtoMessage() {}
+
+func (x *CreateLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLibraryRequest.ProtoReflect.Descriptor instead.
+func (*CreateLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateLibraryRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateLibraryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLibraryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateLibraryRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type CreateLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLibraryResponse) Reset() {
+	*x = CreateLibraryResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLibraryResponse) ProtoMessage() {}
+
+func (x *CreateLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLibraryResponse.ProtoReflect.Descriptor instead.
+func (*CreateLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateLibraryResponse) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+type UpdateLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsPublic      *bool                  `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLibraryRequest) Reset() {
+	*x = UpdateLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLibraryRequest) ProtoMessage() {}
+
+func (x *UpdateLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLibraryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateLibraryRequest) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *UpdateLibraryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLibraryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLibraryRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+type UpdateLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLibraryResponse) Reset() {
+	*x = UpdateLibraryResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLibraryResponse) ProtoMessage() {}
+
+func (x *UpdateLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLibraryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateLibraryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLibraryRequest) Reset() {
+	*x = DeleteLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLibraryRequest) ProtoMessage() {}
+
+func (x *DeleteLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLibraryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteLibraryRequest) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+type DeleteLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLibraryResponse) Reset() {
+	*x = DeleteLibraryResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLibraryResponse) ProtoMessage() {}
+
+func (x *DeleteLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLibraryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteLibraryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateLibraryArticleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the library article entry
+	ReadingStatus   *ReadingStatus         `protobuf:"varint,2,opt,name=reading_status,json=readingStatus,proto3,enum=api.library.v1.ReadingStatus,oneof" json:"reading_status,omitempty"`
+	ReadingProgress *int32                 `protobuf:"varint,3,opt,name=reading_progress,json=readingProgress,proto3,oneof" json:"reading_progress,omitempty"` // 0-100
+	Notes           *string                `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	IsFavorite      *bool                  `protobuf:"varint,5,opt,name=is_favorite,json=isFavorite,proto3,oneof" json:"is_favorite,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateLibraryArticleRequest) Reset() {
+	*x = UpdateLibraryArticleRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLibraryArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLibraryArticleRequest) ProtoMessage() {}
+
+func (x *UpdateLibraryArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLibraryArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateLibraryArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLibraryArticleRequest) GetReadingStatus() ReadingStatus {
+	if x != nil && x.ReadingStatus != nil {
+		return *x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateLibraryArticleRequest) GetReadingProgress() int32 {
+	if x != nil && x.ReadingProgress != nil {
+		return *x.ReadingProgress
+	}
+	return 0
+}
+
+func (x *UpdateLibraryArticleRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateLibraryArticleRequest) GetIsFavorite() bool {
+	if x != nil && x.IsFavorite != nil {
+		return *x.IsFavorite
+	}
+	return false
+}
+
+type UpdateLibraryArticleResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticle *LibraryArticle        `protobuf:"bytes,1,opt,name=library_article,json=libraryArticle,proto3" json:"library_article,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateLibraryArticleResponse) Reset() {
+	*x = UpdateLibraryArticleResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLibraryArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLibraryArticleResponse) ProtoMessage() {}
+
+func (x *UpdateLibraryArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLibraryArticleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryArticleResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateLibraryArticleResponse) GetLibraryArticle() *LibraryArticle {
+	if x != nil {
+		return x.LibraryArticle
+	}
+	return nil
+}
+
+type RemoveArticleFromLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the library article entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveArticleFromLibraryRequest) Reset() {
+	*x = RemoveArticleFromLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveArticleFromLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArticleFromLibraryRequest) ProtoMessage() {}
+
+func (x *RemoveArticleFromLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArticleFromLibraryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveArticleFromLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveArticleFromLibraryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveArticleFromLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveArticleFromLibraryResponse) Reset() {
+	*x = RemoveArticleFromLibraryResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveArticleFromLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArticleFromLibraryResponse) ProtoMessage() {}
+
+func (x *RemoveArticleFromLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArticleFromLibraryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveArticleFromLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveArticleFromLibraryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RecordArticleOpenedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the library article entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordArticleOpenedRequest) Reset() {
+	*x = RecordArticleOpenedRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordArticleOpenedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordArticleOpenedRequest) ProtoMessage() {}
+
+func (x *RecordArticleOpenedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordArticleOpenedRequest.ProtoReflect.Descriptor instead.
+func (*RecordArticleOpenedRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecordArticleOpenedRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RecordArticleOpenedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordArticleOpenedResponse) Reset() {
+	*x = RecordArticleOpenedResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordArticleOpenedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordArticleOpenedResponse) ProtoMessage() {}
+
+func (x *RecordArticleOpenedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordArticleOpenedResponse.ProtoReflect.Descriptor instead.
+func (*RecordArticleOpenedResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{27}
+}
+
+// ReadingEvent is an entry of the append-only reading activity log.
+type ReadingEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LibraryId        int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	ArticleId        int64                  `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	LibraryArticleId int64                  `protobuf:"varint,4,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	EventType        ReadingEventType       `protobuf:"varint,5,opt,name=event_type,json=eventType,proto3,enum=api.library.v1.ReadingEventType" json:"event_type,omitempty"`
+	FromStatus       *ReadingStatus         `protobuf:"varint,6,opt,name=from_status,json=fromStatus,proto3,enum=api.library.v1.ReadingStatus,oneof" json:"from_status,omitempty"` // Set for added and status changed events
+	ToStatus         *ReadingStatus         `protobuf:"varint,7,opt,name=to_status,json=toStatus,proto3,enum=api.library.v1.ReadingStatus,oneof" json:"to_status,omitempty"`
+	Progress         *int32                 `protobuf:"varint,8,opt,name=progress,proto3,oneof" json:"progress,omitempty"` // Set for progress updated events
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ArticleTitle     string                 `protobuf:"bytes,10,opt,name=article_title,json=articleTitle,proto3" json:"article_title,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReadingEvent) Reset() {
+	*x = ReadingEvent{}
+	mi := &file_api_library_v1_library_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingEvent) ProtoMessage() {}
+
+func (x *ReadingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingEvent.ProtoReflect.Descriptor instead.
+func (*ReadingEvent) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadingEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReadingEvent) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *ReadingEvent) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ReadingEvent) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *ReadingEvent) GetEventType() ReadingEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return ReadingEventType_READING_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ReadingEvent) GetFromStatus() ReadingStatus {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *ReadingEvent) GetToStatus() ReadingStatus {
+	if x != nil && x.ToStatus != nil {
+		return *x.ToStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *ReadingEvent) GetProgress() int32 {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return 0
+}
+
+func (x *ReadingEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ReadingEvent) GetArticleTitle() string {
+	if x != nil {
+		return x.ArticleTitle
+	}
+	return ""
+}
+
+type ListReadingActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LibraryId     *int64                 `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3,oneof" json:"library_id,omitempty"`                                          // Restrict to one library's activity feed
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                 // Inclusive, unbounded when unset
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                       // Exclusive, unbounded when unset
+	EventTypes    []ReadingEventType     `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=api.library.v1.ReadingEventType" json:"event_types,omitempty"` // All types when empty
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                   // 0 for the default of 25
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReadingActivityRequest) Reset() {
+	*x = ListReadingActivityRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReadingActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReadingActivityRequest) ProtoMessage() {}
+
+func (x *ListReadingActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReadingActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListReadingActivityRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListReadingActivityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListReadingActivityRequest) GetLibraryId() int64 {
+	if x != nil && x.LibraryId != nil {
+		return *x.LibraryId
+	}
+	return 0
+}
+
+func (x *ListReadingActivityRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListReadingActivityRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListReadingActivityRequest) GetEventTypes() []ReadingEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *ListReadingActivityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *GetLibraryRequest) GetLibraryId() int64 {
+func (x *ListReadingActivityRequest) GetPageToken() string {
 	if x != nil {
-		return x.LibraryId
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-type GetLibraryResponse struct {
+type ListReadingActivityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Library       *Library               `protobuf:"bytes,1,opt,name=library,proto3" json:"library,omitempty"`
+	Events        []*ReadingEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLibraryResponse) Reset() {
-	*x = GetLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[7]
+func (x *ListReadingActivityResponse) Reset() {
+	*x = ListReadingActivityResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLibraryResponse) String() string {
+func (x *ListReadingActivityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLibraryResponse) ProtoMessage() {}
+func (*ListReadingActivityResponse) ProtoMessage() {}
 
-func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[7]
+func (x *ListReadingActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,43 +2192,47 @@ func (x *GetLibraryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLibraryResponse.ProtoReflect.Descriptor instead.
-func (*GetLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListReadingActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListReadingActivityResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetLibraryResponse) GetLibrary() *Library {
+func (x *ListReadingActivityResponse) GetEvents() []*ReadingEvent {
 	if x != nil {
-		return x.Library
+		return x.Events
 	}
 	return nil
 }
 
-type CreateLibraryRequest struct {
+func (x *ListReadingActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The caller's libraries are searched.
+type ListFlaggedArticlesInMyLibrariesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLibraryRequest) Reset() {
-	*x = CreateLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[8]
+func (x *ListFlaggedArticlesInMyLibrariesRequest) Reset() {
+	*x = ListFlaggedArticlesInMyLibrariesRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLibraryRequest) String() string {
+func (x *ListFlaggedArticlesInMyLibrariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLibraryRequest) ProtoMessage() {}
+func (*ListFlaggedArticlesInMyLibrariesRequest) ProtoMessage() {}
 
-func (x *CreateLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[8]
+func (x *ListFlaggedArticlesInMyLibrariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,61 +2243,35 @@ func (x *CreateLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLibraryRequest.ProtoReflect.Descriptor instead.
-func (*CreateLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateLibraryRequest) GetOwnerId() int64 {
-	if x != nil {
-		return x.OwnerId
-	}
-	return 0
-}
-
-func (x *CreateLibraryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateLibraryRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *CreateLibraryRequest) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
-	}
-	return false
+// Deprecated: Use ListFlaggedArticlesInMyLibrariesRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedArticlesInMyLibrariesRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{31}
 }
 
-type CreateLibraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FlaggedLibraryEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	LibraryId        int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	LibraryName      string                 `protobuf:"bytes,3,opt,name=library_name,json=libraryName,proto3" json:"library_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateLibraryResponse) Reset() {
-	*x = CreateLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[9]
+func (x *FlaggedLibraryEntry) Reset() {
+	*x = FlaggedLibraryEntry{}
+	mi := &file_api_library_v1_library_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLibraryResponse) String() string {
+func (x *FlaggedLibraryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLibraryResponse) ProtoMessage() {}
+func (*FlaggedLibraryEntry) ProtoMessage() {}
 
-func (x *CreateLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[9]
+func (x *FlaggedLibraryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,43 +2282,59 @@ func (x *CreateLibraryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLibraryResponse.ProtoReflect.Descriptor instead.
-func (*CreateLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use FlaggedLibraryEntry.ProtoReflect.Descriptor instead.
+func (*FlaggedLibraryEntry) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateLibraryResponse) GetLibraryId() int64 {
+func (x *FlaggedLibraryEntry) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *FlaggedLibraryEntry) GetLibraryId() int64 {
 	if x != nil {
 		return x.LibraryId
 	}
 	return 0
 }
 
-type UpdateLibraryRequest struct {
+func (x *FlaggedLibraryEntry) GetLibraryName() string {
+	if x != nil {
+		return x.LibraryName
+	}
+	return ""
+}
+
+type FlaggedArticle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	IsPublic      *bool                  `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Doi           string                 `protobuf:"bytes,2,opt,name=doi,proto3" json:"doi,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Flag          v1.ArticleFlag         `protobuf:"varint,4,opt,name=flag,proto3,enum=api.articles.v1.ArticleFlag" json:"flag,omitempty"`
+	Updates       []*v1.ArticleUpdate    `protobuf:"bytes,5,rep,name=updates,proto3" json:"updates,omitempty"` // Most recent first
+	Entries       []*FlaggedLibraryEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"` // Where the user saved it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLibraryRequest) Reset() {
-	*x = UpdateLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[10]
+func (x *FlaggedArticle) Reset() {
+	*x = FlaggedArticle{}
+	mi := &file_api_library_v1_library_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLibraryRequest) String() string {
+func (x *FlaggedArticle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLibraryRequest) ProtoMessage() {}
+func (*FlaggedArticle) ProtoMessage() {}
 
-func (x *UpdateLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[10]
+func (x *FlaggedArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,61 +2345,75 @@ func (x *UpdateLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLibraryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use FlaggedArticle.ProtoReflect.Descriptor instead.
+func (*FlaggedArticle) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateLibraryRequest) GetLibraryId() int64 {
+func (x *FlaggedArticle) GetArticleId() int64 {
 	if x != nil {
-		return x.LibraryId
+		return x.ArticleId
 	}
 	return 0
 }
 
-func (x *UpdateLibraryRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *FlaggedArticle) GetDoi() string {
+	if x != nil {
+		return x.Doi
 	}
 	return ""
 }
 
-func (x *UpdateLibraryRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *FlaggedArticle) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *UpdateLibraryRequest) GetIsPublic() bool {
-	if x != nil && x.IsPublic != nil {
-		return *x.IsPublic
+func (x *FlaggedArticle) GetFlag() v1.ArticleFlag {
+	if x != nil {
+		return x.Flag
 	}
-	return false
+	return v1.ArticleFlag(0)
 }
 
-type UpdateLibraryResponse struct {
+func (x *FlaggedArticle) GetUpdates() []*v1.ArticleUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *FlaggedArticle) GetEntries() []*FlaggedLibraryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ListFlaggedArticlesInMyLibrariesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Articles      []*FlaggedArticle      `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"` // Most severe first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLibraryResponse) Reset() {
-	*x = UpdateLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[11]
+func (x *ListFlaggedArticlesInMyLibrariesResponse) Reset() {
+	*x = ListFlaggedArticlesInMyLibrariesResponse{}
+	mi := &file_api_library_v1_library_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLibraryResponse) String() string {
+func (x *ListFlaggedArticlesInMyLibrariesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLibraryResponse) ProtoMessage() {}
+func (*ListFlaggedArticlesInMyLibrariesResponse) ProtoMessage() {}
 
-func (x *UpdateLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[11]
+func (x *ListFlaggedArticlesInMyLibrariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,40 +2424,46 @@ func (x *UpdateLibraryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLibraryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListFlaggedArticlesInMyLibrariesResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedArticlesInMyLibrariesResponse) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateLibraryResponse) GetSuccess() bool {
+func (x *ListFlaggedArticlesInMyLibrariesResponse) GetArticles() []*FlaggedArticle {
 	if x != nil {
-		return x.Success
+		return x.Articles
 	}
-	return false
+	return nil
 }
 
-type DeleteLibraryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+// The caller must own the library unless it is public.
+type WatchLibraryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	// The resume_token of the last event received. If the server no longer
+	// has the events after it, for example after a restart, the stream fails
+	// with OUT_OF_RANGE and the client should reload the library and watch
+	// again without a token.
+	ResumeToken   *string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLibraryRequest) Reset() {
-	*x = DeleteLibraryRequest{}
-	mi := &file_api_library_v1_library_proto_msgTypes[12]
+func (x *WatchLibraryRequest) Reset() {
+	*x = WatchLibraryRequest{}
+	mi := &file_api_library_v1_library_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLibraryRequest) String() string {
+func (x *WatchLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLibraryRequest) ProtoMessage() {}
+func (*WatchLibraryRequest) ProtoMessage() {}
 
-func (x *DeleteLibraryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[12]
+func (x *WatchLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,40 +2474,55 @@ func (x *DeleteLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLibraryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLibraryRequest) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use WatchLibraryRequest.ProtoReflect.Descriptor instead.
+func (*WatchLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeleteLibraryRequest) GetLibraryId() int64 {
+func (x *WatchLibraryRequest) GetLibraryId() int64 {
 	if x != nil {
 		return x.LibraryId
 	}
 	return 0
 }
 
-type DeleteLibraryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WatchLibraryRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
 }
 
-func (x *DeleteLibraryResponse) Reset() {
-	*x = DeleteLibraryResponse{}
-	mi := &file_api_library_v1_library_proto_msgTypes[13]
+type LibraryEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             LibraryEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=api.library.v1.LibraryEventType" json:"type,omitempty"`
+	LibraryId        int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	LibraryArticleId int64                  `protobuf:"varint,3,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	ArticleId        int64                  `protobuf:"varint,4,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// The entry after the change. Not set for removals.
+	LibraryArticle *LibraryArticle        `protobuf:"bytes,5,opt,name=library_article,json=libraryArticle,proto3" json:"library_article,omitempty"`
+	FromStatus     ReadingStatus          `protobuf:"varint,6,opt,name=from_status,json=fromStatus,proto3,enum=api.library.v1.ReadingStatus" json:"from_status,omitempty"` // Set for status changes and removals
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken    string                 `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LibraryEvent) Reset() {
+	*x = LibraryEvent{}
+	mi := &file_api_library_v1_library_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLibraryResponse) String() string {
+func (x *LibraryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLibraryResponse) ProtoMessage() {}
+func (*LibraryEvent) ProtoMessage() {}
 
-func (x *DeleteLibraryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_library_v1_library_proto_msgTypes[13]
+func (x *LibraryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_library_v1_library_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,23 +2533,72 @@ func (x *DeleteLibraryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLibraryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLibraryResponse) Descriptor() ([]byte, []int) {
-	return file_api_library_v1_library_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LibraryEvent.ProtoReflect.Descriptor instead.
+func (*LibraryEvent) Descriptor() ([]byte, []int) {
+	return file_api_library_v1_library_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteLibraryResponse) GetSuccess() bool {
+func (x *LibraryEvent) GetType() LibraryEventType {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return LibraryEventType_LIBRARY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *LibraryEvent) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *LibraryEvent) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *LibraryEvent) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *LibraryEvent) GetLibraryArticle() *LibraryArticle {
+	if x != nil {
+		return x.LibraryArticle
+	}
+	return nil
+}
+
+func (x *LibraryEvent) GetFromStatus() ReadingStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *LibraryEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *LibraryEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
 }
 
 var File_api_library_v1_library_proto protoreflect.FileDescriptor
 
 const file_api_library_v1_library_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/library/v1/library.proto\x12\x0eapi.library.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x02\n" +
+	"\x1capi/library/v1/library.proto\x12\x0eapi.library.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19articles/v1/article.proto\"\xcd\x02\n" +
 	"\aLibrary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x12\n" +
@@ -925,7 +2610,26 @@ const file_api_library_v1_library_proto_rawDesc = "" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
-	"\f_description\"\xd3\x03\n" +
+	"\f_description\"\xab\x03\n" +
+	"\x0eLibrarySummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1a\n" +
+	"\bisPublic\x18\x06 \x01(\bR\bisPublic\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\n" +
+	" \x01(\bR\tisDefault\x12#\n" +
+	"\rarticle_count\x18\v \x01(\x03R\farticleCount\x12G\n" +
+	"\rstatus_counts\x18\f \x03(\v2\".api.library.v1.ReadingStatusCountR\fstatusCountsB\x0e\n" +
+	"\f_descriptionJ\x04\b\a\x10\b\"p\n" +
+	"\x12ReadingStatusCount\x12D\n" +
+	"\x0ereading_status\x18\x01 \x01(\x0e2\x1d.api.library.v1.ReadingStatusR\rreadingStatus\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xd3\x03\n" +
 	"\x0eLibraryArticle\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -942,67 +2646,238 @@ const file_api_library_v1_library_proto_rawDesc = "" +
 	"\n" +
 	"isFavorite\x18\v \x01(\bR\n" +
 	"isFavoriteB\b\n" +
-	"\x06_notes\"\xc6\x01\n" +
-	"\x1bSaveArticleToLibraryRequest\x12\x1d\n" +
+	"\x06_notes\"\xed\x01\n" +
+	"\x1bSaveArticleToLibraryRequest\x12&\n" +
 	"\n" +
-	"library_id\x18\x01 \x01(\x03R\tlibraryId\x12\x1d\n" +
+	"library_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\x12&\n" +
 	"\n" +
-	"article_id\x18\x02 \x01(\x03R\tarticleId\x12D\n" +
-	"\x0ereading_status\x18\x03 \x01(\x0e2\x1d.api.library.v1.ReadingStatusR\rreadingStatus\x12\x19\n" +
-	"\x05notes\x18\x04 \x01(\tH\x00R\x05notes\x88\x01\x01B\b\n" +
+	"article_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\x12N\n" +
+	"\x0ereading_status\x18\x03 \x01(\x0e2\x1d.api.library.v1.ReadingStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\rreadingStatus\x12$\n" +
+	"\x05notes\x18\x04 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x00R\x05notes\x88\x01\x01B\b\n" +
 	"\x06_notes\".\n" +
 	"\x1cSaveArticleToLibraryResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"0\n" +
-	"\x15GetUserLibraryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x9e\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb5\x02\n" +
+	"\x16AddByIdentifierRequest\x12*\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\n" +
+	"identifier\x12+\n" +
+	"\n" +
+	"library_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tlibraryId\x88\x01\x01\x12N\n" +
+	"\x0ereading_status\x18\x04 \x01(\x0e2\x1d.api.library.v1.ReadingStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\rreadingStatus\x12$\n" +
+	"\x05notes\x18\x05 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x01R\x05notes\x88\x01\x01\x12#\n" +
+	"\x05title\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x05title\x88\x01\x01B\r\n" +
+	"\v_library_idB\b\n" +
+	"\x06_notesB\b\n" +
+	"\x06_titleJ\x04\b\x01\x10\x02\"\xb0\x01\n" +
+	"\x17AddByIdentifierResponse\x12G\n" +
+	"\x0flibrary_article\x18\x01 \x01(\v2\x1e.api.library.v1.LibraryArticleR\x0elibraryArticle\x12'\n" +
+	"\x0farticle_created\x18\x02 \x01(\bR\x0earticleCreated\x12#\n" +
+	"\ralready_saved\x18\x03 \x01(\bR\falreadySaved\"9\n" +
+	"\x15GetUserLibraryRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"\x9e\x01\n" +
 	"\x16GetUserLibraryResponse\x12?\n" +
 	"\x0edefaultLibrary\x18\x01 \x01(\v2\x17.api.library.v1.LibraryR\x0edefaultLibrary\x12C\n" +
-	"\x10privateLibraries\x18\x02 \x03(\v2\x17.api.library.v1.LibraryR\x10privateLibraries\"2\n" +
-	"\x11GetLibraryRequest\x12\x1d\n" +
+	"\x10privateLibraries\x18\x02 \x03(\v2\x17.api.library.v1.LibraryR\x10privateLibraries\"?\n" +
+	"\x1bListLibrarySummariesRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\"\xa5\x01\n" +
+	"\x1cListLibrarySummariesResponse\x12G\n" +
+	"\x0fdefault_library\x18\x01 \x01(\v2\x1e.api.library.v1.LibrarySummaryR\x0edefaultLibrary\x12<\n" +
+	"\tlibraries\x18\x02 \x03(\v2\x1e.api.library.v1.LibrarySummaryR\tlibraries\"\xc4\x03\n" +
+	"\x1aListLibraryArticlesRequest\x12&\n" +
+	"\n" +
+	"library_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12J\n" +
+	"\asort_by\x18\x04 \x01(\x0e2'.api.library.v1.LibraryArticleSortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06sortBy\x12\x1e\n" +
 	"\n" +
-	"library_id\x18\x01 \x01(\x03R\tlibraryId\"G\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\x12S\n" +
+	"\x0ereading_status\x18\x06 \x01(\x0e2\x1d.api.library.v1.ReadingStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\rreadingStatus\x88\x01\x01\x12$\n" +
+	"\vis_favorite\x18\a \x01(\bH\x01R\n" +
+	"isFavorite\x88\x01\x01\x12#\n" +
+	"\x05query\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\x05query\x88\x01\x01B\x11\n" +
+	"\x0f_reading_statusB\x0e\n" +
+	"\f_is_favoriteB\b\n" +
+	"\x06_query\"\xa2\x01\n" +
+	"\x1bListLibraryArticlesResponse\x12:\n" +
+	"\barticles\x18\x01 \x03(\v2\x1e.api.library.v1.LibraryArticleR\barticles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\";\n" +
+	"\x11GetLibraryRequest\x12&\n" +
+	"\n" +
+	"library_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\"G\n" +
 	"\x12GetLibraryResponse\x121\n" +
-	"\alibrary\x18\x01 \x01(\v2\x17.api.library.v1.LibraryR\alibrary\"\x99\x01\n" +
-	"\x14CreateLibraryRequest\x12\x19\n" +
-	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\alibrary\x18\x01 \x01(\v2\x17.api.library.v1.LibraryR\alibrary\"\xb8\x01\n" +
+	"\x14CreateLibraryRequest\x12\"\n" +
+	"\bowner_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aownerId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
 	"\tis_public\x18\x04 \x01(\bR\bisPublicB\x0e\n" +
 	"\f_description\"6\n" +
 	"\x15CreateLibraryResponse\x12\x1d\n" +
 	"\n" +
-	"library_id\x18\x01 \x01(\x03R\tlibraryId\"\xbe\x01\n" +
-	"\x14UpdateLibraryRequest\x12\x1d\n" +
+	"library_id\x18\x01 \x01(\x03R\tlibraryId\"\xdd\x01\n" +
+	"\x14UpdateLibraryRequest\x12&\n" +
 	"\n" +
-	"library_id\x18\x01 \x01(\x03R\tlibraryId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"library_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x01R\vdescription\x88\x01\x01\x12 \n" +
 	"\tis_public\x18\x04 \x01(\bH\x02R\bisPublic\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
 	"_is_public\"1\n" +
 	"\x15UpdateLibraryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
-	"\x14DeleteLibraryRequest\x12\x1d\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\">\n" +
+	"\x14DeleteLibraryRequest\x12&\n" +
 	"\n" +
-	"library_id\x18\x01 \x01(\x03R\tlibraryId\"1\n" +
+	"library_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\"1\n" +
 	"\x15DeleteLibraryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*\x9e\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd4\x02\n" +
+	"\x1bUpdateLibraryArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12S\n" +
+	"\x0ereading_status\x18\x02 \x01(\x0e2\x1d.api.library.v1.ReadingStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\rreadingStatus\x88\x01\x01\x129\n" +
+	"\x10reading_progress\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00H\x01R\x0freadingProgress\x88\x01\x01\x12$\n" +
+	"\x05notes\x18\x04 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x02R\x05notes\x88\x01\x01\x12$\n" +
+	"\vis_favorite\x18\x05 \x01(\bH\x03R\n" +
+	"isFavorite\x88\x01\x01B\x11\n" +
+	"\x0f_reading_statusB\x13\n" +
+	"\x11_reading_progressB\b\n" +
+	"\x06_notesB\x0e\n" +
+	"\f_is_favorite\"g\n" +
+	"\x1cUpdateLibraryArticleResponse\x12G\n" +
+	"\x0flibrary_article\x18\x01 \x01(\v2\x1e.api.library.v1.LibraryArticleR\x0elibraryArticle\":\n" +
+	"\x1fRemoveArticleFromLibraryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"<\n" +
+	" RemoveArticleFromLibraryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\x1aRecordArticleOpenedRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x1d\n" +
+	"\x1bRecordArticleOpenedResponse\"\xff\x03\n" +
+	"\fReadingEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03R\tlibraryId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x03R\tarticleId\x12,\n" +
+	"\x12library_article_id\x18\x04 \x01(\x03R\x10libraryArticleId\x12?\n" +
+	"\n" +
+	"event_type\x18\x05 \x01(\x0e2 .api.library.v1.ReadingEventTypeR\teventType\x12C\n" +
+	"\vfrom_status\x18\x06 \x01(\x0e2\x1d.api.library.v1.ReadingStatusH\x00R\n" +
+	"fromStatus\x88\x01\x01\x12?\n" +
+	"\tto_status\x18\a \x01(\x0e2\x1d.api.library.v1.ReadingStatusH\x01R\btoStatus\x88\x01\x01\x12\x1f\n" +
+	"\bprogress\x18\b \x01(\x05H\x02R\bprogress\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12#\n" +
+	"\rarticle_title\x18\n" +
+	" \x01(\tR\farticleTitleB\x0e\n" +
+	"\f_from_statusB\f\n" +
+	"\n" +
+	"_to_statusB\v\n" +
+	"\t_progress\"\xa6\x04\n" +
+	"\x1aListReadingActivityRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12+\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tlibraryId\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12P\n" +
+	"\vevent_types\x18\x05 \x03(\x0e2 .api.library.v1.ReadingEventTypeB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\n" +
+	"eventTypes\x12&\n" +
+	"\tpage_size\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken:\x9e\x01\xbaH\x9a\x01\x1a\x97\x01\n" +
+	" list_reading_activity.time_range\x12\"start_time must be before end_time\x1aO!has(this.start_time) || !has(this.end_time) || this.start_time < this.end_timeB\r\n" +
+	"\v_library_id\"{\n" +
+	"\x1bListReadingActivityResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.api.library.v1.ReadingEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
+	"'ListFlaggedArticlesInMyLibrariesRequestJ\x04\b\x01\x10\x02\"\x85\x01\n" +
+	"\x13FlaggedLibraryEntry\x12,\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03R\x10libraryArticleId\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03R\tlibraryId\x12!\n" +
+	"\flibrary_name\x18\x03 \x01(\tR\vlibraryName\"\x82\x02\n" +
+	"\x0eFlaggedArticle\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03R\tarticleId\x12\x10\n" +
+	"\x03doi\x18\x02 \x01(\tR\x03doi\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x120\n" +
+	"\x04flag\x18\x04 \x01(\x0e2\x1c.api.articles.v1.ArticleFlagR\x04flag\x128\n" +
+	"\aupdates\x18\x05 \x03(\v2\x1e.api.articles.v1.ArticleUpdateR\aupdates\x12=\n" +
+	"\aentries\x18\x06 \x03(\v2#.api.library.v1.FlaggedLibraryEntryR\aentries\"f\n" +
+	"(ListFlaggedArticlesInMyLibrariesResponse\x12:\n" +
+	"\barticles\x18\x01 \x03(\v2\x1e.api.library.v1.FlaggedArticleR\barticles\"|\n" +
+	"\x13WatchLibraryRequest\x12&\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tlibraryId\x12&\n" +
+	"\fresume_token\x18\x03 \x01(\tH\x00R\vresumeToken\x88\x01\x01B\x0f\n" +
+	"\r_resume_tokenJ\x04\b\x01\x10\x02\"\x99\x03\n" +
+	"\fLibraryEvent\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .api.library.v1.LibraryEventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03R\tlibraryId\x12,\n" +
+	"\x12library_article_id\x18\x03 \x01(\x03R\x10libraryArticleId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x04 \x01(\x03R\tarticleId\x12G\n" +
+	"\x0flibrary_article\x18\x05 \x01(\v2\x1e.api.library.v1.LibraryArticleR\x0elibraryArticle\x12>\n" +
+	"\vfrom_status\x18\x06 \x01(\x0e2\x1d.api.library.v1.ReadingStatusR\n" +
+	"fromStatus\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\b \x01(\tR\vresumeToken*\x9e\x01\n" +
 	"\rReadingStatus\x12\x1e\n" +
 	"\x1aREADING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16READING_STATUS_TO_READ\x10\x01\x12\x1a\n" +
 	"\x16READING_STATUS_READING\x10\x02\x12\x17\n" +
 	"\x13READING_STATUS_READ\x10\x03\x12\x1c\n" +
-	"\x18READING_STATUS_ABANDONED\x10\x042\xd3\x04\n" +
-	"\x0eLibraryService\x12q\n" +
-	"\x14SaveArticleToLibrary\x12+.api.library.v1.SaveArticleToLibraryRequest\x1a,.api.library.v1.SaveArticleToLibraryResponse\x12_\n" +
-	"\x0eGetUserLibrary\x12%.api.library.v1.GetUserLibraryRequest\x1a&.api.library.v1.GetUserLibraryResponse\x12S\n" +
+	"\x18READING_STATUS_ABANDONED\x10\x04*\xf8\x01\n" +
+	"\x17LibraryArticleSortField\x12*\n" +
+	"&LIBRARY_ARTICLE_SORT_FIELD_UNSPECIFIED\x10\x00\x12)\n" +
+	"%LIBRARY_ARTICLE_SORT_FIELD_DATE_ADDED\x10\x01\x12$\n" +
+	" LIBRARY_ARTICLE_SORT_FIELD_TITLE\x10\x02\x12/\n" +
+	"+LIBRARY_ARTICLE_SORT_FIELD_PUBLICATION_YEAR\x10\x03\x12/\n" +
+	"+LIBRARY_ARTICLE_SORT_FIELD_READING_PROGRESS\x10\x04*\xd1\x02\n" +
+	"\x10ReadingEventType\x12\"\n" +
+	"\x1eREADING_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18READING_EVENT_TYPE_ADDED\x10\x01\x12%\n" +
+	"!READING_EVENT_TYPE_STATUS_CHANGED\x10\x02\x12'\n" +
+	"#READING_EVENT_TYPE_PROGRESS_UPDATED\x10\x03\x12\"\n" +
+	"\x1eREADING_EVENT_TYPE_NOTE_EDITED\x10\x04\x12\x1d\n" +
+	"\x19READING_EVENT_TYPE_OPENED\x10\x05\x12\x1e\n" +
+	"\x1aREADING_EVENT_TYPE_REMOVED\x10\x06\x12'\n" +
+	"#READING_EVENT_TYPE_FAVORITE_CHANGED\x10\a\x12\x1f\n" +
+	"\x1bREADING_EVENT_TYPE_REVIEWED\x10\b*\xf5\x01\n" +
+	"\x10LibraryEventType\x12\"\n" +
+	"\x1eLIBRARY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cLIBRARY_EVENT_TYPE_CAUGHT_UP\x10\x01\x12$\n" +
+	" LIBRARY_EVENT_TYPE_ARTICLE_ADDED\x10\x02\x12&\n" +
+	"\"LIBRARY_EVENT_TYPE_ARTICLE_REMOVED\x10\x03\x12%\n" +
+	"!LIBRARY_EVENT_TYPE_STATUS_CHANGED\x10\x04\x12&\n" +
+	"\"LIBRARY_EVENT_TYPE_ARTICLE_UPDATED\x10\x052\xd0\x11\n" +
+	"\x0eLibraryService\x12\xa1\x01\n" +
+	"\x14SaveArticleToLibrary\x12+.api.library.v1.SaveArticleToLibraryRequest\x1a,.api.library.v1.SaveArticleToLibraryResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/libraries/{library_id}/articles\x12\x93\x01\n" +
+	"\x0fAddByIdentifier\x12&.api.library.v1.AddByIdentifierRequest\x1a'.api.library.v1.AddByIdentifierResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/library-articles:addByIdentifier\x12\x89\x01\n" +
+	"\x0eGetUserLibrary\x12%.api.library.v1.GetUserLibraryRequest\x1a&.api.library.v1.GetUserLibraryResponse\"(\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/users/{user_id}/libraries\x88\x02\x01\x12\xa0\x01\n" +
+	"\x14ListLibrarySummaries\x12+.api.library.v1.ListLibrarySummariesRequest\x1a,.api.library.v1.ListLibrarySummariesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/users/{user_id}/library-summaries\x12\x9b\x01\n" +
+	"\x13ListLibraryArticles\x12*.api.library.v1.ListLibraryArticlesRequest\x1a+.api.library.v1.ListLibraryArticlesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/libraries/{library_id}/articles\x12w\n" +
 	"\n" +
-	"GetLibrary\x12!.api.library.v1.GetLibraryRequest\x1a\".api.library.v1.GetLibraryResponse\x12\\\n" +
-	"\rCreateLibrary\x12$.api.library.v1.CreateLibraryRequest\x1a%.api.library.v1.CreateLibraryResponse\x12\\\n" +
-	"\rUpdateLibrary\x12$.api.library.v1.UpdateLibraryRequest\x1a%.api.library.v1.UpdateLibraryResponse\x12\\\n" +
-	"\rDeleteLibrary\x12$.api.library.v1.DeleteLibraryRequest\x1a%.api.library.v1.DeleteLibraryResponseB9Z7github.com/chiquitav2/journalful/pkg/library/v1;libraryb\x06proto3"
+	"GetLibrary\x12!.api.library.v1.GetLibraryRequest\x1a\".api.library.v1.GetLibraryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/libraries/{library_id}\x12\x87\x01\n" +
+	"\rCreateLibrary\x12$.api.library.v1.CreateLibraryRequest\x1a%.api.library.v1.CreateLibraryResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{owner_id}/libraries\x12\x83\x01\n" +
+	"\rUpdateLibrary\x12$.api.library.v1.UpdateLibraryRequest\x1a%.api.library.v1.UpdateLibraryResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/v1/libraries/{library_id}\x12\x80\x01\n" +
+	"\rDeleteLibrary\x12$.api.library.v1.DeleteLibraryRequest\x1a%.api.library.v1.DeleteLibraryResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/libraries/{library_id}\x12\x97\x01\n" +
+	"\x14UpdateLibraryArticle\x12+.api.library.v1.UpdateLibraryArticleRequest\x1a,.api.library.v1.UpdateLibraryArticleResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/library-articles/{id}\x12\xa0\x01\n" +
+	"\x18RemoveArticleFromLibrary\x12/.api.library.v1.RemoveArticleFromLibraryRequest\x1a0.api.library.v1.RemoveArticleFromLibraryResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/library-articles/{id}\x12\x99\x01\n" +
+	"\x13RecordArticleOpened\x12*.api.library.v1.RecordArticleOpenedRequest\x1a+.api.library.v1.RecordArticleOpenedResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/library-articles/{id}:open\x12\x9c\x01\n" +
+	"\x13ListReadingActivity\x12*.api.library.v1.ListReadingActivityRequest\x1a+.api.library.v1.ListReadingActivityResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/users/{user_id}/reading-activity\x12\xb3\x01\n" +
+	" ListFlaggedArticlesInMyLibraries\x127.api.library.v1.ListFlaggedArticlesInMyLibrariesRequest\x1a8.api.library.v1.ListFlaggedArticlesInMyLibrariesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/flagged-articles\x12}\n" +
+	"\fWatchLibrary\x12#.api.library.v1.WatchLibraryRequest\x1a\x1c.api.library.v1.LibraryEvent\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/libraries/{library_id}:watch0\x01B9Z7github.com/chiquitav2/journalful/pkg/library/v1;libraryb\x06proto3"
 
 var (
 	file_api_library_v1_library_proto_rawDescOnce sync.Once
@@ -1016,54 +2891,129 @@ func file_api_library_v1_library_proto_rawDescGZIP() []byte {
 	return file_api_library_v1_library_proto_rawDescData
 }
 
-var file_api_library_v1_library_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_library_v1_library_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_api_library_v1_library_proto_goTypes = []any{
-	(ReadingStatus)(0),                   // 0: api.library.v1.ReadingStatus
-	(*Library)(nil),                      // 1: api.library.v1.Library
-	(*LibraryArticle)(nil),               // 2: api.library.v1.LibraryArticle
-	(*SaveArticleToLibraryRequest)(nil),  // 3: api.library.v1.SaveArticleToLibraryRequest
-	(*SaveArticleToLibraryResponse)(nil), // 4: api.library.v1.SaveArticleToLibraryResponse
-	(*GetUserLibraryRequest)(nil),        // 5: api.library.v1.GetUserLibraryRequest
-	(*GetUserLibraryResponse)(nil),       // 6: api.library.v1.GetUserLibraryResponse
-	(*GetLibraryRequest)(nil),            // 7: api.library.v1.GetLibraryRequest
-	(*GetLibraryResponse)(nil),           // 8: api.library.v1.GetLibraryResponse
-	(*CreateLibraryRequest)(nil),         // 9: api.library.v1.CreateLibraryRequest
-	(*CreateLibraryResponse)(nil),        // 10: api.library.v1.CreateLibraryResponse
-	(*UpdateLibraryRequest)(nil),         // 11: api.library.v1.UpdateLibraryRequest
-	(*UpdateLibraryResponse)(nil),        // 12: api.library.v1.UpdateLibraryResponse
-	(*DeleteLibraryRequest)(nil),         // 13: api.library.v1.DeleteLibraryRequest
-	(*DeleteLibraryResponse)(nil),        // 14: api.library.v1.DeleteLibraryResponse
-	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+	(ReadingStatus)(0),                               // 0: api.library.v1.ReadingStatus
+	(LibraryArticleSortField)(0),                     // 1: api.library.v1.LibraryArticleSortField
+	(ReadingEventType)(0),                            // 2: api.library.v1.ReadingEventType
+	(LibraryEventType)(0),                            // 3: api.library.v1.LibraryEventType
+	(*Library)(nil),                                  // 4: api.library.v1.Library
+	(*LibrarySummary)(nil),                           // 5: api.library.v1.LibrarySummary
+	(*ReadingStatusCount)(nil),                       // 6: api.library.v1.ReadingStatusCount
+	(*LibraryArticle)(nil),                           // 7: api.library.v1.LibraryArticle
+	(*SaveArticleToLibraryRequest)(nil),              // 8: api.library.v1.SaveArticleToLibraryRequest
+	(*SaveArticleToLibraryResponse)(nil),             // 9: api.library.v1.SaveArticleToLibraryResponse
+	(*AddByIdentifierRequest)(nil),                   // 10: api.library.v1.AddByIdentifierRequest
+	(*AddByIdentifierResponse)(nil),                  // 11: api.library.v1.AddByIdentifierResponse
+	(*GetUserLibraryRequest)(nil),                    // 12: api.library.v1.GetUserLibraryRequest
+	(*GetUserLibraryResponse)(nil),                   // 13: api.library.v1.GetUserLibraryResponse
+	(*ListLibrarySummariesRequest)(nil),              // 14: api.library.v1.ListLibrarySummariesRequest
+	(*ListLibrarySummariesResponse)(nil),             // 15: api.library.v1.ListLibrarySummariesResponse
+	(*ListLibraryArticlesRequest)(nil),               // 16: api.library.v1.ListLibraryArticlesRequest
+	(*ListLibraryArticlesResponse)(nil),              // 17: api.library.v1.ListLibraryArticlesResponse
+	(*GetLibraryRequest)(nil),                        // 18: api.library.v1.GetLibraryRequest
+	(*GetLibraryResponse)(nil),                       // 19: api.library.v1.GetLibraryResponse
+	(*CreateLibraryRequest)(nil),                     // 20: api.library.v1.CreateLibraryRequest
+	(*CreateLibraryResponse)(nil),                    // 21: api.library.v1.CreateLibraryResponse
+	(*UpdateLibraryRequest)(nil),                     // 22: api.library.v1.UpdateLibraryRequest
+	(*UpdateLibraryResponse)(nil),                    // 23: api.library.v1.UpdateLibraryResponse
+	(*DeleteLibraryRequest)(nil),                     // 24: api.library.v1.DeleteLibraryRequest
+	(*DeleteLibraryResponse)(nil),                    // 25: api.library.v1.DeleteLibraryResponse
+	(*UpdateLibraryArticleRequest)(nil),              // 26: api.library.v1.UpdateLibraryArticleRequest
+	(*UpdateLibraryArticleResponse)(nil),             // 27: api.library.v1.UpdateLibraryArticleResponse
+	(*RemoveArticleFromLibraryRequest)(nil),          // 28: api.library.v1.RemoveArticleFromLibraryRequest
+	(*RemoveArticleFromLibraryResponse)(nil),         // 29: api.library.v1.RemoveArticleFromLibraryResponse
+	(*RecordArticleOpenedRequest)(nil),               // 30: api.library.v1.RecordArticleOpenedRequest
+	(*RecordArticleOpenedResponse)(nil),              // 31: api.library.v1.RecordArticleOpenedResponse
+	(*ReadingEvent)(nil),                             // 32: api.library.v1.ReadingEvent
+	(*ListReadingActivityRequest)(nil),               // 33: api.library.v1.ListReadingActivityRequest
+	(*ListReadingActivityResponse)(nil),              // 34: api.library.v1.ListReadingActivityResponse
+	(*ListFlaggedArticlesInMyLibrariesRequest)(nil),  // 35: api.library.v1.ListFlaggedArticlesInMyLibrariesRequest
+	(*FlaggedLibraryEntry)(nil),                      // 36: api.library.v1.FlaggedLibraryEntry
+	(*FlaggedArticle)(nil),                           // 37: api.library.v1.FlaggedArticle
+	(*ListFlaggedArticlesInMyLibrariesResponse)(nil), // 38: api.library.v1.ListFlaggedArticlesInMyLibrariesResponse
+	(*WatchLibraryRequest)(nil),                      // 39: api.library.v1.WatchLibraryRequest
+	(*LibraryEvent)(nil),                             // 40: api.library.v1.LibraryEvent
+	(*timestamppb.Timestamp)(nil),                    // 41: google.protobuf.Timestamp
+	(v1.ArticleFlag)(0),                              // 42: api.articles.v1.ArticleFlag
+	(*v1.ArticleUpdate)(nil),                         // 43: api.articles.v1.ArticleUpdate
 }
 var file_api_library_v1_library_proto_depIdxs = []int32{
-	2,  // 0: api.library.v1.Library.articles:type_name -> api.library.v1.LibraryArticle
-	15, // 1: api.library.v1.Library.created_at:type_name -> google.protobuf.Timestamp
-	15, // 2: api.library.v1.Library.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: api.library.v1.LibraryArticle.reading_status:type_name -> api.library.v1.ReadingStatus
-	15, // 4: api.library.v1.LibraryArticle.dateAdded:type_name -> google.protobuf.Timestamp
-	15, // 5: api.library.v1.LibraryArticle.dateCompleted:type_name -> google.protobuf.Timestamp
-	0,  // 6: api.library.v1.SaveArticleToLibraryRequest.reading_status:type_name -> api.library.v1.ReadingStatus
-	1,  // 7: api.library.v1.GetUserLibraryResponse.defaultLibrary:type_name -> api.library.v1.Library
-	1,  // 8: api.library.v1.GetUserLibraryResponse.privateLibraries:type_name -> api.library.v1.Library
-	1,  // 9: api.library.v1.GetLibraryResponse.library:type_name -> api.library.v1.Library
-	3,  // 10: api.library.v1.LibraryService.SaveArticleToLibrary:input_type -> api.library.v1.SaveArticleToLibraryRequest
-	5,  // 11: api.library.v1.LibraryService.GetUserLibrary:input_type -> api.library.v1.GetUserLibraryRequest
-	7,  // 12: api.library.v1.LibraryService.GetLibrary:input_type -> api.library.v1.GetLibraryRequest
-	9,  // 13: api.library.v1.LibraryService.CreateLibrary:input_type -> api.library.v1.CreateLibraryRequest
-	11, // 14: api.library.v1.LibraryService.UpdateLibrary:input_type -> api.library.v1.UpdateLibraryRequest
-	13, // 15: api.library.v1.LibraryService.DeleteLibrary:input_type -> api.library.v1.DeleteLibraryRequest
-	4,  // 16: api.library.v1.LibraryService.SaveArticleToLibrary:output_type -> api.library.v1.SaveArticleToLibraryResponse
-	6,  // 17: api.library.v1.LibraryService.GetUserLibrary:output_type -> api.library.v1.GetUserLibraryResponse
-	8,  // 18: api.library.v1.LibraryService.GetLibrary:output_type -> api.library.v1.GetLibraryResponse
-	10, // 19: api.library.v1.LibraryService.CreateLibrary:output_type -> api.library.v1.CreateLibraryResponse
-	12, // 20: api.library.v1.LibraryService.UpdateLibrary:output_type -> api.library.v1.UpdateLibraryResponse
-	14, // 21: api.library.v1.LibraryService.DeleteLibrary:output_type -> api.library.v1.DeleteLibraryResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 0: api.library.v1.Library.articles:type_name -> api.library.v1.LibraryArticle
+	41, // 1: api.library.v1.Library.created_at:type_name -> google.protobuf.Timestamp
+	41, // 2: api.library.v1.Library.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 3: api.library.v1.LibrarySummary.created_at:type_name -> google.protobuf.Timestamp
+	41, // 4: api.library.v1.LibrarySummary.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: api.library.v1.LibrarySummary.status_counts:type_name -> api.library.v1.ReadingStatusCount
+	0,  // 6: api.library.v1.ReadingStatusCount.reading_status:type_name -> api.library.v1.ReadingStatus
+	0,  // 7: api.library.v1.LibraryArticle.reading_status:type_name -> api.library.v1.ReadingStatus
+	41, // 8: api.library.v1.LibraryArticle.dateAdded:type_name -> google.protobuf.Timestamp
+	41, // 9: api.library.v1.LibraryArticle.dateCompleted:type_name -> google.protobuf.Timestamp
+	0,  // 10: api.library.v1.SaveArticleToLibraryRequest.reading_status:type_name -> api.library.v1.ReadingStatus
+	0,  // 11: api.library.v1.AddByIdentifierRequest.reading_status:type_name -> api.library.v1.ReadingStatus
+	7,  // 12: api.library.v1.AddByIdentifierResponse.library_article:type_name -> api.library.v1.LibraryArticle
+	4,  // 13: api.library.v1.GetUserLibraryResponse.defaultLibrary:type_name -> api.library.v1.Library
+	4,  // 14: api.library.v1.GetUserLibraryResponse.privateLibraries:type_name -> api.library.v1.Library
+	5,  // 15: api.library.v1.ListLibrarySummariesResponse.default_library:type_name -> api.library.v1.LibrarySummary
+	5,  // 16: api.library.v1.ListLibrarySummariesResponse.libraries:type_name -> api.library.v1.LibrarySummary
+	1,  // 17: api.library.v1.ListLibraryArticlesRequest.sort_by:type_name -> api.library.v1.LibraryArticleSortField
+	0,  // 18: api.library.v1.ListLibraryArticlesRequest.reading_status:type_name -> api.library.v1.ReadingStatus
+	7,  // 19: api.library.v1.ListLibraryArticlesResponse.articles:type_name -> api.library.v1.LibraryArticle
+	4,  // 20: api.library.v1.GetLibraryResponse.library:type_name -> api.library.v1.Library
+	0,  // 21: api.library.v1.UpdateLibraryArticleRequest.reading_status:type_name -> api.library.v1.ReadingStatus
+	7,  // 22: api.library.v1.UpdateLibraryArticleResponse.library_article:type_name -> api.library.v1.LibraryArticle
+	2,  // 23: api.library.v1.ReadingEvent.event_type:type_name -> api.library.v1.ReadingEventType
+	0,  // 24: api.library.v1.ReadingEvent.from_status:type_name -> api.library.v1.ReadingStatus
+	0,  // 25: api.library.v1.ReadingEvent.to_status:type_name -> api.library.v1.ReadingStatus
+	41, // 26: api.library.v1.ReadingEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	41, // 27: api.library.v1.ListReadingActivityRequest.start_time:type_name -> google.protobuf.Timestamp
+	41, // 28: api.library.v1.ListReadingActivityRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 29: api.library.v1.ListReadingActivityRequest.event_types:type_name -> api.library.v1.ReadingEventType
+	32, // 30: api.library.v1.ListReadingActivityResponse.events:type_name -> api.library.v1.ReadingEvent
+	42, // 31: api.library.v1.FlaggedArticle.flag:type_name -> api.articles.v1.ArticleFlag
+	43, // 32: api.library.v1.FlaggedArticle.updates:type_name -> api.articles.v1.ArticleUpdate
+	36, // 33: api.library.v1.FlaggedArticle.entries:type_name -> api.library.v1.FlaggedLibraryEntry
+	37, // 34: api.library.v1.ListFlaggedArticlesInMyLibrariesResponse.articles:type_name -> api.library.v1.FlaggedArticle
+	3,  // 35: api.library.v1.LibraryEvent.type:type_name -> api.library.v1.LibraryEventType
+	7,  // 36: api.library.v1.LibraryEvent.library_article:type_name -> api.library.v1.LibraryArticle
+	0,  // 37: api.library.v1.LibraryEvent.from_status:type_name -> api.library.v1.ReadingStatus
+	41, // 38: api.library.v1.LibraryEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	8,  // 39: api.library.v1.LibraryService.SaveArticleToLibrary:input_type -> api.library.v1.SaveArticleToLibraryRequest
+	10, // 40: api.library.v1.LibraryService.AddByIdentifier:input_type -> api.library.v1.AddByIdentifierRequest
+	12, // 41: api.library.v1.LibraryService.GetUserLibrary:input_type -> api.library.v1.GetUserLibraryRequest
+	14, // 42: api.library.v1.LibraryService.ListLibrarySummaries:input_type -> api.library.v1.ListLibrarySummariesRequest
+	16, // 43: api.library.v1.LibraryService.ListLibraryArticles:input_type -> api.library.v1.ListLibraryArticlesRequest
+	18, // 44: api.library.v1.LibraryService.GetLibrary:input_type -> api.library.v1.GetLibraryRequest
+	20, // 45: api.library.v1.LibraryService.CreateLibrary:input_type -> api.library.v1.CreateLibraryRequest
+	22, // 46: api.library.v1.LibraryService.UpdateLibrary:input_type -> api.library.v1.UpdateLibraryRequest
+	24, // 47: api.library.v1.LibraryService.DeleteLibrary:input_type -> api.library.v1.DeleteLibraryRequest
+	26, // 48: api.library.v1.LibraryService.UpdateLibraryArticle:input_type -> api.library.v1.UpdateLibraryArticleRequest
+	28, // 49: api.library.v1.LibraryService.RemoveArticleFromLibrary:input_type -> api.library.v1.RemoveArticleFromLibraryRequest
+	30, // 50: api.library.v1.LibraryService.RecordArticleOpened:input_type -> api.library.v1.RecordArticleOpenedRequest
+	33, // 51: api.library.v1.LibraryService.ListReadingActivity:input_type -> api.library.v1.ListReadingActivityRequest
+	35, // 52: api.library.v1.LibraryService.ListFlaggedArticlesInMyLibraries:input_type -> api.library.v1.ListFlaggedArticlesInMyLibrariesRequest
+	39, // 53: api.library.v1.LibraryService.WatchLibrary:input_type -> api.library.v1.WatchLibraryRequest
+	9,  // 54: api.library.v1.LibraryService.SaveArticleToLibrary:output_type -> api.library.v1.SaveArticleToLibraryResponse
+	11, // 55: api.library.v1.LibraryService.AddByIdentifier:output_type -> api.library.v1.AddByIdentifierResponse
+	13, // 56: api.library.v1.LibraryService.GetUserLibrary:output_type -> api.library.v1.GetUserLibraryResponse
+	15, // 57: api.library.v1.LibraryService.ListLibrarySummaries:output_type -> api.library.v1.ListLibrarySummariesResponse
+	17, // 58: api.library.v1.LibraryService.ListLibraryArticles:output_type -> api.library.v1.ListLibraryArticlesResponse
+	19, // 59: api.library.v1.LibraryService.GetLibrary:output_type -> api.library.v1.GetLibraryResponse
+	21, // 60: api.library.v1.LibraryService.CreateLibrary:output_type -> api.library.v1.CreateLibraryResponse
+	23, // 61: api.library.v1.LibraryService.UpdateLibrary:output_type -> api.library.v1.UpdateLibraryResponse
+	25, // 62: api.library.v1.LibraryService.DeleteLibrary:output_type -> api.library.v1.DeleteLibraryResponse
+	27, // 63: api.library.v1.LibraryService.UpdateLibraryArticle:output_type -> api.library.v1.UpdateLibraryArticleResponse
+	29, // 64: api.library.v1.LibraryService.RemoveArticleFromLibrary:output_type -> api.library.v1.RemoveArticleFromLibraryResponse
+	31, // 65: api.library.v1.LibraryService.RecordArticleOpened:output_type -> api.library.v1.RecordArticleOpenedResponse
+	34, // 66: api.library.v1.LibraryService.ListReadingActivity:output_type -> api.library.v1.ListReadingActivityResponse
+	38, // 67: api.library.v1.LibraryService.ListFlaggedArticlesInMyLibraries:output_type -> api.library.v1.ListFlaggedArticlesInMyLibrariesResponse
+	40, // 68: api.library.v1.LibraryService.WatchLibrary:output_type -> api.library.v1.LibraryEvent
+	54, // [54:69] is the sub-list for method output_type
+	39, // [39:54] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_api_library_v1_library_proto_init() }
@@ -1073,16 +3023,23 @@ func file_api_library_v1_library_proto_init() {
 	}
 	file_api_library_v1_library_proto_msgTypes[0].OneofWrappers = []any{}
 	file_api_library_v1_library_proto_msgTypes[1].OneofWrappers = []any{}
-	file_api_library_v1_library_proto_msgTypes[2].OneofWrappers = []any{}
-	file_api_library_v1_library_proto_msgTypes[8].OneofWrappers = []any{}
-	file_api_library_v1_library_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[6].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[29].OneofWrappers = []any{}
+	file_api_library_v1_library_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_library_v1_library_proto_rawDesc), len(file_api_library_v1_library_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      4,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LibraryService_SaveArticleToLibrary_FullMethodName = "/api.library.v1.LibraryService/SaveArticleToLibrary"
-	LibraryService_GetUserLibrary_FullMethodName       = "/api.library.v1.LibraryService/GetUserLibrary"
-	LibraryService_GetLibrary_FullMethodName           = "/api.library.v1.LibraryService/GetLibrary"
-	LibraryService_CreateLibrary_FullMethodName        = "/api.library.v1.LibraryService/CreateLibrary"
-	LibraryService_UpdateLibrary_FullMethodName        = "/api.library.v1.LibraryService/UpdateLibrary"
-	LibraryService_DeleteLibrary_FullMethodName        = "/api.library.v1.LibraryService/DeleteLibrary"
+	LibraryService_SaveArticleToLibrary_FullMethodName             = "/api.library.v1.LibraryService/SaveArticleToLibrary"
+	LibraryService_AddByIdentifier_FullMethodName                  = "/api.library.v1.LibraryService/AddByIdentifier"
+	LibraryService_GetUserLibrary_FullMethodName                   = "/api.library.v1.LibraryService/GetUserLibrary"
+	LibraryService_ListLibrarySummaries_FullMethodName             = "/api.library.v1.LibraryService/ListLibrarySummaries"
+	LibraryService_ListLibraryArticles_FullMethodName              = "/api.library.v1.LibraryService/ListLibraryArticles"
+	LibraryService_GetLibrary_FullMethodName                       = "/api.library.v1.LibraryService/GetLibrary"
+	LibraryService_CreateLibrary_FullMethodName                    = "/api.library.v1.LibraryService/CreateLibrary"
+	LibraryService_UpdateLibrary_FullMethodName                    = "/api.library.v1.LibraryService/UpdateLibrary"
+	LibraryService_DeleteLibrary_FullMethodName                    = "/api.library.v1.LibraryService/DeleteLibrary"
+	LibraryService_UpdateLibraryArticle_FullMethodName             = "/api.library.v1.LibraryService/UpdateLibraryArticle"
+	LibraryService_RemoveArticleFromLibrary_FullMethodName         = "/api.library.v1.LibraryService/RemoveArticleFromLibrary"
+	LibraryService_RecordArticleOpened_FullMethodName              = "/api.library.v1.LibraryService/RecordArticleOpened"
+	LibraryService_ListReadingActivity_FullMethodName              = "/api.library.v1.LibraryService/ListReadingActivity"
+	LibraryService_ListFlaggedArticlesInMyLibraries_FullMethodName = "/api.library.v1.LibraryService/ListFlaggedArticlesInMyLibraries"
+	LibraryService_WatchLibrary_FullMethodName                     = "/api.library.v1.LibraryService/WatchLibrary"
 )
 
 // LibraryServiceClient is the client API for LibraryService service.
@@ -32,11 +41,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LibraryServiceClient interface {
 	SaveArticleToLibrary(ctx context.Context, in *SaveArticleToLibraryRequest, opts ...grpc.CallOption) (*SaveArticleToLibraryResponse, error)
+	// AddByIdentifier finds or creates the article for a DOI and saves it to a
+	// library in one call. Adding an article that is already saved returns
+	// the existing entry unchanged.
+	AddByIdentifier(ctx context.Context, in *AddByIdentifierRequest, opts ...grpc.CallOption) (*AddByIdentifierResponse, error)
+	// Deprecated: Do not use.
+	// GetUserLibrary returns the user's libraries with all their articles.
+	// Deprecated: use ListLibrarySummaries and ListLibraryArticles, which
+	// don't load every saved article.
 	GetUserLibrary(ctx context.Context, in *GetUserLibraryRequest, opts ...grpc.CallOption) (*GetUserLibraryResponse, error)
+	// ListLibrarySummaries returns the user's libraries with their article
+	// counts per reading status, creating the default library if needed.
+	ListLibrarySummaries(ctx context.Context, in *ListLibrarySummariesRequest, opts ...grpc.CallOption) (*ListLibrarySummariesResponse, error)
+	ListLibraryArticles(ctx context.Context, in *ListLibraryArticlesRequest, opts ...grpc.CallOption) (*ListLibraryArticlesResponse, error)
 	GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error)
 	CreateLibrary(ctx context.Context, in *CreateLibraryRequest, opts ...grpc.CallOption) (*CreateLibraryResponse, error)
 	UpdateLibrary(ctx context.Context, in *UpdateLibraryRequest, opts ...grpc.CallOption) (*UpdateLibraryResponse, error)
 	DeleteLibrary(ctx context.Context, in *DeleteLibraryRequest, opts ...grpc.CallOption) (*DeleteLibraryResponse, error)
+	UpdateLibraryArticle(ctx context.Context, in *UpdateLibraryArticleRequest, opts ...grpc.CallOption) (*UpdateLibraryArticleResponse, error)
+	RemoveArticleFromLibrary(ctx context.Context, in *RemoveArticleFromLibraryRequest, opts ...grpc.CallOption) (*RemoveArticleFromLibraryResponse, error)
+	RecordArticleOpened(ctx context.Context, in *RecordArticleOpenedRequest, opts ...grpc.CallOption) (*RecordArticleOpenedResponse, error)
+	ListReadingActivity(ctx context.Context, in *ListReadingActivityRequest, opts ...grpc.CallOption) (*ListReadingActivityResponse, error)
+	// ListFlaggedArticlesInMyLibraries lists saved articles that have been
+	// corrected, retracted or received an expression of concern.
+	ListFlaggedArticlesInMyLibraries(ctx context.Context, in *ListFlaggedArticlesInMyLibrariesRequest, opts ...grpc.CallOption) (*ListFlaggedArticlesInMyLibrariesResponse, error)
+	// WatchLibrary streams changes to a library's articles as they happen.
+	// Reconnecting with the resume token of the last event received replays
+	// the events missed in between.
+	WatchLibrary(ctx context.Context, in *WatchLibraryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LibraryEvent], error)
 }
 
 type libraryServiceClient struct {
@@ -57,6 +89,17 @@ func (c *libraryServiceClient) SaveArticleToLibrary(ctx context.Context, in *Sav
 	return out, nil
 }
 
+func (c *libraryServiceClient) AddByIdentifier(ctx context.Context, in *AddByIdentifierRequest, opts ...grpc.CallOption) (*AddByIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddByIdentifierResponse)
+	err := c.cc.Invoke(ctx, LibraryService_AddByIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *libraryServiceClient) GetUserLibrary(ctx context.Context, in *GetUserLibraryRequest, opts ...grpc.CallOption) (*GetUserLibraryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserLibraryResponse)
@@ -67,6 +110,26 @@ func (c *libraryServiceClient) GetUserLibrary(ctx context.Context, in *GetUserLi
 	return out, nil
 }
 
+func (c *libraryServiceClient) ListLibrarySummaries(ctx context.Context, in *ListLibrarySummariesRequest, opts ...grpc.CallOption) (*ListLibrarySummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLibrarySummariesResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListLibrarySummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListLibraryArticles(ctx context.Context, in *ListLibraryArticlesRequest, opts ...grpc.CallOption) (*ListLibraryArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLibraryArticlesResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListLibraryArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *libraryServiceClient) GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLibraryResponse)
@@ -107,16 +170,108 @@ func (c *libraryServiceClient) DeleteLibrary(ctx context.Context, in *DeleteLibr
 	return out, nil
 }
 
+func (c *libraryServiceClient) UpdateLibraryArticle(ctx context.Context, in *UpdateLibraryArticleRequest, opts ...grpc.CallOption) (*UpdateLibraryArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLibraryArticleResponse)
+	err := c.cc.Invoke(ctx, LibraryService_UpdateLibraryArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) RemoveArticleFromLibrary(ctx context.Context, in *RemoveArticleFromLibraryRequest, opts ...grpc.CallOption) (*RemoveArticleFromLibraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveArticleFromLibraryResponse)
+	err := c.cc.Invoke(ctx, LibraryService_RemoveArticleFromLibrary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) RecordArticleOpened(ctx context.Context, in *RecordArticleOpenedRequest, opts ...grpc.CallOption) (*RecordArticleOpenedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordArticleOpenedResponse)
+	err := c.cc.Invoke(ctx, LibraryService_RecordArticleOpened_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListReadingActivity(ctx context.Context, in *ListReadingActivityRequest, opts ...grpc.CallOption) (*ListReadingActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReadingActivityResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListReadingActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListFlaggedArticlesInMyLibraries(ctx context.Context, in *ListFlaggedArticlesInMyLibrariesRequest, opts ...grpc.CallOption) (*ListFlaggedArticlesInMyLibrariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedArticlesInMyLibrariesResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListFlaggedArticlesInMyLibraries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) WatchLibrary(ctx context.Context, in *WatchLibraryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LibraryEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LibraryService_ServiceDesc.Streams[0], LibraryService_WatchLibrary_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchLibraryRequest, LibraryEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_WatchLibraryClient = grpc.ServerStreamingClient[LibraryEvent]
+
 // LibraryServiceServer is the server API for LibraryService service.
 // All implementations must embed UnimplementedLibraryServiceServer
 // for forward compatibility.
 type LibraryServiceServer interface {
 	SaveArticleToLibrary(context.Context, *SaveArticleToLibraryRequest) (*SaveArticleToLibraryResponse, error)
+	// AddByIdentifier finds or creates the article for a DOI and saves it to a
+	// library in one call. Adding an article that is already saved returns
+	// the existing entry unchanged.
+	AddByIdentifier(context.Context, *AddByIdentifierRequest) (*AddByIdentifierResponse, error)
+	// Deprecated: Do not use.
+	// GetUserLibrary returns the user's libraries with all their articles.
+	// Deprecated: use ListLibrarySummaries and ListLibraryArticles, which
+	// don't load every saved article.
 	GetUserLibrary(context.Context, *GetUserLibraryRequest) (*GetUserLibraryResponse, error)
+	// ListLibrarySummaries returns the user's libraries with their article
+	// counts per reading status, creating the default library if needed.
+	ListLibrarySummaries(context.Context, *ListLibrarySummariesRequest) (*ListLibrarySummariesResponse, error)
+	ListLibraryArticles(context.Context, *ListLibraryArticlesRequest) (*ListLibraryArticlesResponse, error)
 	GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error)
 	CreateLibrary(context.Context, *CreateLibraryRequest) (*CreateLibraryResponse, error)
 	UpdateLibrary(context.Context, *UpdateLibraryRequest) (*UpdateLibraryResponse, error)
 	DeleteLibrary(context.Context, *DeleteLibraryRequest) (*DeleteLibraryResponse, error)
+	UpdateLibraryArticle(context.Context, *UpdateLibraryArticleRequest) (*UpdateLibraryArticleResponse, error)
+	RemoveArticleFromLibrary(context.Context, *RemoveArticleFromLibraryRequest) (*RemoveArticleFromLibraryResponse, error)
+	RecordArticleOpened(context.Context, *RecordArticleOpenedRequest) (*RecordArticleOpenedResponse, error)
+	ListReadingActivity(context.Context, *ListReadingActivityRequest) (*ListReadingActivityResponse, error)
+	// ListFlaggedArticlesInMyLibraries lists saved articles that have been
+	// corrected, retracted or received an expression of concern.
+	ListFlaggedArticlesInMyLibraries(context.Context, *ListFlaggedArticlesInMyLibrariesRequest) (*ListFlaggedArticlesInMyLibrariesResponse, error)
+	// WatchLibrary streams changes to a library's articles as they happen.
+	// Reconnecting with the resume token of the last event received replays
+	// the events missed in between.
+	WatchLibrary(*WatchLibraryRequest, grpc.ServerStreamingServer[LibraryEvent]) error
 	mustEmbedUnimplementedLibraryServiceServer()
 }
 
@@ -130,9 +285,18 @@ type UnimplementedLibraryServiceServer struct{}
 func (UnimplementedLibraryServiceServer) SaveArticleToLibrary(context.Context, *SaveArticleToLibraryRequest) (*SaveArticleToLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveArticleToLibrary not implemented")
 }
+func (UnimplementedLibraryServiceServer) AddByIdentifier(context.Context, *AddByIdentifierRequest) (*AddByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddByIdentifier not implemented")
+}
 func (UnimplementedLibraryServiceServer) GetUserLibrary(context.Context, *GetUserLibraryRequest) (*GetUserLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserLibrary not implemented")
 }
+func (UnimplementedLibraryServiceServer) ListLibrarySummaries(context.Context, *ListLibrarySummariesRequest) (*ListLibrarySummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLibrarySummaries not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListLibraryArticles(context.Context, *ListLibraryArticlesRequest) (*ListLibraryArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLibraryArticles not implemented")
+}
 func (UnimplementedLibraryServiceServer) GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLibrary not implemented")
 }
@@ -145,6 +309,24 @@ func (UnimplementedLibraryServiceServer) UpdateLibrary(context.Context, *UpdateL
 func (UnimplementedLibraryServiceServer) DeleteLibrary(context.Context, *DeleteLibraryRequest) (*DeleteLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLibrary not implemented")
 }
+func (UnimplementedLibraryServiceServer) UpdateLibraryArticle(context.Context, *UpdateLibraryArticleRequest) (*UpdateLibraryArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLibraryArticle not implemented")
+}
+func (UnimplementedLibraryServiceServer) RemoveArticleFromLibrary(context.Context, *RemoveArticleFromLibraryRequest) (*RemoveArticleFromLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveArticleFromLibrary not implemented")
+}
+func (UnimplementedLibraryServiceServer) RecordArticleOpened(context.Context, *RecordArticleOpenedRequest) (*RecordArticleOpenedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordArticleOpened not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListReadingActivity(context.Context, *ListReadingActivityRequest) (*ListReadingActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadingActivity not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListFlaggedArticlesInMyLibraries(context.Context, *ListFlaggedArticlesInMyLibrariesRequest) (*ListFlaggedArticlesInMyLibrariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedArticlesInMyLibraries not implemented")
+}
+func (UnimplementedLibraryServiceServer) WatchLibrary(*WatchLibraryRequest, grpc.ServerStreamingServer[LibraryEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLibrary not implemented")
+}
 func (UnimplementedLibraryServiceServer) mustEmbedUnimplementedLibraryServiceServer() {}
 func (UnimplementedLibraryServiceServer) testEmbeddedByValue()                        {}
 
@@ -184,6 +366,24 @@ func _LibraryService_SaveArticleToLibrary_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_AddByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).AddByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_AddByIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).AddByIdentifier(ctx, req.(*AddByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LibraryService_GetUserLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserLibraryRequest)
 	if err := dec(in); err != nil {
@@ -202,6 +402,42 @@ func _LibraryService_GetUserLibrary_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_ListLibrarySummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLibrarySummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListLibrarySummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListLibrarySummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListLibrarySummaries(ctx, req.(*ListLibrarySummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListLibraryArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLibraryArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListLibraryArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListLibraryArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListLibraryArticles(ctx, req.(*ListLibraryArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LibraryService_GetLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLibraryRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +510,107 @@ func _LibraryService_DeleteLibrary_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_UpdateLibraryArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLibraryArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).UpdateLibraryArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_UpdateLibraryArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).UpdateLibraryArticle(ctx, req.(*UpdateLibraryArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_RemoveArticleFromLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveArticleFromLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).RemoveArticleFromLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_RemoveArticleFromLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).RemoveArticleFromLibrary(ctx, req.(*RemoveArticleFromLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_RecordArticleOpened_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordArticleOpenedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).RecordArticleOpened(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_RecordArticleOpened_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).RecordArticleOpened(ctx, req.(*RecordArticleOpenedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListReadingActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadingActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListReadingActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListReadingActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListReadingActivity(ctx, req.(*ListReadingActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListFlaggedArticlesInMyLibraries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedArticlesInMyLibrariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListFlaggedArticlesInMyLibraries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListFlaggedArticlesInMyLibraries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListFlaggedArticlesInMyLibraries(ctx, req.(*ListFlaggedArticlesInMyLibrariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_WatchLibrary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLibraryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LibraryServiceServer).WatchLibrary(m, &grpc.GenericServerStream[WatchLibraryRequest, LibraryEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LibraryService_WatchLibraryServer = grpc.ServerStreamingServer[LibraryEvent]
+
 // LibraryService_ServiceDesc is the grpc.ServiceDesc for LibraryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,10 +622,22 @@ var LibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveArticleToLibrary",
 			Handler:    _LibraryService_SaveArticleToLibrary_Handler,
 		},
+		{
+			MethodName: "AddByIdentifier",
+			Handler:    _LibraryService_AddByIdentifier_Handler,
+		},
 		{
 			MethodName: "GetUserLibrary",
 			Handler:    _LibraryService_GetUserLibrary_Handler,
 		},
+		{
+			MethodName: "ListLibrarySummaries",
+			Handler:    _LibraryService_ListLibrarySummaries_Handler,
+		},
+		{
+			MethodName: "ListLibraryArticles",
+			Handler:    _LibraryService_ListLibraryArticles_Handler,
+		},
 		{
 			MethodName: "GetLibrary",
 			Handler:    _LibraryService_GetLibrary_Handler,
@@ -305,7 +654,33 @@ var LibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLibrary",
 			Handler:    _LibraryService_DeleteLibrary_Handler,
 		},
+		{
+			MethodName: "UpdateLibraryArticle",
+			Handler:    _LibraryService_UpdateLibraryArticle_Handler,
+		},
+		{
+			MethodName: "RemoveArticleFromLibrary",
+			Handler:    _LibraryService_RemoveArticleFromLibrary_Handler,
+		},
+		{
+			MethodName: "RecordArticleOpened",
+			Handler:    _LibraryService_RecordArticleOpened_Handler,
+		},
+		{
+			MethodName: "ListReadingActivity",
+			Handler:    _LibraryService_ListReadingActivity_Handler,
+		},
+		{
+			MethodName: "ListFlaggedArticlesInMyLibraries",
+			Handler:    _LibraryService_ListFlaggedArticlesInMyLibraries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLibrary",
+			Handler:       _LibraryService_WatchLibrary_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/library/v1/library.proto",
 }
@@ -0,0 +1,8 @@
+// Package openapi holds the OpenAPI document of the HTTP/JSON gateway,
+// generated from the google.api.http annotations in api/.
+package openapi
+
+import _ "embed"
+
+//go:embed journalful.swagger.json
+var Document []byte
@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.9
 // 	protoc        v6.32.0
 // source: profile/v1/author.proto
 
 package profile
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -630,7 +632,7 @@ var File_profile_v1_author_proto protoreflect.FileDescriptor
 
 const file_profile_v1_author_proto_rawDesc = "" +
 	"\n" +
-	"\x17profile/v1/author.proto\x12\x0eapi.profile.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x01\n" +
+	"\x17profile/v1/author.proto\x12\x0eapi.profile.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x01\n" +
 	"\x06Author\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -639,43 +641,43 @@ const file_profile_v1_author_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\"\n" +
-	"\x10GetAuthorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"+\n" +
+	"\x10GetAuthorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"C\n" +
 	"\x11GetAuthorResponse\x12.\n" +
-	"\x06author\x18\x01 \x01(\v2\x16.api.profile.v1.AuthorR\x06author\"<\n" +
-	"\x1bGetAuthorByProfileIDRequest\x12\x1d\n" +
+	"\x06author\x18\x01 \x01(\v2\x16.api.profile.v1.AuthorR\x06author\"E\n" +
+	"\x1bGetAuthorByProfileIDRequest\x12&\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\x03R\tprofileId\"N\n" +
+	"profile_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tprofileId\"N\n" +
 	"\x1cGetAuthorByProfileIDResponse\x12.\n" +
 	"\x06author\x18\x01 \x01(\v2\x16.api.profile.v1.AuthorR\x06author\"\x14\n" +
 	"\x12ListAuthorsRequest\"G\n" +
 	"\x13ListAuthorsResponse\x120\n" +
-	"\aauthors\x18\x01 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\"\\\n" +
-	"\x13CreateAuthorRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\aauthors\x18\x01 \x03(\v2\x16.api.profile.v1.AuthorR\aauthors\"p\n" +
+	"\x13CreateAuthorRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12+\n" +
 	"\n" +
-	"profile_id\x18\x02 \x01(\x03H\x00R\tprofileId\x88\x01\x01B\r\n" +
+	"profile_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tprofileId\x88\x01\x01B\r\n" +
 	"\v_profile_id\"&\n" +
 	"\x14CreateAuthorResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"l\n" +
-	"\x13UpdateAuthorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x89\x01\n" +
+	"\x13UpdateAuthorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12+\n" +
 	"\n" +
-	"profile_id\x18\x03 \x01(\x03H\x00R\tprofileId\x88\x01\x01B\r\n" +
+	"profile_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\tprofileId\x88\x01\x01B\r\n" +
 	"\v_profile_id\"\x16\n" +
-	"\x14UpdateAuthorResponse\"%\n" +
-	"\x13DeleteAuthorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x16\n" +
-	"\x14DeleteAuthorResponse2\xbd\x04\n" +
-	"\rAuthorService\x12P\n" +
-	"\tGetAuthor\x12 .api.profile.v1.GetAuthorRequest\x1a!.api.profile.v1.GetAuthorResponse\x12q\n" +
-	"\x14GetAuthorByProfileID\x12+.api.profile.v1.GetAuthorByProfileIDRequest\x1a,.api.profile.v1.GetAuthorByProfileIDResponse\x12V\n" +
-	"\vListAuthors\x12\".api.profile.v1.ListAuthorsRequest\x1a#.api.profile.v1.ListAuthorsResponse\x12Y\n" +
-	"\fCreateAuthor\x12#.api.profile.v1.CreateAuthorRequest\x1a$.api.profile.v1.CreateAuthorResponse\x12Y\n" +
-	"\fUpdateAuthor\x12#.api.profile.v1.UpdateAuthorRequest\x1a$.api.profile.v1.UpdateAuthorResponse\x12Y\n" +
-	"\fDeleteAuthor\x12#.api.profile.v1.DeleteAuthorRequest\x1a$.api.profile.v1.DeleteAuthorResponseB9Z7github.com/chiquitav2/journalful/pkg/profile/v1;profileb\x06proto3"
+	"\x14UpdateAuthorResponse\".\n" +
+	"\x13DeleteAuthorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x16\n" +
+	"\x14DeleteAuthorResponse2\xe6\x05\n" +
+	"\rAuthorService\x12j\n" +
+	"\tGetAuthor\x12 .api.profile.v1.GetAuthorRequest\x1a!.api.profile.v1.GetAuthorResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/authors/{id}\x12\x9b\x01\n" +
+	"\x14GetAuthorByProfileID\x12+.api.profile.v1.GetAuthorByProfileIDRequest\x1a,.api.profile.v1.GetAuthorByProfileIDResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/profiles/{profile_id}/author\x12k\n" +
+	"\vListAuthors\x12\".api.profile.v1.ListAuthorsRequest\x1a#.api.profile.v1.ListAuthorsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/authors\x12q\n" +
+	"\fCreateAuthor\x12#.api.profile.v1.CreateAuthorRequest\x1a$.api.profile.v1.CreateAuthorResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/authors\x12v\n" +
+	"\fUpdateAuthor\x12#.api.profile.v1.UpdateAuthorRequest\x1a$.api.profile.v1.UpdateAuthorResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/authors/{id}\x12s\n" +
+	"\fDeleteAuthor\x12#.api.profile.v1.DeleteAuthorRequest\x1a$.api.profile.v1.DeleteAuthorResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/authors/{id}B9Z7github.com/chiquitav2/journalful/pkg/profile/v1;profileb\x06proto3"
 
 var (
 	file_profile_v1_author_proto_rawDescOnce sync.Once
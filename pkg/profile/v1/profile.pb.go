@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.9
 // 	protoc        v6.32.0
 // source: profile/v1/profile.proto
 
 package profile
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -558,7 +560,7 @@ var File_profile_v1_profile_proto protoreflect.FileDescriptor
 
 const file_profile_v1_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x18profile/v1/profile.proto\x12\x0eapi.profile.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x01\n" +
+	"\x18profile/v1/profile.proto\x12\x0eapi.profile.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x01\n" +
 	"\aProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -573,33 +575,33 @@ const file_profile_v1_profile_proto_rawDesc = "" +
 	"\aprofile\x18\x01 \x01(\v2\x17.api.profile.v1.ProfileR\aprofile\"\x15\n" +
 	"\x13ListProfilesRequest\"K\n" +
 	"\x14ListProfilesResponse\x123\n" +
-	"\bprofiles\x18\x01 \x03(\v2\x17.api.profile.v1.ProfileR\bprofiles\"\x80\x01\n" +
-	"\x14CreateProfileRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
-	"\x03bio\x18\x02 \x01(\tH\x00R\x03bio\x88\x01\x01\x12%\n" +
-	"\vinstitution\x18\x03 \x01(\tH\x01R\vinstitution\x88\x01\x01B\x06\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x17.api.profile.v1.ProfileR\bprofiles\"\x9f\x01\n" +
+	"\x14CreateProfileRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12 \n" +
+	"\x03bio\x18\x02 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x00R\x03bio\x88\x01\x01\x12.\n" +
+	"\vinstitution\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dH\x01R\vinstitution\x88\x01\x01B\x06\n" +
 	"\x04_bioB\x0e\n" +
 	"\f_institution\"'\n" +
 	"\x15CreateProfileResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x90\x01\n" +
-	"\x14UpdateProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x15\n" +
-	"\x03bio\x18\x03 \x01(\tH\x00R\x03bio\x88\x01\x01\x12%\n" +
-	"\vinstitution\x18\x04 \x01(\tH\x01R\vinstitution\x88\x01\x01B\x06\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb8\x01\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12 \n" +
+	"\x03bio\x18\x03 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x00R\x03bio\x88\x01\x01\x12.\n" +
+	"\vinstitution\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dH\x01R\vinstitution\x88\x01\x01B\x06\n" +
 	"\x04_bioB\x0e\n" +
 	"\f_institution\"\x17\n" +
-	"\x15UpdateProfileResponse\"&\n" +
-	"\x14DeleteProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
-	"\x15DeleteProfileResponse2\xda\x03\n" +
-	"\x0eProfileService\x12S\n" +
+	"\x15UpdateProfileResponse\"/\n" +
+	"\x14DeleteProfileRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x17\n" +
+	"\x15DeleteProfileResponse2\xd7\x04\n" +
+	"\x0eProfileService\x12h\n" +
 	"\n" +
-	"GetProfile\x12!.api.profile.v1.GetProfileRequest\x1a\".api.profile.v1.GetProfileResponse\x12Y\n" +
-	"\fListProfiles\x12#.api.profile.v1.ListProfilesRequest\x1a$.api.profile.v1.ListProfilesResponse\x12\\\n" +
-	"\rCreateProfile\x12$.api.profile.v1.CreateProfileRequest\x1a%.api.profile.v1.CreateProfileResponse\x12\\\n" +
-	"\rUpdateProfile\x12$.api.profile.v1.UpdateProfileRequest\x1a%.api.profile.v1.UpdateProfileResponse\x12\\\n" +
-	"\rDeleteProfile\x12$.api.profile.v1.DeleteProfileRequest\x1a%.api.profile.v1.DeleteProfileResponseB9Z7github.com/chiquitav2/journalful/pkg/profile/v1;profileb\x06proto3"
+	"GetProfile\x12!.api.profile.v1.GetProfileRequest\x1a\".api.profile.v1.GetProfileResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/profile\x12o\n" +
+	"\fListProfiles\x12#.api.profile.v1.ListProfilesRequest\x1a$.api.profile.v1.ListProfilesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/profiles\x12u\n" +
+	"\rCreateProfile\x12$.api.profile.v1.CreateProfileRequest\x1a%.api.profile.v1.CreateProfileResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/profiles\x12z\n" +
+	"\rUpdateProfile\x12$.api.profile.v1.UpdateProfileRequest\x1a%.api.profile.v1.UpdateProfileResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/profiles/{id}\x12w\n" +
+	"\rDeleteProfile\x12$.api.profile.v1.DeleteProfileRequest\x1a%.api.profile.v1.DeleteProfileResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/profiles/{id}B9Z7github.com/chiquitav2/journalful/pkg/profile/v1;profileb\x06proto3"
 
 var (
 	file_profile_v1_profile_proto_rawDescOnce sync.Once
@@ -0,0 +1,479 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: recommendation/v1/recommendation.proto
+
+package recommendation
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/chiquitav2/journalful/pkg/articles/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecommendationReason int32
+
+const (
+	RecommendationReason_RECOMMENDATION_REASON_UNSPECIFIED      RecommendationReason = 0
+	RecommendationReason_RECOMMENDATION_REASON_SHARED_AUTHORS   RecommendationReason = 1
+	RecommendationReason_RECOMMENDATION_REASON_SHARED_TAGS      RecommendationReason = 2
+	RecommendationReason_RECOMMENDATION_REASON_CO_CITATION      RecommendationReason = 3
+	RecommendationReason_RECOMMENDATION_REASON_CO_OCCURRENCE    RecommendationReason = 4
+	RecommendationReason_RECOMMENDATION_REASON_SIMILAR_ABSTRACT RecommendationReason = 5
+)
+
+// Enum value maps for RecommendationReason.
+var (
+	RecommendationReason_name = map[int32]string{
+		0: "RECOMMENDATION_REASON_UNSPECIFIED",
+		1: "RECOMMENDATION_REASON_SHARED_AUTHORS",
+		2: "RECOMMENDATION_REASON_SHARED_TAGS",
+		3: "RECOMMENDATION_REASON_CO_CITATION",
+		4: "RECOMMENDATION_REASON_CO_OCCURRENCE",
+		5: "RECOMMENDATION_REASON_SIMILAR_ABSTRACT",
+	}
+	RecommendationReason_value = map[string]int32{
+		"RECOMMENDATION_REASON_UNSPECIFIED":      0,
+		"RECOMMENDATION_REASON_SHARED_AUTHORS":   1,
+		"RECOMMENDATION_REASON_SHARED_TAGS":      2,
+		"RECOMMENDATION_REASON_CO_CITATION":      3,
+		"RECOMMENDATION_REASON_CO_OCCURRENCE":    4,
+		"RECOMMENDATION_REASON_SIMILAR_ABSTRACT": 5,
+	}
+)
+
+func (x RecommendationReason) Enum() *RecommendationReason {
+	p := new(RecommendationReason)
+	*p = x
+	return p
+}
+
+func (x RecommendationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_recommendation_v1_recommendation_proto_enumTypes[0].Descriptor()
+}
+
+func (RecommendationReason) Type() protoreflect.EnumType {
+	return &file_recommendation_v1_recommendation_proto_enumTypes[0]
+}
+
+func (x RecommendationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationReason.Descriptor instead.
+func (RecommendationReason) EnumDescriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+type Recommendation struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Article             *v1.Article            `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Score               float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons             []RecommendationReason `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=api.recommendation.v1.RecommendationReason" json:"reasons,omitempty"`        // Strongest first
+	Explanation         string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`                                                        // e.g. "Shares 2 authors and has a similar abstract"
+	BecauseOfArticleIds []int64                `protobuf:"varint,5,rep,packed,name=because_of_article_ids,json=becauseOfArticleIds,proto3" json:"because_of_article_ids,omitempty"` // Library articles that led to this suggestion
+	ComputedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recommendation) GetArticle() *v1.Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *Recommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Recommendation) GetReasons() []RecommendationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *Recommendation) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *Recommendation) GetBecauseOfArticleIds() []int64 {
+	if x != nil {
+		return x.BecauseOfArticleIds
+	}
+	return nil
+}
+
+func (x *Recommendation) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetSimilarArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExcludeSaved  bool                   `protobuf:"varint,3,opt,name=exclude_saved,json=excludeSaved,proto3" json:"exclude_saved,omitempty"` // Leave out articles already in the user's libraries
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`             // 0 for the default of 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarArticlesRequest) Reset() {
+	*x = GetSimilarArticlesRequest{}
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarArticlesRequest) ProtoMessage() {}
+
+func (x *GetSimilarArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSimilarArticlesRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *GetSimilarArticlesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSimilarArticlesRequest) GetExcludeSaved() bool {
+	if x != nil {
+		return x.ExcludeSaved
+	}
+	return false
+}
+
+func (x *GetSimilarArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSimilarArticlesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSimilarArticlesResponse) Reset() {
+	*x = GetSimilarArticlesResponse{}
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarArticlesResponse) ProtoMessage() {}
+
+func (x *GetSimilarArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSimilarArticlesResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type GetReadingRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 for the default of 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingRecommendationsRequest) Reset() {
+	*x = GetReadingRecommendationsRequest{}
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetReadingRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReadingRecommendationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReadingRecommendationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetReadingRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"` // Never includes articles already saved
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReadingRecommendationsResponse) Reset() {
+	*x = GetReadingRecommendationsResponse{}
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetReadingRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_v1_recommendation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_v1_recommendation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetReadingRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+var File_recommendation_v1_recommendation_proto protoreflect.FileDescriptor
+
+const file_recommendation_v1_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"&recommendation/v1/recommendation.proto\x12\x15api.recommendation.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19articles/v1/article.proto\"\xb5\x02\n" +
+	"\x0eRecommendation\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.api.articles.v1.ArticleR\aarticle\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12E\n" +
+	"\areasons\x18\x03 \x03(\x0e2+.api.recommendation.v1.RecommendationReasonR\areasons\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x123\n" +
+	"\x16because_of_article_ids\x18\x05 \x03(\x03R\x13becauseOfArticleIds\x12;\n" +
+	"\vcomputed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\xb6\x02\n" +
+	"\x19GetSimilarArticlesRequest\x12&\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tarticleId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06userId\x12#\n" +
+	"\rexclude_saved\x18\x03 \x01(\bR\fexcludeSaved\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xbaH\x06\x1a\x04\x182(\x00R\bpageSize:\x81\x01\xbaH~\x1a|\n" +
+	"\"get_similar_articles.exclude_saved\x12-user_id is required to exclude saved articles\x1a'!this.exclude_saved || this.user_id > 0\"m\n" +
+	"\x1aGetSimilarArticlesResponse\x12O\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2%.api.recommendation.v1.RecommendationR\x0frecommendations\"l\n" +
+	" GetReadingRecommendationsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x182(\x00R\bpageSize\"t\n" +
+	"!GetReadingRecommendationsResponse\x12O\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2%.api.recommendation.v1.RecommendationR\x0frecommendations*\x8a\x02\n" +
+	"\x14RecommendationReason\x12%\n" +
+	"!RECOMMENDATION_REASON_UNSPECIFIED\x10\x00\x12(\n" +
+	"$RECOMMENDATION_REASON_SHARED_AUTHORS\x10\x01\x12%\n" +
+	"!RECOMMENDATION_REASON_SHARED_TAGS\x10\x02\x12%\n" +
+	"!RECOMMENDATION_REASON_CO_CITATION\x10\x03\x12'\n" +
+	"#RECOMMENDATION_REASON_CO_OCCURRENCE\x10\x04\x12*\n" +
+	"&RECOMMENDATION_REASON_SIMILAR_ABSTRACT\x10\x052\xa3\x02\n" +
+	"\x15RecommendationService\x12y\n" +
+	"\x12GetSimilarArticles\x120.api.recommendation.v1.GetSimilarArticlesRequest\x1a1.api.recommendation.v1.GetSimilarArticlesResponse\x12\x8e\x01\n" +
+	"\x19GetReadingRecommendations\x127.api.recommendation.v1.GetReadingRecommendationsRequest\x1a8.api.recommendation.v1.GetReadingRecommendationsResponseBGZEgithub.com/chiquitav2/journalful/pkg/recommendation/v1;recommendationb\x06proto3"
+
+var (
+	file_recommendation_v1_recommendation_proto_rawDescOnce sync.Once
+	file_recommendation_v1_recommendation_proto_rawDescData []byte
+)
+
+func file_recommendation_v1_recommendation_proto_rawDescGZIP() []byte {
+	file_recommendation_v1_recommendation_proto_rawDescOnce.Do(func() {
+		file_recommendation_v1_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_recommendation_v1_recommendation_proto_rawDesc), len(file_recommendation_v1_recommendation_proto_rawDesc)))
+	})
+	return file_recommendation_v1_recommendation_proto_rawDescData
+}
+
+var file_recommendation_v1_recommendation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_recommendation_v1_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_recommendation_v1_recommendation_proto_goTypes = []any{
+	(RecommendationReason)(0),                 // 0: api.recommendation.v1.RecommendationReason
+	(*Recommendation)(nil),                    // 1: api.recommendation.v1.Recommendation
+	(*GetSimilarArticlesRequest)(nil),         // 2: api.recommendation.v1.GetSimilarArticlesRequest
+	(*GetSimilarArticlesResponse)(nil),        // 3: api.recommendation.v1.GetSimilarArticlesResponse
+	(*GetReadingRecommendationsRequest)(nil),  // 4: api.recommendation.v1.GetReadingRecommendationsRequest
+	(*GetReadingRecommendationsResponse)(nil), // 5: api.recommendation.v1.GetReadingRecommendationsResponse
+	(*v1.Article)(nil),                        // 6: api.articles.v1.Article
+	(*timestamppb.Timestamp)(nil),             // 7: google.protobuf.Timestamp
+}
+var file_recommendation_v1_recommendation_proto_depIdxs = []int32{
+	6, // 0: api.recommendation.v1.Recommendation.article:type_name -> api.articles.v1.Article
+	0, // 1: api.recommendation.v1.Recommendation.reasons:type_name -> api.recommendation.v1.RecommendationReason
+	7, // 2: api.recommendation.v1.Recommendation.computed_at:type_name -> google.protobuf.Timestamp
+	1, // 3: api.recommendation.v1.GetSimilarArticlesResponse.recommendations:type_name -> api.recommendation.v1.Recommendation
+	1, // 4: api.recommendation.v1.GetReadingRecommendationsResponse.recommendations:type_name -> api.recommendation.v1.Recommendation
+	2, // 5: api.recommendation.v1.RecommendationService.GetSimilarArticles:input_type -> api.recommendation.v1.GetSimilarArticlesRequest
+	4, // 6: api.recommendation.v1.RecommendationService.GetReadingRecommendations:input_type -> api.recommendation.v1.GetReadingRecommendationsRequest
+	3, // 7: api.recommendation.v1.RecommendationService.GetSimilarArticles:output_type -> api.recommendation.v1.GetSimilarArticlesResponse
+	5, // 8: api.recommendation.v1.RecommendationService.GetReadingRecommendations:output_type -> api.recommendation.v1.GetReadingRecommendationsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_recommendation_v1_recommendation_proto_init() }
+func file_recommendation_v1_recommendation_proto_init() {
+	if File_recommendation_v1_recommendation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recommendation_v1_recommendation_proto_rawDesc), len(file_recommendation_v1_recommendation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_recommendation_v1_recommendation_proto_goTypes,
+		DependencyIndexes: file_recommendation_v1_recommendation_proto_depIdxs,
+		EnumInfos:         file_recommendation_v1_recommendation_proto_enumTypes,
+		MessageInfos:      file_recommendation_v1_recommendation_proto_msgTypes,
+	}.Build()
+	File_recommendation_v1_recommendation_proto = out.File
+	file_recommendation_v1_recommendation_proto_goTypes = nil
+	file_recommendation_v1_recommendation_proto_depIdxs = nil
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: recommendation/v1/recommendation.proto
+
+package recommendation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecommendationService_GetSimilarArticles_FullMethodName        = "/api.recommendation.v1.RecommendationService/GetSimilarArticles"
+	RecommendationService_GetReadingRecommendations_FullMethodName = "/api.recommendation.v1.RecommendationService/GetReadingRecommendations"
+)
+
+// RecommendationServiceClient is the client API for RecommendationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RecommendationService suggests related articles. Suggestions are computed
+// offline from shared authors and tags, co-citation, co-occurrence in public
+// libraries and abstract similarity, so new articles show up after the next
+// recomputation.
+type RecommendationServiceClient interface {
+	// GetSimilarArticles returns "papers like this one".
+	GetSimilarArticles(ctx context.Context, in *GetSimilarArticlesRequest, opts ...grpc.CallOption) (*GetSimilarArticlesResponse, error)
+	// GetReadingRecommendations returns "you might want to read next" based on
+	// everything in the user's libraries.
+	GetReadingRecommendations(ctx context.Context, in *GetReadingRecommendationsRequest, opts ...grpc.CallOption) (*GetReadingRecommendationsResponse, error)
+}
+
+type recommendationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecommendationServiceClient(cc grpc.ClientConnInterface) RecommendationServiceClient {
+	return &recommendationServiceClient{cc}
+}
+
+func (c *recommendationServiceClient) GetSimilarArticles(ctx context.Context, in *GetSimilarArticlesRequest, opts ...grpc.CallOption) (*GetSimilarArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarArticlesResponse)
+	err := c.cc.Invoke(ctx, RecommendationService_GetSimilarArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recommendationServiceClient) GetReadingRecommendations(ctx context.Context, in *GetReadingRecommendationsRequest, opts ...grpc.CallOption) (*GetReadingRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadingRecommendationsResponse)
+	err := c.cc.Invoke(ctx, RecommendationService_GetReadingRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecommendationServiceServer is the server API for RecommendationService service.
+// All implementations must embed UnimplementedRecommendationServiceServer
+// for forward compatibility.
+//
+// RecommendationService suggests related articles. Suggestions are computed
+// offline from shared authors and tags, co-citation, co-occurrence in public
+// libraries and abstract similarity, so new articles show up after the next
+// recomputation.
+type RecommendationServiceServer interface {
+	// GetSimilarArticles returns "papers like this one".
+	GetSimilarArticles(context.Context, *GetSimilarArticlesRequest) (*GetSimilarArticlesResponse, error)
+	// GetReadingRecommendations returns "you might want to read next" based on
+	// everything in the user's libraries.
+	GetReadingRecommendations(context.Context, *GetReadingRecommendationsRequest) (*GetReadingRecommendationsResponse, error)
+	mustEmbedUnimplementedRecommendationServiceServer()
+}
+
+// UnimplementedRecommendationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecommendationServiceServer struct{}
+
+func (UnimplementedRecommendationServiceServer) GetSimilarArticles(context.Context, *GetSimilarArticlesRequest) (*GetSimilarArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarArticles not implemented")
+}
+func (UnimplementedRecommendationServiceServer) GetReadingRecommendations(context.Context, *GetReadingRecommendationsRequest) (*GetReadingRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingRecommendations not implemented")
+}
+func (UnimplementedRecommendationServiceServer) mustEmbedUnimplementedRecommendationServiceServer() {}
+func (UnimplementedRecommendationServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeRecommendationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecommendationServiceServer will
+// result in compilation errors.
+type UnsafeRecommendationServiceServer interface {
+	mustEmbedUnimplementedRecommendationServiceServer()
+}
+
+func RegisterRecommendationServiceServer(s grpc.ServiceRegistrar, srv RecommendationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecommendationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecommendationService_ServiceDesc, srv)
+}
+
+func _RecommendationService_GetSimilarArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).GetSimilarArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecommendationService_GetSimilarArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).GetSimilarArticles(ctx, req.(*GetSimilarArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecommendationService_GetReadingRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).GetReadingRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecommendationService_GetReadingRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).GetReadingRecommendations(ctx, req.(*GetReadingRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecommendationService_ServiceDesc is the grpc.ServiceDesc for RecommendationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecommendationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.recommendation.v1.RecommendationService",
+	HandlerType: (*RecommendationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSimilarArticles",
+			Handler:    _RecommendationService_GetSimilarArticles_Handler,
+		},
+		{
+			MethodName: "GetReadingRecommendations",
+			Handler:    _RecommendationService_GetReadingRecommendations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "recommendation/v1/recommendation.proto",
+}
@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: review/v1/review.proto
+
+package review
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewSchedule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	EaseFactor       float64                `protobuf:"fixed64,2,opt,name=ease_factor,json=easeFactor,proto3" json:"ease_factor,omitempty"`
+	IntervalDays     int32                  `protobuf:"varint,3,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	Repetitions      int32                  `protobuf:"varint,4,opt,name=repetitions,proto3" json:"repetitions,omitempty"` // Successful reviews in a row
+	DueDate          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	LastReviewedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
+	LastRating       *int32                 `protobuf:"varint,7,opt,name=last_rating,json=lastRating,proto3,oneof" json:"last_rating,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewSchedule) Reset() {
+	*x = ReviewSchedule{}
+	mi := &file_review_v1_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSchedule) ProtoMessage() {}
+
+func (x *ReviewSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSchedule.ProtoReflect.Descriptor instead.
+func (*ReviewSchedule) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewSchedule) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *ReviewSchedule) GetEaseFactor() float64 {
+	if x != nil {
+		return x.EaseFactor
+	}
+	return 0
+}
+
+func (x *ReviewSchedule) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *ReviewSchedule) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *ReviewSchedule) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *ReviewSchedule) GetLastReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return nil
+}
+
+func (x *ReviewSchedule) GetLastRating() int32 {
+	if x != nil && x.LastRating != nil {
+		return *x.LastRating
+	}
+	return 0
+}
+
+type ReviewItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReviewSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	LibraryId     int64                  `protobuf:"varint,2,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ArticleTitle  string                 `protobuf:"bytes,4,opt,name=article_title,json=articleTitle,proto3" json:"article_title,omitempty"`
+	Doi           string                 `protobuf:"bytes,5,opt,name=doi,proto3" json:"doi,omitempty"`
+	Notes         *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewItem) Reset() {
+	*x = ReviewItem{}
+	mi := &file_review_v1_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewItem) ProtoMessage() {}
+
+func (x *ReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewItem.ProtoReflect.Descriptor instead.
+func (*ReviewItem) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewItem) GetSchedule() *ReviewSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ReviewItem) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetArticleTitle() string {
+	if x != nil {
+		return x.ArticleTitle
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type ScheduleReviewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone name used to pick the first due date. Defaults to UTC
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleReviewRequest) Reset() {
+	*x = ScheduleReviewRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReviewRequest) ProtoMessage() {}
+
+func (x *ScheduleReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReviewRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleReviewRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *ScheduleReviewRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ScheduleReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReviewSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleReviewResponse) Reset() {
+	*x = ScheduleReviewResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReviewResponse) ProtoMessage() {}
+
+func (x *ScheduleReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReviewResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduleReviewResponse) GetSchedule() *ReviewSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type CancelReviewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CancelReviewRequest) Reset() {
+	*x = CancelReviewRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReviewRequest) ProtoMessage() {}
+
+func (x *CancelReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReviewRequest.ProtoReflect.Descriptor instead.
+func (*CancelReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelReviewRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+type CancelReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReviewResponse) Reset() {
+	*x = CancelReviewResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReviewResponse) ProtoMessage() {}
+
+func (x *CancelReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReviewResponse.ProtoReflect.Descriptor instead.
+func (*CancelReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetReviewQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone name deciding what is due today. Defaults to UTC
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // 0 for the default of 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewQueueRequest) Reset() {
+	*x = GetReviewQueueRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewQueueRequest) ProtoMessage() {}
+
+func (x *GetReviewQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewQueueRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReviewQueueRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReviewQueueRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetReviewQueueRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetReviewQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReviewItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Most overdue first
+	TotalDue      int64                  `protobuf:"varint,2,opt,name=total_due,json=totalDue,proto3" json:"total_due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewQueueResponse) Reset() {
+	*x = GetReviewQueueResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewQueueResponse) ProtoMessage() {}
+
+func (x *GetReviewQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewQueueResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReviewQueueResponse) GetItems() []*ReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetReviewQueueResponse) GetTotalDue() int64 {
+	if x != nil {
+		return x.TotalDue
+	}
+	return 0
+}
+
+type RecordReviewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LibraryArticleId int64                  `protobuf:"varint,1,opt,name=library_article_id,json=libraryArticleId,proto3" json:"library_article_id,omitempty"`
+	// Recall quality from 0 (complete blackout) to 5 (perfect recall).
+	// Ratings below 3 restart the schedule.
+	Rating        int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReviewRequest) Reset() {
+	*x = RecordReviewRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReviewRequest) ProtoMessage() {}
+
+func (x *RecordReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReviewRequest.ProtoReflect.Descriptor instead.
+func (*RecordReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordReviewRequest) GetLibraryArticleId() int64 {
+	if x != nil {
+		return x.LibraryArticleId
+	}
+	return 0
+}
+
+func (x *RecordReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RecordReviewRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type RecordReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReviewSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReviewResponse) Reset() {
+	*x = RecordReviewResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReviewResponse) ProtoMessage() {}
+
+func (x *RecordReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReviewResponse.ProtoReflect.Descriptor instead.
+func (*RecordReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordReviewResponse) GetSchedule() *ReviewSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+var File_review_v1_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_review_proto_rawDesc = "" +
+	"\n" +
+	"\x16review/v1/review.proto\x12\rapi.review.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd9\x02\n" +
+	"\x0eReviewSchedule\x12,\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03R\x10libraryArticleId\x12\x1f\n" +
+	"\vease_factor\x18\x02 \x01(\x01R\n" +
+	"easeFactor\x12#\n" +
+	"\rinterval_days\x18\x03 \x01(\x05R\fintervalDays\x12 \n" +
+	"\vrepetitions\x18\x04 \x01(\x05R\vrepetitions\x125\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12D\n" +
+	"\x10last_reviewed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReviewedAt\x12$\n" +
+	"\vlast_rating\x18\a \x01(\x05H\x00R\n" +
+	"lastRating\x88\x01\x01B\x0e\n" +
+	"\f_last_rating\"\xe1\x01\n" +
+	"\n" +
+	"ReviewItem\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.api.review.v1.ReviewScheduleR\bschedule\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x02 \x01(\x03R\tlibraryId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x03R\tarticleId\x12#\n" +
+	"\rarticle_title\x18\x04 \x01(\tR\farticleTitle\x12\x10\n" +
+	"\x03doi\x18\x05 \x01(\tR\x03doi\x12\x19\n" +
+	"\x05notes\x18\x06 \x01(\tH\x00R\x05notes\x88\x01\x01B\b\n" +
+	"\x06_notes\"k\n" +
+	"\x15ScheduleReviewRequest\x125\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10libraryArticleId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"S\n" +
+	"\x16ScheduleReviewResponse\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.api.review.v1.ReviewScheduleR\bschedule\"L\n" +
+	"\x13CancelReviewRequest\x125\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10libraryArticleId\"0\n" +
+	"\x14CancelReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"w\n" +
+	"\x15GetReviewQueueRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\"f\n" +
+	"\x16GetReviewQueueResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.api.review.v1.ReviewItemR\x05items\x12\x1b\n" +
+	"\ttotal_due\x18\x02 \x01(\x03R\btotalDue\"\x8c\x01\n" +
+	"\x13RecordReviewRequest\x125\n" +
+	"\x12library_article_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10libraryArticleId\x12!\n" +
+	"\x06rating\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x05(\x00R\x06rating\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"Q\n" +
+	"\x14RecordReviewResponse\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.api.review.v1.ReviewScheduleR\bschedule2\xff\x02\n" +
+	"\rReviewService\x12]\n" +
+	"\x0eScheduleReview\x12$.api.review.v1.ScheduleReviewRequest\x1a%.api.review.v1.ScheduleReviewResponse\x12W\n" +
+	"\fCancelReview\x12\".api.review.v1.CancelReviewRequest\x1a#.api.review.v1.CancelReviewResponse\x12]\n" +
+	"\x0eGetReviewQueue\x12$.api.review.v1.GetReviewQueueRequest\x1a%.api.review.v1.GetReviewQueueResponse\x12W\n" +
+	"\fRecordReview\x12\".api.review.v1.RecordReviewRequest\x1a#.api.review.v1.RecordReviewResponseB7Z5github.com/chiquitav2/journalful/pkg/review/v1;reviewb\x06proto3"
+
+var (
+	file_review_v1_review_proto_rawDescOnce sync.Once
+	file_review_v1_review_proto_rawDescData []byte
+)
+
+func file_review_v1_review_proto_rawDescGZIP() []byte {
+	file_review_v1_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_review_proto_rawDesc), len(file_review_v1_review_proto_rawDesc)))
+	})
+	return file_review_v1_review_proto_rawDescData
+}
+
+var file_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_review_v1_review_proto_goTypes = []any{
+	(*ReviewSchedule)(nil),         // 0: api.review.v1.ReviewSchedule
+	(*ReviewItem)(nil),             // 1: api.review.v1.ReviewItem
+	(*ScheduleReviewRequest)(nil),  // 2: api.review.v1.ScheduleReviewRequest
+	(*ScheduleReviewResponse)(nil), // 3: api.review.v1.ScheduleReviewResponse
+	(*CancelReviewRequest)(nil),    // 4: api.review.v1.CancelReviewRequest
+	(*CancelReviewResponse)(nil),   // 5: api.review.v1.CancelReviewResponse
+	(*GetReviewQueueRequest)(nil),  // 6: api.review.v1.GetReviewQueueRequest
+	(*GetReviewQueueResponse)(nil), // 7: api.review.v1.GetReviewQueueResponse
+	(*RecordReviewRequest)(nil),    // 8: api.review.v1.RecordReviewRequest
+	(*RecordReviewResponse)(nil),   // 9: api.review.v1.RecordReviewResponse
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
+}
+var file_review_v1_review_proto_depIdxs = []int32{
+	10, // 0: api.review.v1.ReviewSchedule.due_date:type_name -> google.protobuf.Timestamp
+	10, // 1: api.review.v1.ReviewSchedule.last_reviewed_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: api.review.v1.ReviewItem.schedule:type_name -> api.review.v1.ReviewSchedule
+	0,  // 3: api.review.v1.ScheduleReviewResponse.schedule:type_name -> api.review.v1.ReviewSchedule
+	1,  // 4: api.review.v1.GetReviewQueueResponse.items:type_name -> api.review.v1.ReviewItem
+	0,  // 5: api.review.v1.RecordReviewResponse.schedule:type_name -> api.review.v1.ReviewSchedule
+	2,  // 6: api.review.v1.ReviewService.ScheduleReview:input_type -> api.review.v1.ScheduleReviewRequest
+	4,  // 7: api.review.v1.ReviewService.CancelReview:input_type -> api.review.v1.CancelReviewRequest
+	6,  // 8: api.review.v1.ReviewService.GetReviewQueue:input_type -> api.review.v1.GetReviewQueueRequest
+	8,  // 9: api.review.v1.ReviewService.RecordReview:input_type -> api.review.v1.RecordReviewRequest
+	3,  // 10: api.review.v1.ReviewService.ScheduleReview:output_type -> api.review.v1.ScheduleReviewResponse
+	5,  // 11: api.review.v1.ReviewService.CancelReview:output_type -> api.review.v1.CancelReviewResponse
+	7,  // 12: api.review.v1.ReviewService.GetReviewQueue:output_type -> api.review.v1.GetReviewQueueResponse
+	9,  // 13: api.review.v1.ReviewService.RecordReview:output_type -> api.review.v1.RecordReviewResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_review_proto_init() }
+func file_review_v1_review_proto_init() {
+	if File_review_v1_review_proto != nil {
+		return
+	}
+	file_review_v1_review_proto_msgTypes[0].OneofWrappers = []any{}
+	file_review_v1_review_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_review_proto_rawDesc), len(file_review_v1_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_review_v1_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_review_proto_depIdxs,
+		MessageInfos:      file_review_v1_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_review_proto = out.File
+	file_review_v1_review_proto_goTypes = nil
+	file_review_v1_review_proto_depIdxs = nil
+}
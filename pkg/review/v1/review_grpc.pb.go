@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: review/v1/review.proto
+
+package review
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewService_ScheduleReview_FullMethodName = "/api.review.v1.ReviewService/ScheduleReview"
+	ReviewService_CancelReview_FullMethodName   = "/api.review.v1.ReviewService/CancelReview"
+	ReviewService_GetReviewQueue_FullMethodName = "/api.review.v1.ReviewService/GetReviewQueue"
+	ReviewService_RecordReview_FullMethodName   = "/api.review.v1.ReviewService/RecordReview"
+)
+
+// ReviewServiceClient is the client API for ReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReviewService schedules read articles for spaced-repetition review using
+// the SM-2 algorithm.
+type ReviewServiceClient interface {
+	ScheduleReview(ctx context.Context, in *ScheduleReviewRequest, opts ...grpc.CallOption) (*ScheduleReviewResponse, error)
+	CancelReview(ctx context.Context, in *CancelReviewRequest, opts ...grpc.CallOption) (*CancelReviewResponse, error)
+	GetReviewQueue(ctx context.Context, in *GetReviewQueueRequest, opts ...grpc.CallOption) (*GetReviewQueueResponse, error)
+	RecordReview(ctx context.Context, in *RecordReviewRequest, opts ...grpc.CallOption) (*RecordReviewResponse, error)
+}
+
+type reviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewServiceClient(cc grpc.ClientConnInterface) ReviewServiceClient {
+	return &reviewServiceClient{cc}
+}
+
+func (c *reviewServiceClient) ScheduleReview(ctx context.Context, in *ScheduleReviewRequest, opts ...grpc.CallOption) (*ScheduleReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ScheduleReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) CancelReview(ctx context.Context, in *CancelReviewRequest, opts ...grpc.CallOption) (*CancelReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_CancelReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetReviewQueue(ctx context.Context, in *GetReviewQueueRequest, opts ...grpc.CallOption) (*GetReviewQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewQueueResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetReviewQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) RecordReview(ctx context.Context, in *RecordReviewRequest, opts ...grpc.CallOption) (*RecordReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_RecordReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewServiceServer is the server API for ReviewService service.
+// All implementations must embed UnimplementedReviewServiceServer
+// for forward compatibility.
+//
+// ReviewService schedules read articles for spaced-repetition review using
+// the SM-2 algorithm.
+type ReviewServiceServer interface {
+	ScheduleReview(context.Context, *ScheduleReviewRequest) (*ScheduleReviewResponse, error)
+	CancelReview(context.Context, *CancelReviewRequest) (*CancelReviewResponse, error)
+	GetReviewQueue(context.Context, *GetReviewQueueRequest) (*GetReviewQueueResponse, error)
+	RecordReview(context.Context, *RecordReviewRequest) (*RecordReviewResponse, error)
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+// UnimplementedReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewServiceServer struct{}
+
+func (UnimplementedReviewServiceServer) ScheduleReview(context.Context, *ScheduleReviewRequest) (*ScheduleReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleReview not implemented")
+}
+func (UnimplementedReviewServiceServer) CancelReview(context.Context, *CancelReviewRequest) (*CancelReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReview not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReviewQueue(context.Context, *GetReviewQueueRequest) (*GetReviewQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewQueue not implemented")
+}
+func (UnimplementedReviewServiceServer) RecordReview(context.Context, *RecordReviewRequest) (*RecordReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordReview not implemented")
+}
+func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
+func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewServiceServer will
+// result in compilation errors.
+type UnsafeReviewServiceServer interface {
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+func RegisterReviewServiceServer(s grpc.ServiceRegistrar, srv ReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewService_ServiceDesc, srv)
+}
+
+func _ReviewService_ScheduleReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ScheduleReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ScheduleReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ScheduleReview(ctx, req.(*ScheduleReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_CancelReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CancelReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_CancelReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CancelReview(ctx, req.(*CancelReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetReviewQueue(ctx, req.(*GetReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_RecordReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).RecordReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_RecordReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).RecordReview(ctx, req.(*RecordReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.review.v1.ReviewService",
+	HandlerType: (*ReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleReview",
+			Handler:    _ReviewService_ScheduleReview_Handler,
+		},
+		{
+			MethodName: "CancelReview",
+			Handler:    _ReviewService_CancelReview_Handler,
+		},
+		{
+			MethodName: "GetReviewQueue",
+			Handler:    _ReviewService_GetReviewQueue_Handler,
+		},
+		{
+			MethodName: "RecordReview",
+			Handler:    _ReviewService_RecordReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "review/v1/review.proto",
+}
@@ -0,0 +1,847 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: stats/v1/stats.proto
+
+package stats
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatsPeriod int32
+
+const (
+	StatsPeriod_STATS_PERIOD_UNSPECIFIED StatsPeriod = 0 // Defaults to month
+	StatsPeriod_STATS_PERIOD_WEEK        StatsPeriod = 1
+	StatsPeriod_STATS_PERIOD_MONTH       StatsPeriod = 2
+	StatsPeriod_STATS_PERIOD_YEAR        StatsPeriod = 3
+)
+
+// Enum value maps for StatsPeriod.
+var (
+	StatsPeriod_name = map[int32]string{
+		0: "STATS_PERIOD_UNSPECIFIED",
+		1: "STATS_PERIOD_WEEK",
+		2: "STATS_PERIOD_MONTH",
+		3: "STATS_PERIOD_YEAR",
+	}
+	StatsPeriod_value = map[string]int32{
+		"STATS_PERIOD_UNSPECIFIED": 0,
+		"STATS_PERIOD_WEEK":        1,
+		"STATS_PERIOD_MONTH":       2,
+		"STATS_PERIOD_YEAR":        3,
+	}
+)
+
+func (x StatsPeriod) Enum() *StatsPeriod {
+	p := new(StatsPeriod)
+	*p = x
+	return p
+}
+
+func (x StatsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_stats_v1_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsPeriod) Type() protoreflect.EnumType {
+	return &file_stats_v1_stats_proto_enumTypes[0]
+}
+
+func (x StatsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsPeriod.Descriptor instead.
+func (StatsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{0}
+}
+
+type ReadingTotals struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TotalArticles       int32                  `protobuf:"varint,1,opt,name=total_articles,json=totalArticles,proto3" json:"total_articles,omitempty"`
+	CompletedArticles   int32                  `protobuf:"varint,2,opt,name=completed_articles,json=completedArticles,proto3" json:"completed_articles,omitempty"`
+	InProgressArticles  int32                  `protobuf:"varint,3,opt,name=in_progress_articles,json=inProgressArticles,proto3" json:"in_progress_articles,omitempty"`
+	ToReadArticles      int32                  `protobuf:"varint,4,opt,name=to_read_articles,json=toReadArticles,proto3" json:"to_read_articles,omitempty"`
+	AbandonedArticles   int32                  `protobuf:"varint,5,opt,name=abandoned_articles,json=abandonedArticles,proto3" json:"abandoned_articles,omitempty"`
+	NewThisPeriod       int32                  `protobuf:"varint,6,opt,name=new_this_period,json=newThisPeriod,proto3" json:"new_this_period,omitempty"`                   // Articles added during the selected period
+	CompletedThisPeriod int32                  `protobuf:"varint,7,opt,name=completed_this_period,json=completedThisPeriod,proto3" json:"completed_this_period,omitempty"` // Articles completed during the selected period
+	AverageProgress     int32                  `protobuf:"varint,8,opt,name=average_progress,json=averageProgress,proto3" json:"average_progress,omitempty"`               // Average progress (0-100) of articles in progress
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReadingTotals) Reset() {
+	*x = ReadingTotals{}
+	mi := &file_stats_v1_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingTotals) ProtoMessage() {}
+
+func (x *ReadingTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingTotals.ProtoReflect.Descriptor instead.
+func (*ReadingTotals) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadingTotals) GetTotalArticles() int32 {
+	if x != nil {
+		return x.TotalArticles
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetCompletedArticles() int32 {
+	if x != nil {
+		return x.CompletedArticles
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetInProgressArticles() int32 {
+	if x != nil {
+		return x.InProgressArticles
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetToReadArticles() int32 {
+	if x != nil {
+		return x.ToReadArticles
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetAbandonedArticles() int32 {
+	if x != nil {
+		return x.AbandonedArticles
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetNewThisPeriod() int32 {
+	if x != nil {
+		return x.NewThisPeriod
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetCompletedThisPeriod() int32 {
+	if x != nil {
+		return x.CompletedThisPeriod
+	}
+	return 0
+}
+
+func (x *ReadingTotals) GetAverageProgress() int32 {
+	if x != nil {
+		return x.AverageProgress
+	}
+	return 0
+}
+
+type ReadingStreak struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentDays   int32                  `protobuf:"varint,1,opt,name=current_days,json=currentDays,proto3" json:"current_days,omitempty"` // Consecutive days with reading activity, ending today or yesterday
+	LongestDays   int32                  `protobuf:"varint,2,opt,name=longest_days,json=longestDays,proto3" json:"longest_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingStreak) Reset() {
+	*x = ReadingStreak{}
+	mi := &file_stats_v1_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingStreak) ProtoMessage() {}
+
+func (x *ReadingStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingStreak.ProtoReflect.Descriptor instead.
+func (*ReadingStreak) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadingStreak) GetCurrentDays() int32 {
+	if x != nil {
+		return x.CurrentDays
+	}
+	return 0
+}
+
+func (x *ReadingStreak) GetLongestDays() int32 {
+	if x != nil {
+		return x.LongestDays
+	}
+	return 0
+}
+
+// ActivityBucket is one bar of the activity chart: days for a week, weeks for
+// a month and months for a year. An article counts as started when it first
+// moved to reading, or when it was added if it never did.
+type ActivityBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Started       int32                  `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Completed     int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityBucket) Reset() {
+	*x = ActivityBucket{}
+	mi := &file_stats_v1_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBucket) ProtoMessage() {}
+
+func (x *ActivityBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityBucket.ProtoReflect.Descriptor instead.
+func (*ActivityBucket) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityBucket) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ActivityBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ActivityBucket) GetStarted() int32 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *ActivityBucket) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type TopicCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicCount) Reset() {
+	*x = TopicCount{}
+	mi := &file_stats_v1_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCount) ProtoMessage() {}
+
+func (x *TopicCount) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCount.ProtoReflect.Descriptor instead.
+func (*TopicCount) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopicCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReadingHabits struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AveragePerWeek        float64                `protobuf:"fixed64,1,opt,name=average_per_week,json=averagePerWeek,proto3" json:"average_per_week,omitempty"`                      // Completions per week since the first article was added
+	BestDay               string                 `protobuf:"bytes,2,opt,name=best_day,json=bestDay,proto3" json:"best_day,omitempty"`                                               // Weekday with the most completions, empty if nothing was completed
+	AverageCompletionDays float64                `protobuf:"fixed64,3,opt,name=average_completion_days,json=averageCompletionDays,proto3" json:"average_completion_days,omitempty"` // Average days between starting and completing an article
+	PreferredHour         *int32                 `protobuf:"varint,4,opt,name=preferred_hour,json=preferredHour,proto3,oneof" json:"preferred_hour,omitempty"`                      // Hour of day (0-23, in the requested time zone) with the most reading activity
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReadingHabits) Reset() {
+	*x = ReadingHabits{}
+	mi := &file_stats_v1_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingHabits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingHabits) ProtoMessage() {}
+
+func (x *ReadingHabits) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingHabits.ProtoReflect.Descriptor instead.
+func (*ReadingHabits) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReadingHabits) GetAveragePerWeek() float64 {
+	if x != nil {
+		return x.AveragePerWeek
+	}
+	return 0
+}
+
+func (x *ReadingHabits) GetBestDay() string {
+	if x != nil {
+		return x.BestDay
+	}
+	return ""
+}
+
+func (x *ReadingHabits) GetAverageCompletionDays() float64 {
+	if x != nil {
+		return x.AverageCompletionDays
+	}
+	return 0
+}
+
+func (x *ReadingHabits) GetPreferredHour() int32 {
+	if x != nil && x.PreferredHour != nil {
+		return *x.PreferredHour
+	}
+	return 0
+}
+
+type LibraryStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LibraryId     int64                  `protobuf:"varint,1,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibraryStat) Reset() {
+	*x = LibraryStat{}
+	mi := &file_stats_v1_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryStat) ProtoMessage() {}
+
+func (x *LibraryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryStat.ProtoReflect.Descriptor instead.
+func (*LibraryStat) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LibraryStat) GetLibraryId() int64 {
+	if x != nil {
+		return x.LibraryId
+	}
+	return 0
+}
+
+func (x *LibraryStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LibraryStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LibraryOverview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalLibraries int32                  `protobuf:"varint,1,opt,name=total_libraries,json=totalLibraries,proto3" json:"total_libraries,omitempty"`
+	Largest        *LibraryStat           `protobuf:"bytes,2,opt,name=largest,proto3" json:"largest,omitempty"`                                      // Library with the most articles
+	MostActive     *LibraryStat           `protobuf:"bytes,3,opt,name=most_active,json=mostActive,proto3" json:"most_active,omitempty"`              // Library with the most articles added or completed during the period
+	CompletionRate int32                  `protobuf:"varint,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Percentage of articles that have been read
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LibraryOverview) Reset() {
+	*x = LibraryOverview{}
+	mi := &file_stats_v1_stats_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryOverview) ProtoMessage() {}
+
+func (x *LibraryOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryOverview.ProtoReflect.Descriptor instead.
+func (*LibraryOverview) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LibraryOverview) GetTotalLibraries() int32 {
+	if x != nil {
+		return x.TotalLibraries
+	}
+	return 0
+}
+
+func (x *LibraryOverview) GetLargest() *LibraryStat {
+	if x != nil {
+		return x.Largest
+	}
+	return nil
+}
+
+func (x *LibraryOverview) GetMostActive() *LibraryStat {
+	if x != nil {
+		return x.MostActive
+	}
+	return nil
+}
+
+func (x *LibraryOverview) GetCompletionRate() int32 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type GetReadingStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period        StatsPeriod            `protobuf:"varint,2,opt,name=period,proto3,enum=api.stats.v1.StatsPeriod" json:"period,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone name, e.g. "Europe/Berlin". Defaults to UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStatsRequest) Reset() {
+	*x = GetReadingStatsRequest{}
+	mi := &file_stats_v1_stats_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsRequest) ProtoMessage() {}
+
+func (x *GetReadingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReadingStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReadingStatsRequest) GetPeriod() StatsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StatsPeriod_STATS_PERIOD_UNSPECIFIED
+}
+
+func (x *GetReadingStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetReadingStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        *ReadingTotals         `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals,omitempty"`
+	Streak        *ReadingStreak         `protobuf:"bytes,2,opt,name=streak,proto3" json:"streak,omitempty"`
+	Activity      []*ActivityBucket      `protobuf:"bytes,3,rep,name=activity,proto3" json:"activity,omitempty"`
+	Topics        []*TopicCount          `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Habits        *ReadingHabits         `protobuf:"bytes,5,opt,name=habits,proto3" json:"habits,omitempty"`
+	Libraries     *LibraryOverview       `protobuf:"bytes,6,opt,name=libraries,proto3" json:"libraries,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStatsResponse) Reset() {
+	*x = GetReadingStatsResponse{}
+	mi := &file_stats_v1_stats_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsResponse) ProtoMessage() {}
+
+func (x *GetReadingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_v1_stats_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_stats_v1_stats_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReadingStatsResponse) GetTotals() *ReadingTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetStreak() *ReadingStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetActivity() []*ActivityBucket {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetTopics() []*TopicCount {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetHabits() *ReadingHabits {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetLibraries() *LibraryOverview {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+var File_stats_v1_stats_proto protoreflect.FileDescriptor
+
+const file_stats_v1_stats_proto_rawDesc = "" +
+	"\n" +
+	"\x14stats/v1/stats.proto\x12\fapi.stats.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x02\n" +
+	"\rReadingTotals\x12%\n" +
+	"\x0etotal_articles\x18\x01 \x01(\x05R\rtotalArticles\x12-\n" +
+	"\x12completed_articles\x18\x02 \x01(\x05R\x11completedArticles\x120\n" +
+	"\x14in_progress_articles\x18\x03 \x01(\x05R\x12inProgressArticles\x12(\n" +
+	"\x10to_read_articles\x18\x04 \x01(\x05R\x0etoReadArticles\x12-\n" +
+	"\x12abandoned_articles\x18\x05 \x01(\x05R\x11abandonedArticles\x12&\n" +
+	"\x0fnew_this_period\x18\x06 \x01(\x05R\rnewThisPeriod\x122\n" +
+	"\x15completed_this_period\x18\a \x01(\x05R\x13completedThisPeriod\x12)\n" +
+	"\x10average_progress\x18\b \x01(\x05R\x0faverageProgress\"U\n" +
+	"\rReadingStreak\x12!\n" +
+	"\fcurrent_days\x18\x01 \x01(\x05R\vcurrentDays\x12!\n" +
+	"\flongest_days\x18\x02 \x01(\x05R\vlongestDays\"\x90\x01\n" +
+	"\x0eActivityBucket\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x18\n" +
+	"\astarted\x18\x03 \x01(\x05R\astarted\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x05R\tcompleted\"6\n" +
+	"\n" +
+	"TopicCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xcb\x01\n" +
+	"\rReadingHabits\x12(\n" +
+	"\x10average_per_week\x18\x01 \x01(\x01R\x0eaveragePerWeek\x12\x19\n" +
+	"\bbest_day\x18\x02 \x01(\tR\abestDay\x126\n" +
+	"\x17average_completion_days\x18\x03 \x01(\x01R\x15averageCompletionDays\x12*\n" +
+	"\x0epreferred_hour\x18\x04 \x01(\x05H\x00R\rpreferredHour\x88\x01\x01B\x11\n" +
+	"\x0f_preferred_hour\"V\n" +
+	"\vLibraryStat\x12\x1d\n" +
+	"\n" +
+	"library_id\x18\x01 \x01(\x03R\tlibraryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xd4\x01\n" +
+	"\x0fLibraryOverview\x12'\n" +
+	"\x0ftotal_libraries\x18\x01 \x01(\x05R\x0etotalLibraries\x123\n" +
+	"\alargest\x18\x02 \x01(\v2\x19.api.stats.v1.LibraryStatR\alargest\x12:\n" +
+	"\vmost_active\x18\x03 \x01(\v2\x19.api.stats.v1.LibraryStatR\n" +
+	"mostActive\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x05R\x0ecompletionRate\"\x94\x01\n" +
+	"\x16GetReadingStatsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12;\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x19.api.stats.v1.StatsPeriodB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06period\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"\xdb\x03\n" +
+	"\x17GetReadingStatsResponse\x123\n" +
+	"\x06totals\x18\x01 \x01(\v2\x1b.api.stats.v1.ReadingTotalsR\x06totals\x123\n" +
+	"\x06streak\x18\x02 \x01(\v2\x1b.api.stats.v1.ReadingStreakR\x06streak\x128\n" +
+	"\bactivity\x18\x03 \x03(\v2\x1c.api.stats.v1.ActivityBucketR\bactivity\x120\n" +
+	"\x06topics\x18\x04 \x03(\v2\x18.api.stats.v1.TopicCountR\x06topics\x123\n" +
+	"\x06habits\x18\x05 \x01(\v2\x1b.api.stats.v1.ReadingHabitsR\x06habits\x12;\n" +
+	"\tlibraries\x18\x06 \x01(\v2\x1d.api.stats.v1.LibraryOverviewR\tlibraries\x12=\n" +
+	"\fperiod_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd*q\n" +
+	"\vStatsPeriod\x12\x1c\n" +
+	"\x18STATS_PERIOD_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11STATS_PERIOD_WEEK\x10\x01\x12\x16\n" +
+	"\x12STATS_PERIOD_MONTH\x10\x02\x12\x15\n" +
+	"\x11STATS_PERIOD_YEAR\x10\x032n\n" +
+	"\fStatsService\x12^\n" +
+	"\x0fGetReadingStats\x12$.api.stats.v1.GetReadingStatsRequest\x1a%.api.stats.v1.GetReadingStatsResponseB5Z3github.com/chiquitav2/journalful/pkg/stats/v1;statsb\x06proto3"
+
+var (
+	file_stats_v1_stats_proto_rawDescOnce sync.Once
+	file_stats_v1_stats_proto_rawDescData []byte
+)
+
+func file_stats_v1_stats_proto_rawDescGZIP() []byte {
+	file_stats_v1_stats_proto_rawDescOnce.Do(func() {
+		file_stats_v1_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stats_v1_stats_proto_rawDesc), len(file_stats_v1_stats_proto_rawDesc)))
+	})
+	return file_stats_v1_stats_proto_rawDescData
+}
+
+var file_stats_v1_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stats_v1_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_stats_v1_stats_proto_goTypes = []any{
+	(StatsPeriod)(0),                // 0: api.stats.v1.StatsPeriod
+	(*ReadingTotals)(nil),           // 1: api.stats.v1.ReadingTotals
+	(*ReadingStreak)(nil),           // 2: api.stats.v1.ReadingStreak
+	(*ActivityBucket)(nil),          // 3: api.stats.v1.ActivityBucket
+	(*TopicCount)(nil),              // 4: api.stats.v1.TopicCount
+	(*ReadingHabits)(nil),           // 5: api.stats.v1.ReadingHabits
+	(*LibraryStat)(nil),             // 6: api.stats.v1.LibraryStat
+	(*LibraryOverview)(nil),         // 7: api.stats.v1.LibraryOverview
+	(*GetReadingStatsRequest)(nil),  // 8: api.stats.v1.GetReadingStatsRequest
+	(*GetReadingStatsResponse)(nil), // 9: api.stats.v1.GetReadingStatsResponse
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+}
+var file_stats_v1_stats_proto_depIdxs = []int32{
+	10, // 0: api.stats.v1.ActivityBucket.start:type_name -> google.protobuf.Timestamp
+	6,  // 1: api.stats.v1.LibraryOverview.largest:type_name -> api.stats.v1.LibraryStat
+	6,  // 2: api.stats.v1.LibraryOverview.most_active:type_name -> api.stats.v1.LibraryStat
+	0,  // 3: api.stats.v1.GetReadingStatsRequest.period:type_name -> api.stats.v1.StatsPeriod
+	1,  // 4: api.stats.v1.GetReadingStatsResponse.totals:type_name -> api.stats.v1.ReadingTotals
+	2,  // 5: api.stats.v1.GetReadingStatsResponse.streak:type_name -> api.stats.v1.ReadingStreak
+	3,  // 6: api.stats.v1.GetReadingStatsResponse.activity:type_name -> api.stats.v1.ActivityBucket
+	4,  // 7: api.stats.v1.GetReadingStatsResponse.topics:type_name -> api.stats.v1.TopicCount
+	5,  // 8: api.stats.v1.GetReadingStatsResponse.habits:type_name -> api.stats.v1.ReadingHabits
+	7,  // 9: api.stats.v1.GetReadingStatsResponse.libraries:type_name -> api.stats.v1.LibraryOverview
+	10, // 10: api.stats.v1.GetReadingStatsResponse.period_start:type_name -> google.protobuf.Timestamp
+	10, // 11: api.stats.v1.GetReadingStatsResponse.period_end:type_name -> google.protobuf.Timestamp
+	8,  // 12: api.stats.v1.StatsService.GetReadingStats:input_type -> api.stats.v1.GetReadingStatsRequest
+	9,  // 13: api.stats.v1.StatsService.GetReadingStats:output_type -> api.stats.v1.GetReadingStatsResponse
+	13, // [13:14] is the sub-list for method output_type
+	12, // [12:13] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_stats_v1_stats_proto_init() }
+func file_stats_v1_stats_proto_init() {
+	if File_stats_v1_stats_proto != nil {
+		return
+	}
+	file_stats_v1_stats_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stats_v1_stats_proto_rawDesc), len(file_stats_v1_stats_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stats_v1_stats_proto_goTypes,
+		DependencyIndexes: file_stats_v1_stats_proto_depIdxs,
+		EnumInfos:         file_stats_v1_stats_proto_enumTypes,
+		MessageInfos:      file_stats_v1_stats_proto_msgTypes,
+	}.Build()
+	File_stats_v1_stats_proto = out.File
+	file_stats_v1_stats_proto_goTypes = nil
+	file_stats_v1_stats_proto_depIdxs = nil
+}
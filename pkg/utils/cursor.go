@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+// EncodeCursor returns an opaque page token for keyset pagination, where
+// pages are keyed by the id of the last row returned.
+func EncodeCursor(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+// DecodeCursor returns the id carried by a token from EncodeCursor.
+func DecodeCursor(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("malformed cursor token")
+	}
+	return id, nil
+}
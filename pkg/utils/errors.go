@@ -1,7 +0,0 @@
-package utils
-
-//handle sqlc errors
-
-func HandleSQLError(err error) error {
-	return err
-}
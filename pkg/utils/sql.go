@@ -0,0 +1,10 @@
+package utils
+
+import "strings"
+
+// LikePattern turns free text into a LIKE pattern matching it anywhere,
+// escaping the LIKE wildcards in the input.
+func LikePattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.TrimSpace(query))
+	return "%" + escaped + "%"
+}
@@ -55,11 +55,14 @@ SELECT * FROM articles WHERE doi = ? LIMIT 1;
 -- name: ListArticles :many
 SELECT * FROM articles ORDER BY title;
 
+-- name: GetArticleByNormalizedDOI :one
+SELECT * FROM articles WHERE doi_normalized = ? ORDER BY id LIMIT 1;
+
 -- name: CreateArticle :execresult
-INSERT INTO articles (doi, title, abstract, url, publication_year, journal_name) VALUES (?, ?, ?, ?, ?, ?);
+INSERT INTO articles (doi, doi_normalized, title, abstract, url, publication_year, journal_name) VALUES (?, ?, ?, ?, ?, ?, ?);
 
 -- name: UpdateArticle :exec
-UPDATE articles SET doi = ?, title = ?, abstract = ?, url = ?, publication_year = ?, journal_name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+UPDATE articles SET doi = ?, doi_normalized = ?, title = ?, abstract = ?, url = ?, publication_year = ?, journal_name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
 
 -- name: DeleteArticle :exec
 DELETE FROM articles WHERE id = ?;
@@ -91,6 +94,18 @@ FROM article_authors aa
 WHERE aa.article_id = ?
 ORDER BY aa.author_order;
 
+-- name: ListArticleAuthorsByArticleIDs :many
+SELECT
+    aa.article_id,
+    aa.author_id,
+    aa.author_order,
+    a.name AS author_name,
+    a.profile_id
+FROM article_authors aa
+         JOIN authors a ON aa.author_id = a.id
+WHERE aa.article_id IN (sqlc.slice(article_ids))
+ORDER BY aa.article_id, aa.author_order;
+
 -- name: ListArticleAuthorsByAuthorID :many
 SELECT
     aa.article_id,
@@ -105,6 +120,70 @@ ORDER BY ar.publication_year DESC, ar.title;
 -- name: DeleteArticleAuthor :exec
 DELETE FROM article_authors WHERE article_id = ? AND author_id = ?;
 
+-- name: CreateArticleReference :exec
+INSERT INTO article_references (citing_article_id, position, cited_doi, title, author, publication_year, journal_name, unstructured)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- ResolveReferencesFromArticle links the references of a newly stored
+-- article to the local articles they cite.
+-- name: ResolveReferencesFromArticle :exec
+UPDATE article_references r
+    JOIN articles a ON a.doi_normalized = r.cited_doi
+SET r.cited_article_id = a.id
+WHERE r.citing_article_id = ?;
+
+-- ResolveReferencesToArticle links dangling references that cite a newly
+-- stored article.
+-- name: ResolveReferencesToArticle :exec
+UPDATE article_references
+SET cited_article_id = sqlc.arg(article_id)
+WHERE cited_doi = sqlc.arg(doi) AND cited_article_id IS NULL;
+
+-- name: ListArticleReferences :many
+SELECT
+    r.position,
+    r.cited_doi,
+    r.cited_article_id,
+    COALESCE(a.title, r.title, '') AS title,
+    r.author,
+    COALESCE(a.publication_year, r.publication_year) AS publication_year,
+    COALESCE(a.journal_name, r.journal_name) AS journal_name,
+    r.unstructured
+FROM article_references r
+         LEFT JOIN articles a ON a.id = r.cited_article_id
+WHERE r.citing_article_id = ?
+ORDER BY r.position;
+
+-- name: ListCitingArticles :many
+SELECT DISTINCT a.*
+FROM article_references r
+         JOIN articles a ON a.id = r.citing_article_id
+WHERE r.cited_article_id = ?
+ORDER BY a.publication_year DESC, a.title;
+
+-- name: ListReferenceEdgesFrom :many
+SELECT
+    r.id,
+    r.citing_article_id,
+    r.cited_article_id,
+    r.cited_doi,
+    COALESCE(a.title, r.title, r.unstructured, '') AS cited_title
+FROM article_references r
+         LEFT JOIN articles a ON a.id = r.cited_article_id
+WHERE r.citing_article_id IN (sqlc.slice(article_ids))
+ORDER BY r.citing_article_id, r.position;
+
+-- name: ListReferenceEdgesTo :many
+SELECT
+    r.citing_article_id,
+    r.cited_article_id,
+    a.doi AS citing_doi,
+    a.title AS citing_title
+FROM article_references r
+         JOIN articles a ON a.id = r.citing_article_id
+WHERE r.cited_article_id IN (sqlc.slice(article_ids))
+ORDER BY r.cited_article_id, a.publication_year DESC;
+
 
 -- User's personal library
 
@@ -147,6 +226,81 @@ FROM library_articles la
 WHERE la.library_id = ?
 ORDER BY la.dateAdded DESC;
 
+-- name: ListLibraryArticlesPage :many
+SELECT
+    la.id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title AS article_title,
+    a.doi,
+    a.publication_year
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = sqlc.arg(library_id)
+  AND (sqlc.narg(reading_status) IS NULL OR la.reading_status = sqlc.narg(reading_status))
+  AND (sqlc.narg(is_favorite) IS NULL OR la.isFavorite = sqlc.narg(is_favorite))
+  AND (sqlc.narg(query) IS NULL OR a.title LIKE sqlc.narg(query) OR a.doi LIKE sqlc.narg(query))
+ORDER BY
+    CASE WHEN sqlc.arg(sort_order) = 'date_added_asc' THEN la.dateAdded END ASC,
+    CASE WHEN sqlc.arg(sort_order) = 'date_added_desc' THEN la.dateAdded END DESC,
+    CASE WHEN sqlc.arg(sort_order) = 'title_asc' THEN a.title END ASC,
+    CASE WHEN sqlc.arg(sort_order) = 'title_desc' THEN a.title END DESC,
+    CASE WHEN sqlc.arg(sort_order) = 'year_asc' THEN a.publication_year END ASC,
+    CASE WHEN sqlc.arg(sort_order) = 'year_desc' THEN a.publication_year END DESC,
+    CASE WHEN sqlc.arg(sort_order) = 'progress_asc' THEN la.reading_progress END ASC,
+    CASE WHEN sqlc.arg(sort_order) = 'progress_desc' THEN la.reading_progress END DESC,
+    la.id
+LIMIT ? OFFSET ?;
+
+-- name: CountLibraryArticlesFiltered :one
+SELECT COUNT(*)
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = sqlc.arg(library_id)
+  AND (sqlc.narg(reading_status) IS NULL OR la.reading_status = sqlc.narg(reading_status))
+  AND (sqlc.narg(is_favorite) IS NULL OR la.isFavorite = sqlc.narg(is_favorite))
+  AND (sqlc.narg(query) IS NULL OR a.title LIKE sqlc.narg(query) OR a.doi LIKE sqlc.narg(query));
+
+-- name: CountLibraryArticlesByStatusForOwner :many
+SELECT
+    la.library_id,
+    la.reading_status,
+    COUNT(*) AS article_count
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ?
+GROUP BY la.library_id, la.reading_status;
+
+-- name: GetLibraryArticleDetails :one
+SELECT
+    la.id,
+    la.library_id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title AS article_title,
+    a.doi,
+    a.publication_year,
+    l.owner_id
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+         JOIN library l ON la.library_id = l.id
+WHERE la.id = ? LIMIT 1;
+
+-- name: UpdateLibraryArticle :exec
+UPDATE library_articles
+SET reading_status = ?, reading_progress = ?, dateCompleted = ?, notes = ?, isFavorite = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
 -- name: UpdateLibraryArticleStatus :exec
 UPDATE library_articles SET reading_status = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
 
@@ -188,3 +342,608 @@ SELECT
     l.updated_at
 FROM library l
 WHERE l.id = ? LIMIT 1;
+
+
+-- Reading statistics
+
+-- name: ListLibraryArticlesForOwner :many
+SELECT
+    la.id,
+    la.library_id,
+    la.article_id,
+    la.reading_status,
+    la.reading_progress,
+    la.dateAdded,
+    la.dateCompleted
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ?;
+
+-- name: CountTagsForOwner :many
+SELECT
+    t.name,
+    COUNT(DISTINCT la.article_id) AS article_count
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+         JOIN article_tags at ON at.article_id = la.article_id
+         JOIN tags t ON at.tag_id = t.id
+WHERE l.owner_id = ?
+GROUP BY t.id, t.name
+ORDER BY article_count DESC, t.name
+LIMIT ?;
+
+
+-- Reading activity log (reading_events)
+
+-- name: CreateReadingEvent :exec
+INSERT INTO reading_events (profile_id, library_id, article_id, library_article_id, event_type, from_status, to_status, progress)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListReadingEvents :many
+SELECT
+    e.id,
+    e.library_id,
+    e.article_id,
+    e.library_article_id,
+    e.event_type,
+    e.from_status,
+    e.to_status,
+    e.progress,
+    e.occurred_at,
+    a.title AS article_title
+FROM reading_events e
+         JOIN articles a ON e.article_id = a.id
+WHERE e.profile_id = sqlc.arg(profile_id)
+  AND (sqlc.narg(library_id) IS NULL OR e.library_id = sqlc.narg(library_id))
+  AND (sqlc.narg(start_time) IS NULL OR e.occurred_at >= sqlc.narg(start_time))
+  AND (sqlc.narg(end_time) IS NULL OR e.occurred_at < sqlc.narg(end_time))
+  AND (sqlc.narg(before_id) IS NULL OR e.id < sqlc.narg(before_id))
+  AND e.event_type IN (sqlc.slice(event_types))
+ORDER BY e.id DESC
+LIMIT ?;
+
+-- name: ListReadingEventTimesForOwner :many
+SELECT
+    library_article_id,
+    event_type,
+    to_status,
+    occurred_at
+FROM reading_events
+WHERE profile_id = ?
+ORDER BY occurred_at;
+
+
+-- Reading goals (reading_goals)
+
+-- name: CreateGoal :execresult
+INSERT INTO reading_goals (profile_id, name, goal_type, target_count, period, library_id, due_date) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetGoal :one
+SELECT * FROM reading_goals WHERE id = ? LIMIT 1;
+
+-- name: ListGoalsByProfileID :many
+SELECT * FROM reading_goals WHERE profile_id = ? ORDER BY created_at;
+
+-- name: UpdateGoal :exec
+UPDATE reading_goals SET name = ?, target_count = ?, period = ?, due_date = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: DeleteGoal :exec
+DELETE FROM reading_goals WHERE id = ?;
+
+
+-- Spaced-repetition review schedules (review_schedules)
+
+-- name: CreateReviewSchedule :exec
+INSERT INTO review_schedules (library_article_id, ease_factor, interval_days, repetitions, due_date) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetReviewSchedule :one
+SELECT * FROM review_schedules WHERE library_article_id = ? LIMIT 1;
+
+-- name: UpdateReviewSchedule :exec
+UPDATE review_schedules
+SET ease_factor = ?, interval_days = ?, repetitions = ?, due_date = ?, last_reviewed_at = ?, last_rating = ?, updated_at = CURRENT_TIMESTAMP
+WHERE library_article_id = ?;
+
+-- name: DeleteReviewSchedule :exec
+DELETE FROM review_schedules WHERE library_article_id = ?;
+
+-- name: ListDueReviews :many
+SELECT
+    sqlc.embed(rs),
+    la.library_id,
+    la.article_id,
+    la.notes,
+    a.title AS article_title,
+    a.doi
+FROM review_schedules rs
+         JOIN library_articles la ON rs.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE l.owner_id = ? AND rs.due_date <= ?
+ORDER BY rs.due_date, rs.library_article_id
+LIMIT ?;
+
+-- name: CountDueReviews :one
+SELECT COUNT(*)
+FROM review_schedules rs
+         JOIN library_articles la ON rs.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE l.owner_id = ? AND rs.due_date <= ?;
+
+
+-- Annotations (annotations, annotation_tags)
+
+-- name: CreateAnnotation :execresult
+INSERT INTO annotations (library_article_id, author_id, quote, comment, page, location, color, visibility)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAnnotation :one
+SELECT
+    sqlc.embed(an),
+    la.library_id,
+    la.article_id,
+    l.owner_id,
+    l.isPublic
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE an.id = ? LIMIT 1;
+
+-- name: ListAnnotationsForLibraryArticle :many
+SELECT
+    sqlc.embed(an),
+    la.library_id,
+    la.article_id
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+WHERE an.library_article_id = sqlc.arg(library_article_id)
+  AND (an.author_id = sqlc.arg(viewer_id)
+    OR (an.visibility = 2 AND (l.owner_id = sqlc.arg(viewer_id) OR l.isPublic)))
+ORDER BY an.page IS NULL, an.page, an.id;
+
+-- name: UpdateAnnotation :exec
+UPDATE annotations
+SET quote = ?, comment = ?, page = ?, location = ?, color = ?, visibility = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: DeleteAnnotation :exec
+DELETE FROM annotations WHERE id = ?;
+
+-- name: SearchAnnotations :many
+SELECT
+    sqlc.embed(an),
+    la.library_id,
+    la.article_id
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+WHERE an.author_id = sqlc.arg(author_id)
+  AND (sqlc.narg(library_id) IS NULL OR la.library_id = sqlc.narg(library_id))
+  AND (sqlc.narg(color) IS NULL OR an.color = sqlc.narg(color))
+  AND (sqlc.narg(query) IS NULL OR an.quote LIKE sqlc.narg(query) OR an.comment LIKE sqlc.narg(query))
+  AND (sqlc.narg(tag) IS NULL OR EXISTS (
+    SELECT 1 FROM annotation_tags ant JOIN tags t ON ant.tag_id = t.id
+    WHERE ant.annotation_id = an.id AND t.name = sqlc.narg(tag)))
+  AND (sqlc.narg(before_id) IS NULL OR an.id < sqlc.narg(before_id))
+ORDER BY an.id DESC
+LIMIT ?;
+
+-- name: ListAnnotationsForExport :many
+SELECT
+    sqlc.embed(an),
+    la.library_id,
+    la.article_id,
+    l.name AS library_name,
+    a.title AS article_title,
+    a.doi
+FROM annotations an
+         JOIN library_articles la ON an.library_article_id = la.id
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE an.author_id = sqlc.arg(author_id)
+  AND (sqlc.narg(library_id) IS NULL OR la.library_id = sqlc.narg(library_id))
+ORDER BY la.library_id, a.title, la.id, an.page IS NULL, an.page, an.id;
+
+-- name: ListAnnotationTags :many
+SELECT ant.annotation_id, t.name
+FROM annotation_tags ant
+         JOIN tags t ON ant.tag_id = t.id
+WHERE ant.annotation_id IN (sqlc.slice(annotation_ids))
+ORDER BY t.name;
+
+-- name: UpsertTag :execlastid
+INSERT INTO tags (name) VALUES (?) ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id);
+
+-- name: AddAnnotationTag :exec
+INSERT IGNORE INTO annotation_tags (annotation_id, tag_id) VALUES (?, ?);
+
+-- name: DeleteAnnotationTags :exec
+DELETE FROM annotation_tags WHERE annotation_id = ?;
+
+-- name: ListLibraryArticlesForNotesExport :many
+SELECT
+    la.id,
+    la.article_id,
+    la.reading_status,
+    la.dateAdded,
+    la.dateCompleted,
+    la.notes,
+    la.isFavorite,
+    a.title,
+    a.doi,
+    a.publication_year,
+    a.journal_name
+FROM library_articles la
+         JOIN articles a ON la.article_id = a.id
+WHERE la.library_id = ?
+ORDER BY a.title, la.id;
+
+-- name: ListArticleAuthorsForLibrary :many
+SELECT aa.article_id, au.name
+FROM article_authors aa
+         JOIN authors au ON aa.author_id = au.id
+         JOIN library_articles la ON la.article_id = aa.article_id
+WHERE la.library_id = ?
+ORDER BY aa.article_id, aa.author_order;
+
+-- name: ListArticleTagsForLibrary :many
+SELECT at.article_id, t.name
+FROM article_tags at
+         JOIN tags t ON at.tag_id = t.id
+         JOIN library_articles la ON la.article_id = at.article_id
+WHERE la.library_id = ?
+ORDER BY at.article_id, t.name;
+
+
+-- Attachments (blobs, attachments)
+
+-- Creates the blob row, or takes the row lock on the existing one, so the
+-- contents are not deleted while an upload refers to them.
+-- name: CreateBlob :exec
+INSERT INTO blobs (sha256, size, content_type, extraction_status) VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE sha256 = sha256;
+
+-- name: LockBlob :one
+SELECT sha256 FROM blobs WHERE sha256 = ? FOR UPDATE;
+
+-- name: DeleteBlob :exec
+DELETE FROM blobs WHERE sha256 = ?;
+
+-- Serializes uploads of one owner, so concurrent uploads can't overrun the
+-- quota together.
+-- name: LockProfile :one
+SELECT id FROM profiles WHERE id = ? FOR UPDATE;
+
+-- name: CreateAttachment :execresult
+INSERT INTO attachments (owner_id, sha256, filename, content_type, size, article_id, library_article_id)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAttachment :one
+SELECT
+    sqlc.embed(at),
+    b.extraction_status,
+    b.extraction_error,
+    b.page_count,
+    l.owner_id AS library_owner_id,
+    l.isPublic AS library_is_public
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+         LEFT JOIN library_articles la ON at.library_article_id = la.id
+         LEFT JOIN library l ON la.library_id = l.id
+WHERE at.id = ? LIMIT 1;
+
+-- name: ListAttachmentsForArticle :many
+SELECT sqlc.embed(at), b.extraction_status, b.extraction_error, b.page_count
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+WHERE at.article_id = ?
+ORDER BY at.id;
+
+-- name: ListAttachmentsForLibraryArticle :many
+SELECT sqlc.embed(at), b.extraction_status, b.extraction_error, b.page_count
+FROM attachments at
+         JOIN blobs b ON at.sha256 = b.sha256
+WHERE at.library_article_id = ?
+ORDER BY at.id;
+
+-- name: DeleteAttachment :exec
+DELETE FROM attachments WHERE id = ?;
+
+-- name: CountAttachmentsBySha256 :one
+SELECT COUNT(*) FROM attachments WHERE sha256 = ?;
+
+-- name: SumAttachmentSizeForOwner :one
+SELECT CAST(COALESCE(SUM(size), 0) AS SIGNED) AS used_bytes FROM attachments WHERE owner_id = ?;
+
+
+-- Full-text extraction (blobs, blob_pages)
+
+-- name: ListBlobsPendingExtraction :many
+SELECT sha256, size FROM blobs WHERE extraction_status = 1 ORDER BY created_at LIMIT ?;
+
+-- name: ClaimBlobForExtraction :execrows
+UPDATE blobs SET extraction_status = 2, extraction_lease_until = ? WHERE sha256 = ? AND extraction_status = 1;
+
+-- Extractions whose worker stopped before its lease ran out are picked up
+-- again.
+-- name: ReleaseExpiredExtractions :execrows
+UPDATE blobs SET extraction_status = 1, extraction_lease_until = NULL
+WHERE extraction_status = 2 AND extraction_lease_until < ?;
+
+-- name: RetryBlobExtraction :execrows
+UPDATE blobs SET extraction_status = 1, extraction_error = NULL WHERE sha256 = ? AND extraction_status = 4;
+
+-- name: DeleteBlobPages :exec
+DELETE FROM blob_pages WHERE sha256 = ?;
+
+-- name: CreateBlobPage :exec
+INSERT INTO blob_pages (sha256, page_number, text) VALUES (?, ?, ?);
+
+-- name: CompleteBlobExtraction :exec
+UPDATE blobs
+SET extraction_status = 3, extraction_error = NULL, extraction_lease_until = NULL, page_count = ?, extracted_at = CURRENT_TIMESTAMP
+WHERE sha256 = ?;
+
+-- name: FailBlobExtraction :exec
+UPDATE blobs
+SET extraction_status = 4, extraction_error = ?, extraction_lease_until = NULL, extracted_at = CURRENT_TIMESTAMP
+WHERE sha256 = ?;
+
+-- Pages matching a full-text query in attachments the viewer can see:
+-- everything attached to articles, and their own library attachments.
+-- Without an ORDER BY, MATCH in the WHERE clause returns the most relevant
+-- pages first.
+-- name: SearchAttachmentPages :many
+SELECT
+    at.id AS attachment_id,
+    CAST(COALESCE(at.article_id, la.article_id) AS SIGNED) AS article_id,
+    p.page_number,
+    p.text
+FROM blob_pages p
+         JOIN attachments at ON at.sha256 = p.sha256
+         LEFT JOIN library_articles la ON at.library_article_id = la.id
+         LEFT JOIN library l ON la.library_id = l.id
+WHERE MATCH(p.text) AGAINST (sqlc.arg(query))
+  AND (at.article_id IS NOT NULL OR l.owner_id = sqlc.arg(viewer_id))
+LIMIT ?;
+
+-- name: SearchArticlesByMetadata :many
+SELECT * FROM articles
+WHERE title LIKE sqlc.arg(title_pattern) OR abstract LIKE sqlc.arg(abstract_pattern) OR doi = sqlc.arg(doi)
+ORDER BY title
+LIMIT ?;
+
+-- name: ListArticlesByIDs :many
+SELECT * FROM articles WHERE id IN (sqlc.slice(ids));
+
+-- Recommendation inputs
+
+-- name: ListArticleAbstracts :many
+SELECT id, abstract FROM articles WHERE abstract IS NOT NULL AND abstract <> '';
+
+-- name: ListAllArticleAuthors :many
+SELECT article_id, author_id FROM article_authors;
+
+-- name: ListAllArticleTags :many
+SELECT article_id, tag_id FROM article_tags;
+
+-- name: ListResolvedReferences :many
+SELECT citing_article_id, CAST(cited_article_id AS SIGNED) AS cited_article_id
+FROM article_references
+WHERE cited_article_id IS NOT NULL;
+
+-- name: ListPublicLibraryArticles :many
+SELECT la.library_id, la.article_id
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+WHERE l.isPublic = TRUE;
+
+-- HasRecentRecommendations reports whether the recommendations were
+-- computed within the last max_age_seconds, by any instance.
+-- name: HasRecentRecommendations :one
+SELECT EXISTS (SELECT 1
+               FROM article_recommendations
+               WHERE computed_at > NOW() - INTERVAL CAST(sqlc.arg(max_age_seconds) AS SIGNED) SECOND) AS recent;
+
+-- name: DeleteAllRecommendations :exec
+DELETE FROM article_recommendations;
+
+-- name: CreateRecommendation :exec
+INSERT INTO article_recommendations (article_id, recommended_article_id, score, shared_authors, shared_tags, co_citations, co_occurrences, abstract_similarity)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- ListSimilarArticles optionally leaves out articles already in any of the
+-- given owner's libraries.
+-- name: ListSimilarArticles :many
+SELECT sqlc.embed(r), sqlc.embed(a)
+FROM article_recommendations r
+         JOIN articles a ON a.id = r.recommended_article_id
+WHERE r.article_id = sqlc.arg(article_id)
+  AND (sqlc.narg(exclude_owner_id) IS NULL OR NOT EXISTS (
+    SELECT 1
+    FROM library_articles la
+             JOIN library l ON la.library_id = l.id
+    WHERE la.article_id = r.recommended_article_id AND l.owner_id = sqlc.narg(exclude_owner_id)))
+ORDER BY r.score DESC
+LIMIT ?;
+
+-- ListRecommendationsForOwner returns the recommendations of every article
+-- in the owner's libraries that point outside of them.
+-- name: ListRecommendationsForOwner :many
+SELECT sqlc.embed(r), s.title AS seed_title
+FROM article_recommendations r
+         JOIN articles s ON s.id = r.article_id
+WHERE r.article_id IN (SELECT la.article_id
+                       FROM library_articles la
+                                JOIN library l ON la.library_id = l.id
+                       WHERE l.owner_id = sqlc.arg(owner_id))
+  AND r.recommended_article_id NOT IN (SELECT la.article_id
+                                       FROM library_articles la
+                                                JOIN library l ON la.library_id = l.id
+                                       WHERE l.owner_id = sqlc.arg(owner_id))
+ORDER BY r.score DESC
+LIMIT ?;
+
+-- Duplicate detection and merging
+
+-- ListArticleDOIs leaves out doi_normalized, which is NULL until
+-- MigrateNormalizedDOIs has filled it in.
+-- name: ListArticleDOIs :many
+SELECT id, doi FROM articles ORDER BY id;
+
+-- name: SetArticleDOINormalized :exec
+UPDATE articles SET doi_normalized = sqlc.arg(doi_normalized) WHERE id = sqlc.arg(id);
+
+-- ListArticlesByTitleMatch relies on MATCH in WHERE returning rows by
+-- relevance.
+-- name: ListArticlesByTitleMatch :many
+SELECT * FROM articles
+WHERE MATCH(title) AGAINST (sqlc.arg(title)) AND id <> sqlc.arg(exclude_id)
+LIMIT ?;
+
+-- name: GetArticleRedirect :one
+SELECT new_id FROM article_redirects WHERE old_id = ?;
+
+-- name: GetArticleRedirectByDOI :one
+SELECT new_id FROM article_redirects WHERE old_doi = ? ORDER BY merged_at DESC LIMIT 1;
+
+-- name: CreateArticleRedirect :exec
+INSERT INTO article_redirects (old_id, old_doi, new_id) VALUES (?, ?, ?);
+
+-- name: RepointArticleRedirects :exec
+UPDATE article_redirects SET new_id = sqlc.arg(survivor_id) WHERE new_id = sqlc.arg(duplicate_id);
+
+-- name: ListLibraryArticlesByArticleID :many
+SELECT * FROM library_articles WHERE article_id = ?;
+
+-- name: MoveLibraryArticle :exec
+UPDATE library_articles SET article_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: MoveAnnotationsToLibraryArticle :exec
+UPDATE annotations SET library_article_id = sqlc.arg(to_id) WHERE library_article_id = sqlc.arg(from_id);
+
+-- name: MoveAttachmentsToLibraryArticle :exec
+UPDATE attachments SET library_article_id = sqlc.arg(to_id) WHERE library_article_id = sqlc.arg(from_id);
+
+-- MoveReviewScheduleToLibraryArticle keeps the target's schedule if it
+-- already has one; the source schedule is then removed with its row.
+-- name: MoveReviewScheduleToLibraryArticle :exec
+UPDATE IGNORE review_schedules SET library_article_id = sqlc.arg(to_id) WHERE library_article_id = sqlc.arg(from_id);
+
+-- name: MoveReadingEventsToLibraryArticle :exec
+UPDATE reading_events
+SET library_article_id = sqlc.arg(to_id), article_id = sqlc.arg(article_id)
+WHERE library_article_id = sqlc.arg(from_id);
+
+-- name: MoveReadingEventsToArticle :exec
+UPDATE reading_events SET article_id = sqlc.arg(survivor_id) WHERE article_id = sqlc.arg(duplicate_id);
+
+-- name: CopyArticleTags :exec
+INSERT IGNORE INTO article_tags (article_id, tag_id)
+SELECT sqlc.arg(survivor_id), t.tag_id FROM article_tags t WHERE t.article_id = sqlc.arg(duplicate_id);
+
+-- name: CopyArticleAuthors :exec
+INSERT IGNORE INTO article_authors (article_id, author_id, author_order)
+SELECT sqlc.arg(survivor_id), aa.author_id, aa.author_order FROM article_authors aa WHERE aa.article_id = sqlc.arg(duplicate_id);
+
+-- name: MoveArticleAttachments :exec
+UPDATE attachments SET article_id = sqlc.arg(survivor_id) WHERE article_id = sqlc.arg(duplicate_id);
+
+-- name: CountArticleReferences :one
+SELECT COUNT(*) FROM article_references WHERE citing_article_id = ?;
+
+-- name: MoveArticleReferences :exec
+UPDATE article_references SET citing_article_id = sqlc.arg(survivor_id) WHERE citing_article_id = sqlc.arg(duplicate_id);
+
+-- name: RepointCitedReferences :exec
+UPDATE article_references SET cited_article_id = sqlc.arg(survivor_id) WHERE cited_article_id = sqlc.arg(duplicate_id);
+
+-- Correction and retraction notices
+
+-- name: UpsertArticleUpdate :exec
+INSERT INTO article_updates (article_id, update_type, flag, notice_doi, label, updated_on)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE flag = VALUES(flag), label = VALUES(label), updated_on = VALUES(updated_on);
+
+-- name: RefreshArticleFlag :exec
+UPDATE articles
+SET flag = (SELECT COALESCE(MAX(u.flag), 0) FROM article_updates u WHERE u.article_id = sqlc.arg(article_id))
+WHERE articles.id = sqlc.arg(article_id);
+
+-- name: MarkArticleUpdatesChecked :exec
+UPDATE articles SET updates_checked_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: ListArticlesDueForUpdateCheck :many
+SELECT id, doi_normalized
+FROM articles
+WHERE updates_checked_at IS NULL OR updates_checked_at < sqlc.arg(checked_before)
+ORDER BY updates_checked_at
+LIMIT ?;
+
+-- name: ListArticleUpdates :many
+SELECT * FROM article_updates
+WHERE article_id IN (sqlc.slice(article_ids))
+ORDER BY article_id, updated_on DESC;
+
+-- name: ListFlaggedLibraryArticlesForOwner :many
+SELECT
+    la.id AS library_article_id,
+    la.library_id,
+    l.name AS library_name,
+    a.id AS article_id,
+    a.doi,
+    a.title,
+    a.flag
+FROM library_articles la
+         JOIN library l ON la.library_id = l.id
+         JOIN articles a ON la.article_id = a.id
+WHERE l.owner_id = ? AND a.flag > 0
+ORDER BY a.flag DESC, a.title, la.library_id;
+
+-- name: CreateAccessToken :execresult
+INSERT INTO access_tokens (profile_id, name, token_hash, token_prefix, scopes, expires_at) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetAccessToken :one
+SELECT * FROM access_tokens WHERE id = ? LIMIT 1;
+
+-- name: GetAccessTokenByHash :one
+SELECT
+    t.id,
+    t.profile_id,
+    t.scopes,
+    t.expires_at,
+    t.last_used_at,
+    t.revoked_at,
+    p.user_id,
+    p.name AS profile_name,
+    p.admin_confirmed_at
+FROM access_tokens t
+         JOIN profiles p ON t.profile_id = p.id
+WHERE t.token_hash = ?
+LIMIT 1;
+
+-- name: ListAccessTokensByProfileID :many
+SELECT * FROM access_tokens WHERE profile_id = ? ORDER BY created_at DESC, id DESC;
+
+-- name: RevokeAccessToken :execrows
+UPDATE access_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE id = ? AND profile_id = ? AND revoked_at IS NULL;
+
+-- ConfirmProfileAdmin records that the identity provider vouched for the
+-- admin role.
+-- name: ConfirmProfileAdmin :exec
+UPDATE profiles SET admin_confirmed_at = sqlc.arg(confirmed_at) WHERE id = sqlc.arg(id);
+
+-- name: ClearProfileAdmin :exec
+UPDATE profiles SET admin_confirmed_at = NULL WHERE id = ?;
+
+-- name: TouchAccessToken :exec
+UPDATE access_tokens SET last_used_at = sqlc.arg(used_at)
+WHERE id = sqlc.arg(id) AND (last_used_at IS NULL OR last_used_at < sqlc.arg(stale_before));
+
+-- Metrics
+
+-- name: CountCatalog :one
+SELECT
+    (SELECT COUNT(*) FROM articles) AS articles,
+    (SELECT COUNT(*) FROM library) AS libraries,
+    (SELECT COUNT(*) FROM profiles) AS profiles;
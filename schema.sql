@@ -6,6 +6,9 @@ CREATE TABLE profiles
     name        VARCHAR(100) NOT NULL,
     bio         TEXT,
     institution VARCHAR(100),
+    -- When the identity provider last vouched for the admin role; NULL once
+    -- it signs the user in without it. Access tokens check it on every use.
+    admin_confirmed_at TIMESTAMP NULL,
     created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     UNIQUE INDEX idx_profiles_user_id (user_id)
@@ -37,14 +40,48 @@ CREATE TABLE articles
 (
     id               BIGINT AUTO_INCREMENT PRIMARY KEY,
     doi              VARCHAR(100) NOT NULL,
+    doi_normalized   VARCHAR(100) NOT NULL, -- Lowercase, without a resolver prefix; used for duplicate detection
     title            VARCHAR(255) NOT NULL,
     abstract         TEXT,
     url              VARBINARY(255),
     publication_year INT,               -- Added for common metadata
     journal_name     VARCHAR(255),      -- Added for common metadata
+    -- Most severe correction notice: 0: None, 1: Corrected, 2: Expression of concern, 3: Retracted
+    flag             TINYINT NOT NULL DEFAULT 0 COMMENT '0:None, 1:Corrected, 2:ExpressionOfConcern, 3:Retracted',
+    updates_checked_at TIMESTAMP NULL,  -- Last time CrossRef was asked for new notices
     created_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    UNIQUE INDEX idx_articles_doi (doi) -- DOI should be unique
+    UNIQUE INDEX idx_articles_doi (doi), -- DOI should be unique
+    UNIQUE INDEX idx_articles_doi_normalized (doi_normalized), -- Catches the same DOI created concurrently
+    FULLTEXT INDEX ft_articles_title (title)
+);
+
+-- Corrections, retractions and similar notices about an article, from the
+-- CrossRef update-to/updated-by relations
+CREATE TABLE article_updates
+(
+    id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    article_id  BIGINT       NOT NULL,
+    update_type VARCHAR(50)  NOT NULL, -- CrossRef type, e.g. retraction, correction, erratum
+    flag        TINYINT      NOT NULL COMMENT '1:Corrected, 2:ExpressionOfConcern, 3:Retracted',
+    notice_doi  VARCHAR(100) NOT NULL, -- Normalized DOI of the notice
+    label       VARCHAR(100),
+    updated_on  DATE,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_articleupdates_notice (article_id, notice_doi, update_type),
+    CONSTRAINT fk_articleupdates_article FOREIGN KEY (article_id) REFERENCES articles (id) ON DELETE CASCADE
+);
+
+-- Articles merged into another one. Lookups of the old id or DOI follow the
+-- redirect to the surviving article.
+CREATE TABLE article_redirects
+(
+    old_id    BIGINT PRIMARY KEY,
+    old_doi   VARCHAR(100) NOT NULL, -- Normalized
+    new_id    BIGINT       NOT NULL,
+    merged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_articleredirects_old_doi (old_doi),
+    CONSTRAINT fk_articleredirects_new FOREIGN KEY (new_id) REFERENCES articles (id) ON DELETE CASCADE
 );
 
 CREATE TABLE article_tags
@@ -69,6 +106,29 @@ CREATE TABLE article_authors
     CONSTRAINT fk_articleauthors_author FOREIGN KEY (author_id) REFERENCES authors (id) ON DELETE CASCADE
 );
 
+-- Outgoing references of an article as reported by CrossRef. cited_article_id is
+-- filled in once the cited DOI exists locally; references without a local
+-- article (or without a DOI at all) stay dangling.
+CREATE TABLE article_references
+(
+    id                BIGINT AUTO_INCREMENT PRIMARY KEY,
+    citing_article_id BIGINT NOT NULL,
+    position          INT    NOT NULL, -- 1-based order in the reference list
+    cited_doi         VARCHAR(100),    -- Normalized
+    cited_article_id  BIGINT,
+    title             VARCHAR(512),
+    author            VARCHAR(255),
+    publication_year  INT,
+    journal_name      VARCHAR(255),
+    unstructured      TEXT,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_articlereferences_position (citing_article_id, position),
+    INDEX idx_articlereferences_cited_doi (cited_doi),
+    INDEX idx_articlereferences_cited_article (cited_article_id),
+    CONSTRAINT fk_articlereferences_citing FOREIGN KEY (citing_article_id) REFERENCES articles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_articlereferences_cited FOREIGN KEY (cited_article_id) REFERENCES articles (id) ON DELETE SET NULL
+);
+
 -- User's personal library
 CREATE TABLE library
 (
@@ -104,6 +164,180 @@ CREATE TABLE library_articles
 );
 
 
+-- Append-only log of reading activity on library articles. Rows are never
+-- updated, and outlive the library article they describe.
+CREATE TABLE reading_events
+(
+    id                 BIGINT AUTO_INCREMENT PRIMARY KEY,
+    profile_id         BIGINT    NOT NULL,                            -- Owner of the library
+    library_id         BIGINT    NOT NULL,
+    article_id         BIGINT    NOT NULL,
+    library_article_id BIGINT    NOT NULL,
+    -- 1: Added, 2: Status changed, 3: Progress updated, 4: Note edited, 5: Opened, 6: Removed, 7: Favorite changed, 8: Reviewed
+    event_type         TINYINT   NOT NULL COMMENT '1:Added, 2:StatusChanged, 3:ProgressUpdated, 4:NoteEdited, 5:Opened, 6:Removed, 7:FavoriteChanged, 8:Reviewed',
+    from_status        TINYINT   NULL,
+    to_status          TINYINT   NULL,
+    progress           INT       NULL,
+    occurred_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_readingevents_profile FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+    INDEX idx_reading_events_profile_time (profile_id, occurred_at),
+    INDEX idx_reading_events_library_time (library_id, occurred_at)
+);
+
+-- Reading goals, either "read N articles per period" or "finish a library by a date"
+CREATE TABLE reading_goals
+(
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    profile_id   BIGINT       NOT NULL,
+    name         VARCHAR(255) NOT NULL,
+    -- 1: Read count, 2: Finish library
+    goal_type    TINYINT      NOT NULL COMMENT '1:ReadCount, 2:FinishLibrary',
+    target_count INT          NULL,                                   -- Read count goals
+    period       TINYINT      NULL COMMENT '1:Day, 2:Week, 3:Month, 4:Year', -- Read count goals
+    library_id   BIGINT       NULL,                                   -- Finish library goals
+    due_date     DATE         NULL,                                   -- Finish library goals
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_readinggoals_profile FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_readinggoals_library FOREIGN KEY (library_id) REFERENCES library (id) ON DELETE CASCADE,
+    INDEX idx_reading_goals_profile (profile_id)
+);
+
+-- Spaced-repetition (SM-2) review schedule of a library article
+CREATE TABLE review_schedules
+(
+    library_article_id BIGINT PRIMARY KEY,
+    ease_factor        DOUBLE    NOT NULL DEFAULT 2.5,
+    interval_days      INT       NOT NULL DEFAULT 0,
+    repetitions        INT       NOT NULL DEFAULT 0,
+    due_date           DATE      NOT NULL,
+    last_reviewed_at   TIMESTAMP NULL,
+    last_rating        TINYINT   NULL,
+    created_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_reviewschedules_libraryarticle FOREIGN KEY (library_article_id) REFERENCES library_articles (id) ON DELETE CASCADE,
+    INDEX idx_review_schedules_due_date (due_date)
+);
+
+-- Highlights and comments on a library article
+CREATE TABLE annotations
+(
+    id                 BIGINT AUTO_INCREMENT PRIMARY KEY,
+    library_article_id BIGINT       NOT NULL,
+    author_id          BIGINT       NOT NULL,
+    quote              TEXT         NULL,
+    comment            TEXT         NULL,
+    page               INT          NULL,
+    location           VARCHAR(255) NULL,
+    -- 0: Unspecified, 1: Yellow, 2: Green, 3: Blue, 4: Pink, 5: Purple
+    color              TINYINT      NOT NULL DEFAULT 0 COMMENT '0:Unspecified, 1:Yellow, 2:Green, 3:Blue, 4:Pink, 5:Purple',
+    -- 1: Private, 2: Shared
+    visibility         TINYINT      NOT NULL DEFAULT 1 COMMENT '1:Private, 2:Shared',
+    created_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_annotations_libraryarticle FOREIGN KEY (library_article_id) REFERENCES library_articles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_annotations_author FOREIGN KEY (author_id) REFERENCES profiles (id) ON DELETE CASCADE,
+    INDEX idx_annotations_author (author_id, id)
+);
+
+CREATE TABLE annotation_tags
+(
+    annotation_id BIGINT NOT NULL,
+    tag_id        BIGINT NOT NULL,
+    created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (annotation_id, tag_id),
+    CONSTRAINT fk_annotationtags_annotation FOREIGN KEY (annotation_id) REFERENCES annotations (id) ON DELETE CASCADE,
+    CONSTRAINT fk_annotationtags_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+-- Uploaded file contents, stored once per SHA-256 hash in the blob store
+CREATE TABLE blobs
+(
+    sha256            CHAR(64) PRIMARY KEY,
+    size              BIGINT        NOT NULL,
+    content_type      VARCHAR(127)  NOT NULL,
+    -- 0: Not applicable, 1: Pending, 2: Processing, 3: Done, 4: Failed
+    extraction_status TINYINT       NOT NULL DEFAULT 0 COMMENT '0:NotApplicable, 1:Pending, 2:Processing, 3:Done, 4:Failed',
+    extraction_error  VARCHAR(1024) NULL,
+    -- A worker that claimed the blob owns it until then; expired claims are picked up again
+    extraction_lease_until TIMESTAMP NULL,
+    page_count        INT           NULL,
+    extracted_at      TIMESTAMP     NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_blobs_extraction_status (extraction_status)
+);
+
+-- Text extracted from each page of a PDF blob
+CREATE TABLE blob_pages
+(
+    sha256      CHAR(64)   NOT NULL,
+    page_number INT        NOT NULL,
+    text        MEDIUMTEXT NOT NULL,
+    PRIMARY KEY (sha256, page_number),
+    CONSTRAINT fk_blobpages_blob FOREIGN KEY (sha256) REFERENCES blobs (sha256) ON DELETE CASCADE,
+    FULLTEXT INDEX ft_blob_pages_text (text)
+);
+
+-- Files attached to either an article (shared by everyone) or a library article (private to the library)
+CREATE TABLE attachments
+(
+    id                 BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_id           BIGINT       NOT NULL,
+    sha256             CHAR(64)     NOT NULL,
+    filename           VARCHAR(255) NOT NULL,
+    content_type       VARCHAR(127) NOT NULL,
+    size               BIGINT       NOT NULL,
+    article_id         BIGINT       NULL,
+    library_article_id BIGINT       NULL,
+    created_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_attachments_owner FOREIGN KEY (owner_id) REFERENCES profiles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachments_blob FOREIGN KEY (sha256) REFERENCES blobs (sha256),
+    CONSTRAINT fk_attachments_article FOREIGN KEY (article_id) REFERENCES articles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachments_libraryarticle FOREIGN KEY (library_article_id) REFERENCES library_articles (id) ON DELETE CASCADE,
+    CONSTRAINT chk_attachments_target CHECK ((article_id IS NULL) <> (library_article_id IS NULL)),
+    INDEX idx_attachments_owner (owner_id),
+    INDEX idx_attachments_sha256 (sha256)
+);
+
+-- Related articles computed offline by the recommendation job. Each row keeps
+-- the signals behind the score so the suggestion can be explained.
+CREATE TABLE article_recommendations
+(
+    article_id             BIGINT NOT NULL,
+    recommended_article_id BIGINT NOT NULL,
+    score                  DOUBLE NOT NULL,
+    shared_authors         INT    NOT NULL DEFAULT 0,
+    shared_tags            INT    NOT NULL DEFAULT 0,
+    co_citations           INT    NOT NULL DEFAULT 0, -- Articles citing both
+    co_occurrences         INT    NOT NULL DEFAULT 0, -- Public libraries containing both
+    abstract_similarity    DOUBLE NOT NULL DEFAULT 0, -- TF-IDF cosine similarity
+    computed_at            TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, recommended_article_id),
+    INDEX idx_articlerecommendations_score (article_id, score),
+    CONSTRAINT fk_articlerecommendations_article FOREIGN KEY (article_id) REFERENCES articles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_articlerecommendations_recommended FOREIGN KEY (recommended_article_id) REFERENCES articles (id) ON DELETE CASCADE
+);
+
+-- Personal access tokens for scripts. Only the SHA-256 of the secret is
+-- stored; the token itself is shown once, when it is created.
+CREATE TABLE access_tokens
+(
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    profile_id   BIGINT       NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    token_hash   CHAR(64)     NOT NULL,
+    token_prefix VARCHAR(16)  NOT NULL, -- Start of the token, to tell tokens apart
+    scopes       VARCHAR(255) NOT NULL, -- Space separated
+    expires_at   TIMESTAMP    NOT NULL,
+    last_used_at TIMESTAMP    NULL,
+    revoked_at   TIMESTAMP    NULL,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_accesstokens_hash (token_hash),
+    INDEX idx_accesstokens_profile (profile_id),
+    CONSTRAINT fk_accesstokens_profile FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE
+);
+
 -- Indexes for performance
 CREATE INDEX idx_authors_name ON authors (name);
 CREATE INDEX idx_articles_title ON articles (title);
@@ -19,7 +19,9 @@ export const useGrpcClient = () => {
         });
     };
 
-    const createAuthenticatedClient = (token?: string): Rpc => {
+    // traceHeaders, such as traceparent and tracestate, are sent as metadata
+    // with every call so the backend continues the caller's trace.
+    const createAuthenticatedClient = (token?: string, traceHeaders: Record<string, string> = {}): Rpc => {
         let channelCredentials;
         if (token) {
             const sslCreds = credentials.createSsl(null, null, null, {
@@ -34,6 +36,10 @@ export const useGrpcClient = () => {
 
         const sendRequest = (service: string, method: string, data: Uint8Array): Promise<Uint8Array> => {
             const path = `/${service}/${method}`;
+            const metadata = new Metadata();
+            for (const [key, value] of Object.entries(traceHeaders)) {
+                metadata.set(key, value);
+            }
 
             return new Promise((resolve, reject) => {
                 const resultCallback: UnaryCallback<any> = (err, res) => {
@@ -47,7 +53,7 @@ export const useGrpcClient = () => {
                     return argument;
                 }
 
-                conn.makeUnaryRequest(path, d => Buffer.from(d), passThrough, data, resultCallback);
+                conn.makeUnaryRequest(path, d => Buffer.from(d), passThrough, data, metadata, resultCallback);
             });
         };
 
@@ -1,17 +1,32 @@
 import {useGrpcClient} from "~~/server/proto/useGrpcClient";
-import {H3Event} from "h3";
+import {H3Event, getRequestHeader} from "h3";
 import {ArticlesServiceClientImpl} from "~~/server/proto/grpc/articles/v1/article";
 import {LibraryServiceClientImpl} from "~~/server/proto/grpc/library/v1/library";
 import {ProfileServiceClientImpl} from "~~/server/proto/grpc/profile/v1/profile";
 import {AuthorServiceClientImpl} from "~~/server/proto/grpc/profile/v1/author";
 
+// W3C trace context headers passed on to the backend, so its spans join the
+// trace of the incoming request.
+const traceContextHeaders = ["traceparent", "tracestate", "baggage"];
+
+const traceHeaders = (event: H3Event): Record<string, string> => {
+    const headers: Record<string, string> = {};
+    for (const name of traceContextHeaders) {
+        const value = getRequestHeader(event, name);
+        if (value) {
+            headers[name] = value;
+        }
+    }
+    return headers;
+}
+
 export const useServices = () => {
     const {createAuthenticatedClient} = useGrpcClient();
 
     const getArticlesServiceClient = async (event: H3Event) => {
         const accessToken = (await getUserSession(event)).secure?.accessToken
         //Create rpc client
-        const client = createAuthenticatedClient(accessToken)
+        const client = createAuthenticatedClient(accessToken, traceHeaders(event))
         return new ArticlesServiceClientImpl(client)
     }
 
@@ -19,7 +34,7 @@ export const useServices = () => {
         const accessToken = (await getUserSession(event)).secure?.accessToken
 
         //Create rpc client
-        const client = createAuthenticatedClient(accessToken)
+        const client = createAuthenticatedClient(accessToken, traceHeaders(event))
         return new LibraryServiceClientImpl(client)
     }
 
@@ -27,7 +42,7 @@ export const useServices = () => {
         const accessToken = (await getUserSession(event)).secure?.accessToken
 
         //Create rpc client
-        const client = createAuthenticatedClient(accessToken)
+        const client = createAuthenticatedClient(accessToken, traceHeaders(event))
         return new ProfileServiceClientImpl(client)
     }
 
@@ -35,7 +50,7 @@ export const useServices = () => {
         const accessToken = (await getUserSession(event)).secure?.accessToken
 
         //Create rpc client
-        const client = createAuthenticatedClient(accessToken)
+        const client = createAuthenticatedClient(accessToken, traceHeaders(event))
         return new AuthorServiceClientImpl(client)
     }
 